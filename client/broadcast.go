@@ -1,56 +1,140 @@
 package client
 
 import (
+	"context"
+	"time"
+
 	mevm "github.com/Moonyongjung/xpriv.go/core/evm"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
 
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/ethereum/go-ethereum/common"
 )
 
-var xplaTxRes types.TxRes
-
 // Broadcast the transaction.
 // Default broadcast mode is "sync" if not xpla client has broadcast mode option.
 // The broadcast method is determined according to the broadcast mode option of the xpla client.
 // For evm transaction broadcast, use a separate method in this function.
 func (xplac *xplaClient) Broadcast(txBytes []byte) (*types.TxRes, error) {
-
-	if xplac.GetModule() == mevm.EvmModule {
-		return xplac.broadcastEvm(txBytes)
-
-	} else {
-		broadcastMode := xplac.GetBroadcastMode()
-		switch {
-		case broadcastMode == "block":
-			return xplac.BroadcastBlock(txBytes)
-		case broadcastMode == "async":
-			return xplac.BroadcastAsync(txBytes)
-		case broadcastMode == "sync":
-			return broadcastTx(xplac, txBytes, txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
-		default:
-			return broadcastTx(xplac, txBytes, txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
-		}
-	}
+	return requestWithVP(xplac, func(c *xplaClient) (*types.TxRes, error) {
+		return c.broadcast(txBytes, c.GetBroadcastMode())
+	})
 }
 
 // Broadcast the transaction with mode "block".
 // It takes precedence over the option of the xpla client.
+// The mode "block" is deprecated, so use BroadcastAndWait in order to wait the transaction is included in a block.
 func (xplac *xplaClient) BroadcastBlock(txBytes []byte) (*types.TxRes, error) {
-	if xplac.GetModule() == mevm.EvmModule {
-		return xplac.broadcastEvm(txBytes)
-	}
-	return broadcastTx(xplac, txBytes, txtypes.BroadcastMode_BROADCAST_MODE_BLOCK)
+	return requestWithVP(xplac, func(c *xplaClient) (*types.TxRes, error) {
+		return c.broadcast(txBytes, "block")
+	})
 }
 
 // Broadcast the transaction with mode "Async".
 // It takes precedence over the option of the xpla client.
 func (xplac *xplaClient) BroadcastAsync(txBytes []byte) (*types.TxRes, error) {
-	if xplac.GetModule() == mevm.EvmModule {
+	return requestWithVP(xplac, func(c *xplaClient) (*types.TxRes, error) {
+		return c.broadcast(txBytes, "async")
+	})
+}
+
+// Broadcast the transaction with mode "sync" and wait until the transaction is included in a block.
+// The transaction is requested by its hash through gRPC or LCD, and the receipt is requested for evm transaction.
+// If the transaction is not included until the timeout, the timeout error is returned.
+// It is recommended instead of BroadcastBlock because the broadcast mode "block" is deprecated.
+func (xplac *xplaClient) BroadcastAndWait(txBytes []byte, timeout time.Duration) (*types.TxRes, error) {
+	if timeout <= 0 {
+		return nil, util.LogErr(errors.ErrInvalidRequest, "timeout must be positive")
+	}
+
+	ctx, cancel := context.WithTimeout(xplac.GetContext(), timeout)
+	defer cancel()
+
+	// The context of the copied client has timeout, and it keeps the metadata of the receiver.
+	c := xplac.clone()
+	c.context = ctx
+
+	// The broadcast and the polling are retried with the refreshed VP respectively,
+	// so the transaction is not broadcasted again when the VP is rejected while polling.
+	txRes, err := requestWithVP(c, func(c *xplaClient) (*types.TxRes, error) {
+		return c.broadcastSync(txBytes)
+	})
+	if err != nil {
+		return txRes, err
+	}
+
+	return requestWithVP(c, func(c *xplaClient) (*types.TxRes, error) {
+		return c.waitBroadcastedTx(txBytes, txRes)
+	})
+}
+
+// Broadcast the transaction with mode "sync" before waiting it.
+func (xplac *xplaClient) broadcastSync(txBytes []byte) (*types.TxRes, error) {
+	if isEvmTx(txBytes) {
+		if xplac.GetEvmRpc() == "" {
+			return nil, util.LogErr(errors.ErrNotSatisfiedOptions, "evm JSON-RPC URL must exist")
+		}
+		evmClient, err := util.NewEvmClient(xplac.GetEvmRpc(), xplac.GetContext())
+		if err != nil {
+			return nil, err
+		}
+		return broadcastTxEvm(xplac, txBytes, "sync", evmClient)
+	}
+
+	txRes, err := broadcastTx(xplac, txBytes, txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+	if err != nil {
+		return txRes, err
+	}
+	if txRes.Response.Code != 0 {
+		return txRes, util.LogErr(errors.ErrTxFailed, "with code", txRes.Response.Code, ":", txRes.Response.RawLog)
+	}
+	return txRes, nil
+}
+
+// Wait until the broadcasted transaction is included in a block.
+func (xplac *xplaClient) waitBroadcastedTx(txBytes []byte, txRes *types.TxRes) (*types.TxRes, error) {
+	if isEvmTx(txBytes) {
+		evmClient, err := util.NewEvmClient(xplac.GetEvmRpc(), xplac.GetContext())
+		if err != nil {
+			return txRes, err
+		}
+
+		receipt, err := waitTxReceipt(xplac.GetContext(), evmClient, common.HexToHash(txRes.EvmTxHash))
+		if err != nil {
+			return txRes, err
+		}
+		evmTxRes := *txRes
+		evmTxRes.EvmReceipt = receipt
+
+		signedTx, err := mevm.DecodeSignedEvmTx(txBytes)
+		if err != nil {
+			return &evmTxRes, err
+		}
+		if err := checkEvmReceipt(evmClient, signedTx, receipt, evmTxAbi(xplac)); err != nil {
+			return &evmTxRes, err
+		}
+		return &evmTxRes, nil
+	}
+
+	return waitTx(xplac, txRes.Response.TxHash)
+}
+
+// Broadcast the transaction by the broadcast mode.
+func (xplac *xplaClient) broadcast(txBytes []byte, broadcastMode string) (*types.TxRes, error) {
+	if isEvmTx(txBytes) {
 		return xplac.broadcastEvm(txBytes)
 	}
-	return broadcastTx(xplac, txBytes, txtypes.BroadcastMode_BROADCAST_MODE_ASYNC)
+
+	switch {
+	case broadcastMode == "block":
+		return broadcastTx(xplac, txBytes, txtypes.BroadcastMode_BROADCAST_MODE_BLOCK)
+	case broadcastMode == "async":
+		return broadcastTx(xplac, txBytes, txtypes.BroadcastMode_BROADCAST_MODE_ASYNC)
+	default:
+		return broadcastTx(xplac, txBytes, txtypes.BroadcastMode_BROADCAST_MODE_SYNC)
+	}
 }
 
 // Broadcast the transaction which is evm transaction by using ethclient of go-ethereum.
@@ -65,3 +149,12 @@ func (xplac *xplaClient) broadcastEvm(txBytes []byte) (*types.TxRes, error) {
 	broadcastMode := xplac.GetBroadcastMode()
 	return broadcastTxEvm(xplac, txBytes, broadcastMode, evmClient)
 }
+
+// Check the transaction is evm transaction.
+// The xpla client which broadcasts the transaction may be different from the client which created it,
+// so the evm transaction is determined by decoding the bytes as the signed evm transaction.
+// JSON encoded cosmos transactions, e.g. outputs of SignTx and MultiSign, are not evm transactions.
+func isEvmTx(txBytes []byte) bool {
+	_, err := mevm.DecodeSignedEvmTx(txBytes)
+	return err == nil
+}
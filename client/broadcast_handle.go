@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"encoding/json"
 	"time"
 
@@ -10,22 +11,28 @@ import (
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	evmtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
+// Interval of requesting the transaction until it is included in a block.
+const txPollInterval = time.Second
+
 // Broadcast generated transactions.
 // Broadcast responses, excluding evm, are delivered as "TxResponse" of the entire response structure of the xpla client.
 // Support broadcast by using LCD and gRPC at the same time. Default method is gRPC.
 func broadcastTx(xplac *xplaClient, txBytes []byte, mode txtypes.BroadcastMode) (*types.TxRes, error) {
+	var xplaTxRes types.TxRes
 	broadcastReq := txtypes.BroadcastTxRequest{
 		TxBytes: txBytes,
 		Mode:    mode,
 	}
 
 	if xplac.GetGrpcUrl() == "" {
+		// The VP is included in the body for the private chain, and it is also attached to the header by the VP transport.
 		vpByte := xplac.GetVPByte()
 		var pAuth middleware.PrivateAuth
 		json.Unmarshal(vpByte, &pAuth)
@@ -53,124 +60,167 @@ func broadcastTx(xplac *xplaClient, txBytes []byte, mode txtypes.BroadcastMode)
 		}
 
 		txResponse := broadcastTxResponse.TxResponse
+		updateTxNonce(xplac, txBytes, txResponse)
+		xplaTxRes.Response = txResponse
 		if txResponse.Code != 0 {
 			return &xplaTxRes, util.LogErr(errors.ErrTxFailed, "with code", txResponse.Code, ":", txResponse.RawLog)
 		}
-
-		xplaTxRes.Response = txResponse
 	} else {
-		xplac = VPInputGrpcContext(xplac)
 		txClient := txtypes.NewServiceClient(xplac.GetGrpcClient())
 		txResponse, err := txClient.BroadcastTx(xplac.GetContext(), &broadcastReq)
 		if err != nil {
 			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
+		updateTxNonce(xplac, txBytes, txResponse.TxResponse)
 		xplaTxRes.Response = txResponse.TxResponse
 	}
 
 	return &xplaTxRes, nil
 }
 
+// Update the nonce manager by the response of the broadcasted transaction.
+func updateTxNonce(xplac *xplaClient, txBytes []byte, txResponse *sdk.TxResponse) {
+	if xplac.GetNonceManager() == nil || txResponse == nil {
+		return
+	}
+
+	usedSequence, ok := signedSequence(xplac, txBytes)
+	if !ok {
+		return
+	}
+
+	// The sequence is used by the transaction which is included in the block even though it is failed.
+	updateNonce(xplac, usedSequence, txResponse.Code == 0 || txResponse.Height > 0, txResponse.RawLog)
+}
+
+// Update the nonce manager by the result of the broadcasted evm transaction.
+func updateEvmTxNonce(xplac *xplaClient, usedNonce uint64, broadcastErr error) {
+	if broadcastErr != nil {
+		updateNonce(xplac, usedNonce, false, broadcastErr.Error())
+		return
+	}
+	updateNonce(xplac, usedNonce, true, "")
+}
+
 // Broadcast generated transactions of ethereum type.
 // Broadcast responses, including evm, are delivered as "TxResponse".
+// Transactions of sending coin, deploying and invoking contract are signed when they are created.
 func broadcastTxEvm(xplac *xplaClient, txBytes []byte, broadcastMode string, evmClient *util.EvmClient) (*types.TxRes, error) {
-	switch {
-	case xplac.GetMsgType() == mevm.EvmSendCoinMsgType ||
-		xplac.GetMsgType() == mevm.EvmInvokeSolContractMsgType:
-		var signedTx evmtypes.Transaction
-		err := signedTx.UnmarshalJSON(txBytes)
-		if err != nil {
-			return nil, util.LogErr(errors.ErrFailedToUnmarshal, err)
-		}
-
-		err = evmClient.Client.SendTransaction(evmClient.Ctx, &signedTx)
-		if err != nil {
-			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
-		}
+	signedTx, err := mevm.DecodeSignedEvmTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
 
-		return checkEvmBroadcastMode(broadcastMode, evmClient, &signedTx)
+	err = evmClient.Client.SendTransaction(evmClient.Ctx, signedTx)
+	updateEvmTxNonce(xplac, signedTx.Nonce(), err)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
+	}
 
-	case xplac.GetMsgType() == mevm.EvmDeploySolContractMsgType:
-		var deployTx mevm.DeploySolTx
+	return checkEvmBroadcastMode(broadcastMode, evmClient, signedTx, evmTxAbi(xplac))
+}
 
-		err := json.Unmarshal(txBytes, &deployTx)
+// Handle evm broadcast mode.
+// Similarly, determine broadcast mode included in the options of xpla client.
+// If broadcast mode is not "block", the hash of the transaction is returned without waiting the receipt.
+// The ABI is used to decode the custom error of the failed transaction.
+func checkEvmBroadcastMode(broadcastMode string, evmClient *util.EvmClient, tx *evmtypes.Transaction, abiJson string) (*types.TxRes, error) {
+	txRes := types.TxRes{EvmTxHash: tx.Hash().Hex()}
+	if tx.To() == nil {
+		contractAddress, err := mevm.EvmContractAddress(tx)
 		if err != nil {
-			return nil, util.LogErr(errors.ErrFailedToUnmarshal, err)
+			return nil, err
 		}
+		txRes.EvmContractAddress = contractAddress.Hex()
+	}
 
-		ethPrivKey, err := toECDSA(xplac.GetPrivateKey())
-		if err != nil {
-			return nil, util.LogErr(errors.ErrCannotConvert, err)
-		}
+	// Wait tx receipt (Broadcast Block)
+	if broadcastMode == "block" {
+		ctx, cancel := context.WithTimeout(evmClient.Ctx, time.Duration(util.DefaultEvmTxReceiptTimeout)*time.Second)
+		defer cancel()
 
-		contractAuth, err := bind.NewKeyedTransactorWithChainID(ethPrivKey, deployTx.ChainId)
+		receipt, err := waitTxReceipt(ctx, evmClient, tx.Hash())
 		if err != nil {
-			return nil, util.LogErr(errors.ErrInsufficientParams, err)
+			return nil, err
 		}
-		contractAuth.Nonce = deployTx.Nonce
-		contractAuth.Value = deployTx.Value
-		contractAuth.GasLimit = deployTx.GasLimit
-		contractAuth.GasPrice = deployTx.GasPrice
+		txRes.EvmReceipt = receipt
 
-		metadata := util.GetBindMetaData(deployTx.ABI, deployTx.Bytecode)
-		parsedAbi, err := metadata.GetAbi()
-		if err != nil {
-			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
-		}
-		if parsedAbi == nil {
-			return nil, util.LogErr(errors.ErrEvmRpcRequest, "GetABI returned nil")
-		}
-		parsedBytecode := common.FromHex(metadata.Bin)
-
-		var transaction *evmtypes.Transaction
-		if mevm.Args == nil {
-			_, transaction, _, err = bind.DeployContract(contractAuth, *parsedAbi, parsedBytecode, evmClient.Client)
-		} else {
-			_, transaction, _, err = bind.DeployContract(contractAuth, *parsedAbi, parsedBytecode, evmClient.Client, mevm.Args...)
-			mevm.Args = nil
-		}
-		if err != nil {
-			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
+		if err := checkEvmReceipt(evmClient, tx, receipt, abiJson); err != nil {
+			return &txRes, err
 		}
+	}
 
-		return checkEvmBroadcastMode(broadcastMode, evmClient, transaction)
+	return &txRes, nil
+}
 
+// Check the status of the evm transaction receipt.
+// The reason of the failed transaction is recovered by eth_call at the block of it.
+func checkEvmReceipt(evmClient *util.EvmClient, tx *evmtypes.Transaction, receipt *evmtypes.Receipt, abiJson string) error {
+	if receipt.Status == evmtypes.ReceiptStatusFailed {
+		return mevm.ReplayFailedTx(evmClient, tx, receipt, abiJson)
+	}
+	return nil
+}
+
+// Get the ABI of the contract from the evm message of the xpla client which creates the transaction.
+// It is empty if the message of the client is not the message of the contract.
+func evmTxAbi(xplac *xplaClient) string {
+	switch msg := xplac.GetMsg().(type) {
+	case types.InvokeSolContractMsg:
+		return msg.ABI
+	case mevm.ContractInfo:
+		return msg.Abi
 	default:
-		return nil, util.LogErr(errors.ErrInvalidMsgType, "invalid EVM msg type:", xplac.GetMsgType())
+		return ""
 	}
 }
 
-// Handle evm broadcast mode.
-// Similarly, determine broadcast mode included in the options of xpla client.
-func checkEvmBroadcastMode(broadcastMode string, evmClient *util.EvmClient, tx *evmtypes.Transaction) (*types.TxRes, error) {
-	// Wait tx receipt (Broadcast Block)
-	if broadcastMode == "block" {
-		receipt, err := waitTxReceipt(evmClient, tx)
-		if err != nil {
-			return nil, err
+// Client waits transaction receipt of evm until the context is done.
+// The receipt is requested periodically while it is not found, and other errors are returned immediately.
+func waitTxReceipt(ctx context.Context, evmClient *util.EvmClient, txHash common.Hash) (*evmtypes.Receipt, error) {
+	ticker := time.NewTicker(txPollInterval)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := evmClient.Client.TransactionReceipt(ctx, txHash)
+		if err == nil {
+			return receipt, nil
+		}
+		if err != ethereum.NotFound && ctx.Err() == nil {
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, util.LogErr(errors.ErrTimeout, "cannot receive the transaction receipt of", txHash.Hex(), ":", err)
+		case <-ticker.C:
 		}
-		xplaTxRes.EvmReceipt = receipt
-		return &xplaTxRes, nil
-	} else {
-		return nil, nil
 	}
 }
 
-// If broadcast mode is "block", client waits transaction receipt of evm.
-// The wait time is in seconds and is set inside the library as timeout count.
-// When the timeout occurs, no longer wait for the transaction receipt.
-func waitTxReceipt(evmClient *util.EvmClient, signedTx *evmtypes.Transaction) (*evmtypes.Receipt, error) {
-	count := util.DefaultEvmTxReceiptTimeout
+// Client waits the transaction until it is included in a block or the context is done.
+// The transaction is requested periodically while it is not found, and other errors are returned immediately.
+func waitTx(xplac *xplaClient, txHash string) (*types.TxRes, error) {
+	ticker := time.NewTicker(txPollInterval)
+	defer ticker.Stop()
+
 	for {
-		receipt, err := evmClient.Client.TransactionReceipt(evmClient.Ctx, signedTx.Hash())
-		if err != nil {
-			count = count - 1
-			if count < 0 {
-				return nil, util.LogErr(errors.ErrEvmRpcRequest, "cannot receive the transaction receipt in count time is", count)
+		txResponse, err := getTxResponse(xplac, txHash)
+		if err == nil && txResponse != nil {
+			txRes := types.TxRes{Response: txResponse}
+			if txResponse.Code != 0 {
+				return &txRes, util.LogErr(errors.ErrTxFailed, "with code", txResponse.Code, ":", txResponse.RawLog)
 			}
-			time.Sleep(time.Second * 1)
-		} else {
-			return receipt, nil
+			return &txRes, nil
+		}
+		if err != nil && !util.IsNotFoundErr(err) && xplac.GetContext().Err() == nil {
+			return nil, err
+		}
+
+		select {
+		case <-xplac.GetContext().Done():
+			return nil, util.LogErr(errors.ErrTimeout, "cannot find the transaction", txHash, ":", err)
+		case <-ticker.C:
 		}
 	}
 }
@@ -1,35 +1,54 @@
 package client_test
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"path/filepath"
 	"strings"
+	"sync"
+	"testing"
+	"time"
 
+	"github.com/Moonyongjung/xpla-private-chain/serve/middleware"
+	"github.com/Moonyongjung/xpriv.go/client"
+	mevm "github.com/Moonyongjung/xpriv.go/core/evm"
 	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/provider"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/util"
+	"github.com/Moonyongjung/xpriv.go/util/testutil"
 	"github.com/evmos/ethermint/crypto/hd"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	evmtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/stretchr/testify/require"
 )
 
 func (s *ClientTestSuite) TestBroadcast() {
 	from := s.accounts[0]
 	to := s.accounts[1]
-	s.xplac.WithPrivateKey(s.accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(s.accounts[0].PrivKey)
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
-			nowSeq, err := util.FromStringToInt(s.xplac.GetSequence())
-			s.Require().NoError(err)
-			s.xplac.WithSequence(util.FromIntToString(nowSeq + 1))
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		// check before send
@@ -79,14 +98,14 @@ func (s *ClientTestSuite) TestBroadcastMode() {
 	from := s.accounts[0]
 	to := s.accounts[1]
 
-	s.xplac.
+	s.xplac = s.xplac.
 		WithURL(s.apis[0]).
 		WithPrivateKey(s.accounts[0].PrivKey)
 
 	modes := []string{"block", "async", "sync", "", invalidBroadcastMode}
 
 	for _, mode := range modes {
-		s.xplac.WithBroadcastMode(mode)
+		s.xplac = s.xplac.WithBroadcastMode(mode)
 
 		bankSendMsg := types.BankSendMsg{
 			FromAddress: from.Address.String(),
@@ -100,18 +119,308 @@ func (s *ClientTestSuite) TestBroadcastMode() {
 		_, err = s.xplac.Broadcast(txbytes)
 		s.Require().NoError(err)
 		s.Require().NoError(s.network.WaitForNextBlock())
+	}
+	s.xplac = provider.ResetXplac(s.xplac)
+}
+
+func (s *ClientTestSuite) TestBroadcastAndWait() {
+	from := s.accounts[0]
+	to := s.accounts[1]
+	timeout := 30 * time.Second
+
+	xplac := s.xplac.
+		WithEvmRpc("http://" + s.network.Validators[0].AppConfig.JSONRPC.Address).
+		WithPrivateKey(from.PrivKey)
+
+	for i, api := range s.apis {
+		if i == 0 {
+			xplac = xplac.WithURL(api)
+		} else {
+			xplac = xplac.WithGrpc(api)
+		}
+
+		bankSendMsg := types.BankSendMsg{
+			FromAddress: from.Address.String(),
+			ToAddress:   to.Address.String(),
+			Amount:      testSendAmount,
+		}
+		txbytes, err := xplac.BankSend(bankSendMsg).CreateAndSignTx()
+		s.Require().NoError(err)
 
-		nowSeq, err := util.FromStringToInt(s.xplac.GetSequence())
+		res, err := xplac.BroadcastAndWait(txbytes, timeout)
 		s.Require().NoError(err)
-		s.xplac.WithSequence(util.FromIntToString(nowSeq + 1))
+		s.Require().Equal(uint32(0), res.Response.Code)
+		s.Require().Greater(res.Response.Height, int64(0))
+		s.Require().Greater(res.Response.GasUsed, int64(0))
+		s.Require().NotEmpty(res.Response.Events)
+	}
+
+	// evm transaction waits the receipt
+	sendCoinMsg := types.SendCoinMsg{
+		FromAddress: from.PubKey.Address().String(),
+		ToAddress:   to.PubKey.Address().String(),
+		Amount:      testSendAmount,
+	}
+	txbytes, err := xplac.EvmSendCoin(sendCoinMsg).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	res, err := xplac.BroadcastAndWait(txbytes, timeout)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(res.EvmTxHash)
+	s.Require().Equal(res.EvmTxHash, res.EvmReceipt.TxHash.Hex())
+	s.Require().Equal(evmtypes.ReceiptStatusSuccessful, res.EvmReceipt.Status)
+
+	// invalid timeout
+	_, err = xplac.BroadcastAndWait(txbytes, 0)
+	s.Require().Error(err)
+}
+
+func TestBroadcastAndWaitWithRefreshedVP(t *testing.T) {
+	refreshedVP := base64.StdEncoding.EncodeToString([]byte(testRefreshedVP))
+	txHash := "ABCD"
+
+	// LCD accepts the broadcast with any VP, and it responds 401 to the query of the tx if the VP is not refreshed.
+	var mu sync.Mutex
+	broadcastCount, queryCount := 0, 0
+	queryStatus := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.Method == http.MethodPost {
+			broadcastCount++
+			w.Write([]byte(`{"tx_response":{"txhash":"` + txHash + `","code":0}}`))
+			return
+		}
+
+		if r.Header.Get(util.VPHeader) != refreshedVP {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		queryCount++
+		switch {
+		case queryStatus != http.StatusOK:
+			w.WriteHeader(queryStatus)
+		case queryCount == 1:
+			// the transaction is not included in a block yet
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.Write([]byte(`{"tx_response":{"txhash":"` + txHash + `","height":"1","code":0}}`))
+		}
+	}))
+	defer server.Close()
+
+	txbytes := []byte("tx")
+	xplac := client.NewXplaClient(testutil.TestChainId).WithURL(server.URL)
+
+	// the transaction is broadcasted once even though the polling is retried with the refreshed VP
+	vpProvider := &refreshingVPProvider{}
+	res, err := xplac.WithVPProvider(vpProvider).BroadcastAndWait(txbytes, 10*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), res.Response.Height)
+	require.Equal(t, 1, broadcastCount)
+	require.Equal(t, 1, vpProvider.refreshCalled)
+
+	// other errors than not found are returned without waiting the timeout
+	mu.Lock()
+	queryStatus = http.StatusInternalServerError
+	mu.Unlock()
+	_, err = xplac.WithVPProvider(vpProvider).BroadcastAndWait(txbytes, time.Minute)
+	require.Error(t, err)
+	code, ok := util.HttpStatusCode(err)
+	require.True(t, ok)
+	require.Equal(t, http.StatusInternalServerError, code)
+	require.Equal(t, 2, broadcastCount)
+}
+
+func TestLcdBroadcastWithVP(t *testing.T) {
+	vp := `{"type":["VerifiablePresentation"]}`
+	txbytes := []byte("tx")
+
+	var method, path, header string
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.Path
+		header = r.Header.Get(util.VPHeader)
+		body, _ = io.ReadAll(r.Body)
+		w.Write([]byte(`{"tx_response":{"txhash":"ABCD","code":0}}`))
+	}))
+	defer server.Close()
+
+	xplac := client.NewXplaClient(testutil.TestChainId).WithURL(server.URL).WithUseVP(true).WithVPByString(vp)
+	_, err := xplac.Broadcast(txbytes)
+	require.NoError(t, err)
+
+	// the VP is included in the body of the private chain and attached to the header
+	var pAuth middleware.PrivateAuth
+	require.NoError(t, json.Unmarshal([]byte(vp), &pAuth))
+	expectBody, err := json.Marshal(middleware.TxBroadcastMessage{
+		TxBytes:     txbytes,
+		Mode:        txtypes.BroadcastMode_BROADCAST_MODE_SYNC,
+		PrivateAuth: pAuth,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, http.MethodPost, method)
+	require.Equal(t, "/cosmos/tx/v1beta1/txs", path)
+	require.Equal(t, base64.StdEncoding.EncodeToString([]byte(vp)), header)
+	require.JSONEq(t, string(expectBody), string(body))
+}
+
+func (s *ClientTestSuite) TestBroadcastBatch() {
+	from := s.accounts[0]
+	to := s.accounts[1]
+
+	s.xplac = s.xplac.
+		WithURL(s.apis[0]).
+		WithPrivateKey(s.accounts[0].PrivKey)
+
+	// check before send
+	bankBalancesMsg := types.BankBalancesMsg{
+		Address: to.Address.String(),
+	}
+	beforeRes, err := client.QueryAs[banktypes.QueryAllBalancesResponse](s.xplac.BankBalances(bankBalancesMsg))
+	s.Require().NoError(err)
+
+	// broadcast transaction which has two messages of bank send and a message of fund community pool
+	bankSendMsg := types.BankSendMsg{
+		FromAddress: from.Address.String(),
+		ToAddress:   to.Address.String(),
+		Amount:      testSendAmount,
+	}
+	fundCommunityPoolMsg := types.FundCommunityPoolMsg{
+		Amount: testSendAmount,
 	}
+	txbytes, err := s.xplac.Batch(
+		s.xplac.BankSend(bankSendMsg),
+		s.xplac.BankSend(bankSendMsg),
+		s.xplac.FundCommunityPool(fundCommunityPoolMsg),
+	).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	_, err = s.xplac.Broadcast(txbytes)
+	s.Require().NoError(err)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	// check after send
+	afterRes, err := client.QueryAs[banktypes.QueryAllBalancesResponse](s.xplac.BankBalances(bankBalancesMsg))
+	s.Require().NoError(err)
+
+	sendAmount, ok := sdk.NewIntFromString(testSendAmount)
+	s.Require().True(ok)
+	s.Require().Equal(
+		sendAmount.MulRaw(2),
+		afterRes.Balances[0].Amount.Sub(beforeRes.Balances[0].Amount),
+	)
+
 	s.xplac = provider.ResetXplac(s.xplac)
 }
 
+func (s *ClientTestSuite) TestBroadcastWithNonceManager() {
+	from := s.accounts[0]
+	to := s.accounts[1]
+	sendCount := 3
+
+	nonceManager := util.NewNonceManager()
+	xplac := s.xplac.
+		WithURL(s.apis[0]).
+		WithEvmRpc("http://" + s.network.Validators[0].AppConfig.JSONRPC.Address).
+		WithPrivateKey(from.PrivKey).
+		WithNonceManager(nonceManager)
+
+	account, err := xplac.LoadAccount(from.Address)
+	s.Require().NoError(err)
+
+	// check before send
+	bankBalancesMsg := types.BankBalancesMsg{
+		Address: to.Address.String(),
+	}
+	beforeRes, err := client.QueryAs[banktypes.QueryAllBalancesResponse](xplac.BankBalances(bankBalancesMsg))
+	s.Require().NoError(err)
+
+	// the sequence is not changed until the transaction is broadcasted
+	bankSendMsg := types.BankSendMsg{
+		FromAddress: from.Address.String(),
+		ToAddress:   to.Address.String(),
+		Amount:      testSendAmount,
+	}
+	_, err = xplac.BankSend(bankSendMsg).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	_, unusedSeq, ok := nonceManager.Get(from.Address.String())
+	s.Require().True(ok)
+	s.Require().Equal(account.GetSequence(), unusedSeq)
+
+	// broadcast transactions back to back without waiting the next block
+	for i := 0; i < sendCount; i++ {
+		txbytes, err := xplac.BankSend(bankSendMsg).CreateAndSignTx()
+		s.Require().NoError(err)
+
+		_, err = xplac.Broadcast(txbytes)
+		s.Require().NoError(err)
+	}
+
+	accNum, accSeq, ok := nonceManager.Get(from.Address.String())
+	s.Require().True(ok)
+	s.Require().Equal(account.GetAccountNumber(), accNum)
+	s.Require().Equal(account.GetSequence()+uint64(sendCount), accSeq)
+	s.Require().NoError(s.network.WaitForNextBlock())
+
+	// check after send
+	afterRes, err := client.QueryAs[banktypes.QueryAllBalancesResponse](xplac.BankBalances(bankBalancesMsg))
+	s.Require().NoError(err)
+
+	sendAmount, ok := sdk.NewIntFromString(testSendAmount)
+	s.Require().True(ok)
+	s.Require().Equal(
+		sendAmount.MulRaw(int64(sendCount)),
+		afterRes.Balances[0].Amount.Sub(beforeRes.Balances[0].Amount),
+	)
+
+	// the sequence is synchronized with the chain after sequence mismatch
+	nonceManager.Set(from.Address.String(), accNum, accSeq+10)
+
+	txbytes, err := xplac.BankSend(bankSendMsg).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	_, err = xplac.Broadcast(txbytes)
+	s.Require().Error(err)
+
+	_, resyncSeq, ok := nonceManager.Get(from.Address.String())
+	s.Require().True(ok)
+	s.Require().Equal(accSeq, resyncSeq)
+
+	txbytes, err = xplac.BankSend(bankSendMsg).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	_, err = xplac.Broadcast(txbytes)
+	s.Require().NoError(err)
+
+	// the nonce of evm transaction is managed together
+	sendCoinMsg := types.SendCoinMsg{
+		FromAddress: from.PubKey.Address().String(),
+		ToAddress:   to.PubKey.Address().String(),
+		Amount:      testSendAmount,
+	}
+	for i := 0; i < sendCount; i++ {
+		txbytes, err := xplac.EvmSendCoin(sendCoinMsg).CreateAndSignTx()
+		s.Require().NoError(err)
+
+		_, err = xplac.Broadcast(txbytes)
+		s.Require().NoError(err)
+	}
+
+	_, evmSeq, ok := nonceManager.Get(from.Address.String())
+	s.Require().True(ok)
+	s.Require().Equal(accSeq+1+uint64(sendCount), evmSeq)
+	s.Require().NoError(s.network.WaitForNextBlock())
+}
+
 func (s *ClientTestSuite) TestBroadcastEVM() {
 	from := s.accounts[0]
 	to := s.accounts[1]
-	s.xplac.WithPrivateKey(s.accounts[0].PrivKey).
+	s.xplac = s.xplac.WithPrivateKey(s.accounts[0].PrivKey).
 		WithURL(s.apis[0]).
 		WithEvmRpc("http://" + s.network.Validators[0].AppConfig.JSONRPC.Address)
 
@@ -156,7 +465,7 @@ func (s *ClientTestSuite) TestBroadcastEVM() {
 }
 
 func (s *ClientTestSuite) TestMultiSignature() {
-	s.xplac.WithURL(s.apis[0])
+	s.xplac = s.xplac.WithURL(s.apis[0])
 	rootDir := s.network.Validators[0].Dir
 	key1 := s.accounts[0]
 	key2 := s.accounts[1]
@@ -198,7 +507,7 @@ func (s *ClientTestSuite) TestMultiSignature() {
 	s.Require().NoError(err)
 
 	// send coin to multisig account
-	s.xplac.WithPrivateKey(key2.PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(key2.PrivKey)
 
 	bankSendMsg := types.BankSendMsg{
 		FromAddress: key2.Address.String(),
@@ -214,7 +523,7 @@ func (s *ClientTestSuite) TestMultiSignature() {
 
 	// create unsigned tx
 	unsignedTxPath := filepath.Join(rootDir, "unsignedTx.json")
-	s.xplac.WithOutputDocument(unsignedTxPath)
+	s.xplac = s.xplac.WithOutputDocument(unsignedTxPath)
 
 	bankSendMsg2 := types.BankSendMsg{
 		FromAddress: multiKeyInfo.GetAddress().String(),
@@ -226,9 +535,9 @@ func (s *ClientTestSuite) TestMultiSignature() {
 	s.Require().NoError(err)
 
 	// create signature of key1
-	s.xplac.WithPrivateKey(key1.PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(key1.PrivKey)
 	signature1Path := filepath.Join(rootDir, "signature1.json")
-	s.xplac.WithOutputDocument(signature1Path)
+	s.xplac = s.xplac.WithOutputDocument(signature1Path)
 
 	signTxMsg1 := types.SignTxMsg{
 		UnsignedFileName: unsignedTxPath,
@@ -242,9 +551,9 @@ func (s *ClientTestSuite) TestMultiSignature() {
 	s.Require().NoError(err)
 
 	// create signature of key2
-	s.xplac.WithPrivateKey(key2.PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(key2.PrivKey)
 	signature2Path := filepath.Join(rootDir, "signature2.json")
-	s.xplac.WithOutputDocument(signature2Path)
+	s.xplac = s.xplac.WithOutputDocument(signature2Path)
 
 	signTxMsg2 := types.SignTxMsg{
 		UnsignedFileName: unsignedTxPath,
@@ -258,7 +567,7 @@ func (s *ClientTestSuite) TestMultiSignature() {
 	s.Require().NoError(err)
 
 	// create multisigned transaction
-	s.xplac.WithOutputDocument("")
+	s.xplac = s.xplac.WithOutputDocument("")
 	txMultiSignMsg := types.TxMultiSignMsg{
 		FileName:     unsignedTxPath,
 		GenerateOnly: true,
@@ -292,3 +601,107 @@ func (s *ClientTestSuite) TestMultiSignature() {
 
 	s.xplac = provider.ResetXplac(s.xplac)
 }
+
+const testRevertABI = `[
+	{"type":"function","name":"withdraw","stateMutability":"nonpayable","inputs":[{"name":"amount","type":"uint256"}],"outputs":[]},
+	{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"}]}
+]`
+
+// Fake eth JSON-RPC API whose transactions are always failed.
+// eth_call is reverted with the revert data, so the reason of the failed transaction is recovered by replaying it.
+type fakeEvmRevertAPI struct {
+	revertData hexutil.Bytes
+}
+
+func (api *fakeEvmRevertAPI) SendRawTransaction(input hexutil.Bytes) (common.Hash, error) {
+	var tx evmtypes.Transaction
+	if err := tx.UnmarshalBinary(input); err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), nil
+}
+
+func (api *fakeEvmRevertAPI) GetTransactionReceipt(txHash common.Hash) *evmtypes.Receipt {
+	return &evmtypes.Receipt{
+		Status:      evmtypes.ReceiptStatusFailed,
+		TxHash:      txHash,
+		BlockNumber: big.NewInt(10),
+		Logs:        []*evmtypes.Log{},
+	}
+}
+
+func (api *fakeEvmRevertAPI) Call(args json.RawMessage, blockNumber string) (hexutil.Bytes, error) {
+	return nil, testRevertError{data: api.revertData}
+}
+
+// RPC error which has the revert data, as the error of eth_call.
+type testRevertError struct {
+	data hexutil.Bytes
+}
+
+func (e testRevertError) Error() string          { return "execution reverted" }
+func (e testRevertError) ErrorCode() int         { return 3 }
+func (e testRevertError) ErrorData() interface{} { return e.data }
+
+func TestBroadcastEvmFailedTx(t *testing.T) {
+	contractAbi, err := abi.JSON(strings.NewReader(testRevertABI))
+	require.NoError(t, err)
+	abiErr := contractAbi.Errors["InsufficientBalance"]
+	packed, err := abiErr.Inputs.Pack(big.NewInt(10))
+	require.NoError(t, err)
+
+	rpcServer := rpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("eth", &fakeEvmRevertAPI{revertData: append(abiErr.ID.Bytes()[:4], packed...)}))
+	server := httptest.NewServer(rpcServer)
+	defer server.Close()
+
+	mnemonic, err := key.NewMnemonic()
+	require.NoError(t, err)
+	privKey, err := key.NewPrivKey(mnemonic)
+	require.NoError(t, err)
+
+	xplac := client.NewXplaClient(testutil.TestChainId).
+		WithPrivateKey(privKey).
+		WithGasLimit("100000").
+		WithEvmRpc(server.URL).
+		WithBroadcastMode("block")
+	invokeXplac := xplac.InvokeSolidityContract(types.InvokeSolContractMsg{
+		ContractAddress:      "0x80E123317190cAf36292A04776b0De020136526F",
+		ContractFuncCallName: "withdraw",
+		Args:                 []interface{}{big.NewInt(100)},
+		ABI:                  testRevertABI,
+	})
+	txbytes, err := invokeXplac.CreateAndSignTx()
+	require.NoError(t, err)
+
+	// The custom error is decoded by the ABI of the invoke msg of the client.
+	broadcasts := map[string]func() (*types.TxRes, error){
+		"block": func() (*types.TxRes, error) {
+			return invokeXplac.Broadcast(txbytes)
+		},
+		"wait": func() (*types.TxRes, error) {
+			return invokeXplac.BroadcastAndWait(txbytes, 10*time.Second)
+		},
+	}
+	for name, broadcast := range broadcasts {
+		t.Run(name, func(t *testing.T) {
+			res, err := broadcast()
+			require.Error(t, err)
+			require.Equal(t, evmtypes.ReceiptStatusFailed, res.EvmReceipt.Status)
+
+			var revertErr *mevm.RevertError
+			require.True(t, errors.As(err, &revertErr))
+			require.Equal(t, res.EvmTxHash, revertErr.TxHash)
+			require.Equal(t, mevm.RevertKindCustom, revertErr.Kind)
+			require.Equal(t, "InsufficientBalance", revertErr.ErrorName)
+			require.Equal(t, big.NewInt(10), revertErr.Args["available"])
+		})
+	}
+
+	// The client which does not have the ABI cannot decode the custom error.
+	_, err = xplac.Broadcast(txbytes)
+	var revertErr *mevm.RevertError
+	require.True(t, errors.As(err, &revertErr))
+	require.Equal(t, mevm.RevertKindCustom, revertErr.Kind)
+	require.Empty(t, revertErr.ErrorName)
+}
@@ -15,6 +15,7 @@ const (
 	userInfoUrl  = "/cosmos/auth/v1beta1/accounts/"
 	simulateUrl  = "/cosmos/tx/v1beta1/simulate"
 	broadcastUrl = "/cosmos/tx/v1beta1/txs"
+	txUrl        = "/cosmos/tx/v1beta1/txs/"
 )
 
 // LoadAccount gets the account info by AccAddress
@@ -23,8 +24,8 @@ func (xplac *xplaClient) LoadAccount(address sdk.AccAddress) (res authtypes.Acco
 
 	if xplac.GetGrpcUrl() == "" {
 
+		// The VP is attached to the header by the VP transport.
 		out, err := util.CtxHttpClient("GET", xplac.GetLcdURL()+userInfoUrl+address.String(), nil, xplac.GetContext())
-		// out, err := util.CtxHttpClient("POST", xplac.Opts.LcdURL+userInfoUrl+address.String(), xplac.GetVPByte(), xplac.Context)
 		if err != nil {
 			return nil, err
 		}
@@ -37,7 +38,6 @@ func (xplac *xplaClient) LoadAccount(address sdk.AccAddress) (res authtypes.Acco
 		return response.Account.GetCachedValue().(authtypes.AccountI), nil
 
 	} else {
-		xplac = VPInputGrpcContext(xplac)
 		queryClient := authtypes.NewQueryClient(xplac.GetGrpcClient())
 		queryAccountRequest := authtypes.QueryAccountRequest{
 			Address: address.String(),
@@ -60,13 +60,18 @@ func (xplac *xplaClient) LoadAccount(address sdk.AccAddress) (res authtypes.Acco
 // Simulate tx and get response
 // If xpla client has gRPC client, query simulation by using gRPC
 func (xplac *xplaClient) Simulate(txbuilder cmclient.TxBuilder) (*sdktx.SimulateResponse, error) {
+	signer := xplac.GetSigner()
+	if signer == nil {
+		return nil, util.LogErr(errors.ErrNotSatisfiedOptions, "need private key or signer of xpla client's option")
+	}
+
 	seq, err := util.FromStringToUint64(xplac.GetSequence())
 	if err != nil {
 		return nil, err
 	}
 
 	sig := signing.SignatureV2{
-		PubKey: xplac.GetPrivateKey().PubKey(),
+		PubKey: signer.PubKey(),
 		Data: &signing.SingleSignatureData{
 			SignMode: xplac.GetSignMode(),
 		},
@@ -104,7 +109,6 @@ func (xplac *xplaClient) Simulate(txbuilder cmclient.TxBuilder) (*sdktx.Simulate
 
 		return &response, nil
 	} else {
-		xplac = VPInputGrpcContext(xplac)
 		serviceClient := sdktx.NewServiceClient(xplac.GetGrpcClient())
 		simulateRequest := sdktx.SimulateRequest{
 			TxBytes: txBytes,
@@ -118,3 +122,34 @@ func (xplac *xplaClient) Simulate(txbuilder cmclient.TxBuilder) (*sdktx.Simulate
 		return response, nil
 	}
 }
+
+// Get the response of the transaction which is included in a block by the transaction hash.
+// If xpla client has gRPC client, query the transaction by using gRPC
+func getTxResponse(xplac *xplaClient, txHash string) (*sdk.TxResponse, error) {
+	if xplac.GetGrpcUrl() == "" {
+		out, err := util.CtxHttpClient("GET", xplac.GetLcdURL()+txUrl+txHash, nil, xplac.GetContext())
+		if err != nil {
+			return nil, err
+		}
+
+		var response sdktx.GetTxResponse
+		err = xplac.GetEncoding().Marshaler.UnmarshalJSON(out, &response)
+		if err != nil {
+			return nil, util.LogErr(errors.ErrFailedToUnmarshal, err)
+		}
+
+		return response.TxResponse, nil
+	} else {
+		serviceClient := sdktx.NewServiceClient(xplac.GetGrpcClient())
+		getTxRequest := sdktx.GetTxRequest{
+			Hash: txHash,
+		}
+
+		response, err := serviceClient.GetTx(xplac.GetContext(), &getTxRequest)
+		if err != nil {
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
+		}
+
+		return response.TxResponse, nil
+	}
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"context"
+
+	"github.com/Moonyongjung/xpriv.go/core"
+	"github.com/Moonyongjung/xpriv.go/provider"
+)
+
+// Iterator of pages for the paginated query.
+// It follows the next key of the page response until the last page, by using gRPC or LCD.
+//
+// e.g.
+//
+//	it := client.NewPageIterator[authtypes.QueryAccountsResponse](ctx, xplac.Accounts())
+//	for it.Next() {
+//	    accounts := it.Page().Accounts
+//	}
+//	if it.Err() != nil { ... }
+type PageIterator[T any] struct {
+	*core.PageIterator[T]
+}
+
+// Make the page iterator of the query which is set by the module method of the xpla client.
+// The pagination of the xpla client is applied to the first page, and the limit of it is used
+// as the page size of next pages. The context is used for all queries of pages.
+func NewPageIterator[T any](ctx context.Context, xplac provider.XplaClient) *PageIterator[T] {
+	return &PageIterator[T]{core.NewPageIterator[T](ctx, xplac)}
+}
+
+// Query all pages of the paginated query and collect items of pages.
+// The items function selects items in the page response, e.g. the accounts of the accounts response.
+// If max items is larger than 0, the query is stopped when the number of collected items reaches max items.
+//
+// e.g.
+//
+//	accounts, err := client.QueryAll(ctx, xplac.Accounts(), func(res *authtypes.QueryAccountsResponse) []*codectypes.Any {
+//	    return res.Accounts
+//	}, 1000)
+func QueryAll[T any, I any](ctx context.Context, xplac provider.XplaClient, items func(*T) []I, maxItems ...int) ([]I, error) {
+	return core.QueryAll(ctx, xplac, items, maxItems...)
+}
@@ -0,0 +1,64 @@
+package client_test
+
+import (
+	"context"
+
+	"github.com/Moonyongjung/xpriv.go/client"
+	"github.com/Moonyongjung/xpriv.go/provider"
+	"github.com/Moonyongjung/xpriv.go/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func (s *ClientTestSuite) TestQueryAll() {
+	accountsItems := func(res *authtypes.QueryAccountsResponse) []*codectypes.Any {
+		return res.Accounts
+	}
+
+	for i, api := range s.apis {
+		var xplac provider.XplaClient
+		if i == 0 {
+			xplac = s.xplac.WithURL(api)
+		} else {
+			xplac = s.xplac.WithGrpc(api)
+		}
+
+		expected, err := client.QueryAs[authtypes.QueryAccountsResponse](xplac.Accounts())
+		s.Require().NoError(err)
+		s.Require().Greater(len(expected.Accounts), 2)
+
+		// one account per page
+		pageXplac := xplac.WithPagination(types.Pagination{Limit: 1})
+
+		it := client.NewPageIterator[authtypes.QueryAccountsResponse](context.Background(), pageXplac.Accounts())
+		pages := 0
+		for it.Next() {
+			s.Require().Len(it.Page().Accounts, 1)
+			pages++
+		}
+		s.Require().NoError(it.Err())
+		s.Require().Equal(len(expected.Accounts), pages)
+
+		accounts, err := client.QueryAll(context.Background(), pageXplac.Accounts(), accountsItems)
+		s.Require().NoError(err)
+		s.Require().Equal(expected.Accounts, accounts)
+
+		// max items
+		accounts, err = client.QueryAll(context.Background(), xplac.Accounts(), accountsItems, 2)
+		s.Require().NoError(err)
+		s.Require().Equal(expected.Accounts[:2], accounts)
+
+		// cancelled context
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err = client.QueryAll(ctx, pageXplac.Accounts(), accountsItems)
+		s.Require().Error(err)
+	}
+
+	// not paginated query
+	_, err := client.QueryAll(context.Background(), s.xplac.WithGrpc(s.apis[1]).AuthParams(), func(res *authtypes.QueryParamsResponse) []authtypes.Params {
+		return []authtypes.Params{res.Params}
+	})
+	s.Require().Error(err)
+}
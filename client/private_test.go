@@ -0,0 +1,125 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Moonyongjung/xpriv.go/client"
+	"github.com/Moonyongjung/xpriv.go/core/private"
+	"github.com/Moonyongjung/xpriv.go/key"
+	"github.com/Moonyongjung/xpriv.go/provider"
+	"github.com/Moonyongjung/xpriv.go/types"
+
+	didtypes "github.com/Moonyongjung/xpla-private-chain/x/did/types"
+	privtypes "github.com/Moonyongjung/xpla-private-chain/x/private/types"
+)
+
+const testDIDPassphrase = "passphrase"
+
+// Onboard the participant by the initial admin, and return the xpla client and the DID key of the participant.
+func (s *ClientTestSuite) onboardTestParticipant(didKeyPath string) (provider.XplaClient, string) {
+	timeout := 30 * time.Second
+
+	adminXplac := s.xplac.WithURL(s.apis[0]).WithPrivateKey(s.accounts[0].PrivKey)
+	participantXplac := s.xplac.WithURL(s.apis[0]).WithPrivateKey(s.accounts[1].PrivKey)
+
+	// create the DID of the admin and set the initial admin
+	adminMnemonic, err := key.NewMnemonic()
+	s.Require().NoError(err)
+
+	createDIDXplac := adminXplac.CreateDID(types.CreateDIDMsg{
+		DIDMnemonic:    adminMnemonic,
+		DIDPassphrase:  testDIDPassphrase,
+		SaveDIDKeyPath: didKeyPath,
+		Moniker:        "admin",
+	})
+	s.Require().NoError(createDIDXplac.GetErr())
+	createMsg, ok := createDIDXplac.GetMsg().(didtypes.MsgCreateDID)
+	s.Require().True(ok)
+
+	txbytes, err := createDIDXplac.CreateAndSignTx()
+	s.Require().NoError(err)
+	_, err = adminXplac.BroadcastAndWait(txbytes, timeout)
+	s.Require().NoError(err)
+
+	adminDIDKey := createMsg.Did + "#key1"
+	txbytes, err = adminXplac.InitialAdmin(types.InitialAdminMsg{
+		InitAdminDIDKey: adminDIDKey,
+		DIDPassphrase:   testDIDPassphrase,
+		DIDKeyPath:      didKeyPath,
+	}).CreateAndSignTx()
+	s.Require().NoError(err)
+	_, err = adminXplac.BroadcastAndWait(txbytes, timeout)
+	s.Require().NoError(err)
+
+	// onboard the participant which is accepted by the admin
+	participantMnemonic, err := key.NewMnemonic()
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	_, progress, err := private.Onboard(ctx, participantXplac, private.OnboardingOptions{
+		DIDMnemonic:   participantMnemonic,
+		Moniker:       "participant",
+		DIDPassphrase: testDIDPassphrase,
+		DIDKeyPath:    didKeyPath,
+		Admin: &private.OnboardingAdmin{
+			Xplac:         adminXplac,
+			DIDKey:        adminDIDKey,
+			DIDPassphrase: testDIDPassphrase,
+			DIDKeyPath:    didKeyPath,
+		},
+		VPPath:    didKeyPath + "/vp.json",
+		TxTimeout: timeout,
+	})
+	s.Require().NoError(err)
+
+	return participantXplac, progress.DIDKey
+}
+
+func (s *ClientTestSuite) TestVerifyIssuedVC() {
+	didKeyPath := s.T().TempDir()
+	xplac, didKey := s.onboardTestParticipant(didKeyPath)
+
+	signXplac := xplac.GenDIDSign(types.GenDIDSignMsg{
+		DIDKey:        didKey,
+		DIDPassphrase: testDIDPassphrase,
+		DIDKeyPath:    didKeyPath,
+	})
+	s.Require().NoError(signXplac.GetErr())
+	didSign, ok := signXplac.GetMsg().(string)
+	s.Require().True(ok)
+
+	// the VC which is issued by the chain is verified by the DID document of the issuer on the chain
+	res, err := client.QueryAs[privtypes.QueryIssueVCResponse](xplac.IssueVC(types.IssueVCMsg{
+		DIDKey:        didKey,
+		DIDSignBase64: didSign,
+	}))
+	s.Require().NoError(err)
+
+	verifier := private.NewVerifier(xplac)
+	vc, err := verifier.VerifyVCJson([]byte(res.Vc))
+	s.Require().NoError(err)
+	s.Require().NotEmpty(vc.Issuer)
+	s.Require().NotNil(vc.Proof)
+
+	// the VP which is obtained by the onboarding
+	vpJson, err := os.ReadFile(didKeyPath + "/vp.json")
+	s.Require().NoError(err)
+	vp, err := verifier.VerifyVPJson(vpJson)
+	s.Require().NoError(err)
+	s.Require().Equal(strings.Split(didKey, "#")[0], vp.Holder)
+
+	// tampered VC
+	var vcMap map[string]interface{}
+	s.Require().NoError(json.Unmarshal([]byte(res.Vc), &vcMap))
+	vcMap["id"] = "tampered"
+	tampered, err := json.Marshal(vcMap)
+	s.Require().NoError(err)
+	_, err = verifier.VerifyVCJson(tampered)
+	s.Require().Error(err)
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"github.com/Moonyongjung/xpriv.go/controller"
 	"github.com/Moonyongjung/xpriv.go/core"
+	"github.com/Moonyongjung/xpriv.go/provider"
 
 	mevm "github.com/Moonyongjung/xpriv.go/core/evm"
 	"github.com/Moonyongjung/xpriv.go/types"
@@ -13,24 +14,64 @@ import (
 // Query transactions and xpla blockchain information.
 // Execute a query of functions for all modules.
 // After module query messages are generated, it receives query messages/information to the xpla client receiver and transmits a query message.
+// The response is returned as string type, use QueryTyped in order to get the typed response.
 func (xplac *xplaClient) Query() (string, error) {
+	res, err := xplac.query()
+	if err != nil {
+		return "", err
+	}
+
+	return res.String()
+}
+
+// Query and decode the response to the typed response of the query.
+// The argument must be a pointer of the response type, e.g. *banktypes.QueryAllBalancesResponse.
+// If the query is requested by gRPC, the received response is assigned without marshaling.
+func (xplac *xplaClient) QueryTyped(res interface{}) error {
+	queryRes, err := xplac.query()
+	if err != nil {
+		return err
+	}
+
+	return queryRes.Decode(res)
+}
+
+// Query by using the xpla client and return the response as the generic type.
+//
+// e.g.
+//
+//	res, err := client.QueryAs[banktypes.QueryAllBalancesResponse](xplac.BankBalances(bankBalancesMsg))
+func QueryAs[T any](xplac provider.XplaClient) (*T, error) {
+	var res T
+	err := xplac.QueryTyped(&res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// Route the query to the module and get the query response.
+func (xplac *xplaClient) query() (*core.QueryResponse, error) {
 	if xplac.GetErr() != nil {
-		return "", xplac.GetErr()
+		return nil, xplac.GetErr()
 	}
 
 	if xplac.GetGrpcUrl() == "" && xplac.GetLcdURL() == "" {
 		if xplac.GetModule() == mevm.EvmModule {
 			if xplac.GetEvmRpc() == "" {
-				return "", util.LogErr(errors.ErrNotSatisfiedOptions, "evm JSON-RPC URL must exist")
+				return nil, util.LogErr(errors.ErrNotSatisfiedOptions, "evm JSON-RPC URL must exist")
 			}
 
 		} else {
-			return "", util.LogErr(errors.ErrNotSatisfiedOptions, "at least one of the gRPC URL or LCD URL must exist for query")
+			return nil, util.LogErr(errors.ErrNotSatisfiedOptions, "at least one of the gRPC URL or LCD URL must exist for query")
 		}
 	}
-	queryClient := core.NewIxplaClient(xplac, setQueryType(xplac))
 
-	return controller.Controller().Get(xplac.GetModule()).NewQueryRouter(*queryClient)
+	return requestWithVP(xplac, func(c *xplaClient) (*core.QueryResponse, error) {
+		queryClient := core.NewIxplaClient(c, setQueryType(c))
+		return controller.Controller().Get(c.GetModule()).NewQueryRouter(*queryClient)
+	})
 }
 
 func setQueryType(xplac *xplaClient) uint8 {
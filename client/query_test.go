@@ -0,0 +1,208 @@
+package client_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/Moonyongjung/xpriv.go/client"
+	"github.com/Moonyongjung/xpriv.go/provider"
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/util"
+	"github.com/Moonyongjung/xpriv.go/util/testutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ClientTestSuite) TestParallelQuery() {
+	var xplacs []provider.XplaClient
+	xplacs = append(xplacs, s.xplac.WithURL(s.apis[0]))
+	xplacs = append(xplacs, s.xplac.WithGrpc(s.apis[1]))
+
+	addrs := []string{
+		s.accounts[0].Address.String(),
+		s.accounts[1].Address.String(),
+		s.network.Validators[0].Address.String(),
+	}
+
+	expected := make(map[string]banktypes.QueryAllBalancesResponse)
+	for _, addr := range addrs {
+		res, err := client.QueryAs[banktypes.QueryAllBalancesResponse](
+			xplacs[1].BankBalances(types.BankBalancesMsg{Address: addr}),
+		)
+		s.Require().NoError(err)
+		expected[addr] = *res
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 30; i++ {
+		for _, xplac := range xplacs {
+			for _, addr := range addrs {
+				wg.Add(1)
+				go func(xplac provider.XplaClient, addr string) {
+					defer wg.Done()
+
+					res, err := client.QueryAs[banktypes.QueryAllBalancesResponse](
+						xplac.BankBalances(types.BankBalancesMsg{Address: addr}),
+					)
+					if s.NoError(err) {
+						s.Equal(expected[addr].Balances, res.Balances)
+					}
+				}(xplac, addr)
+			}
+		}
+
+		// pagination is only applied to gRPC query
+		wg.Add(1)
+		go func(limit uint64) {
+			defer wg.Done()
+
+			res, err := client.QueryAs[banktypes.QueryTotalSupplyResponse](
+				xplacs[1].WithPagination(types.Pagination{Limit: limit}).Total(),
+			)
+			if s.NoError(err) {
+				s.Len(res.Supply, int(limit))
+			}
+		}(uint64(i%2 + 1))
+	}
+	wg.Wait()
+}
+
+type countingVPProvider struct {
+	mu       sync.Mutex
+	vp       []byte
+	err      error
+	vpCalled int
+}
+
+func (p *countingVPProvider) VP(ctx context.Context) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.vpCalled++
+	return p.vp, p.err
+}
+
+func (p *countingVPProvider) Refresh(ctx context.Context) ([]byte, error) {
+	return p.VP(ctx)
+}
+
+func (s *ClientTestSuite) TestQueryWithVPProvider() {
+	vpProvider := &countingVPProvider{vp: []byte(`{}`)}
+	xplac := s.xplac.WithGrpc(s.apis[1]).WithVPProvider(vpProvider)
+	s.Require().True(xplac.GetUseVP())
+
+	bankBalancesMsg := types.BankBalancesMsg{Address: s.accounts[0].Address.String()}
+
+	// the VP provider is consulted per request
+	for i := 1; i <= 2; i++ {
+		_, err := xplac.BankBalances(bankBalancesMsg).Query()
+		s.Require().NoError(err)
+		s.Require().Equal(i, vpProvider.vpCalled)
+	}
+
+	// the error of the VP provider is returned
+	vpProvider.err = errors.New("no vp")
+	_, err := xplac.BankBalances(bankBalancesMsg).Query()
+	s.Require().Error(err)
+}
+
+// VP provider which provides the old VP until it is refreshed.
+type refreshingVPProvider struct {
+	mu            sync.Mutex
+	refreshed     bool
+	refreshCalled int
+}
+
+func (p *refreshingVPProvider) VP(ctx context.Context) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.refreshed {
+		return []byte(testRefreshedVP), nil
+	}
+	return []byte(`{"holder":"old"}`), nil
+}
+
+func (p *refreshingVPProvider) Refresh(ctx context.Context) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.refreshed = true
+	p.refreshCalled++
+	return []byte(testRefreshedVP), nil
+}
+
+const testRefreshedVP = `{"holder":"new"}`
+
+func TestQueryRetryWithRefreshedVP(t *testing.T) {
+	refreshedVP := base64.StdEncoding.EncodeToString([]byte(testRefreshedVP))
+	address := sdk.AccAddress(make([]byte, 20)).String()
+
+	// LCD responds 401 if the VP is not the refreshed VP
+	unauthorizedStatus := http.StatusUnauthorized
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(util.VPHeader) != refreshedVP {
+			w.WriteHeader(unauthorizedStatus)
+			return
+		}
+		w.Write([]byte(`{"balances":[],"pagination":null}`))
+	}))
+	defer server.Close()
+
+	// gRPC responds unauthenticated if the VP is not the refreshed VP
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	banktypes.RegisterQueryServer(grpcServer, &vpBankQueryServer{vp: refreshedVP})
+	go grpcServer.Serve(listener)
+	defer grpcServer.Stop()
+
+	xplacs := map[string]provider.XplaClient{
+		"lcd":  client.NewXplaClient(testutil.TestChainId).WithURL(server.URL),
+		"grpc": client.NewXplaClient(testutil.TestChainId).WithGrpc(listener.Addr().String()),
+	}
+	for name, xplac := range xplacs {
+		t.Run(name, func(t *testing.T) {
+			// the request is retried with the refreshed VP
+			vpProvider := &refreshingVPProvider{}
+			_, err := xplac.WithVPProvider(vpProvider).BankBalances(types.BankBalancesMsg{Address: address}).Query()
+			require.NoError(t, err)
+			require.Equal(t, 1, vpProvider.refreshCalled)
+
+			// the refreshed VP is used without refreshing again
+			_, err = xplac.WithVPProvider(vpProvider).BankBalances(types.BankBalancesMsg{Address: address}).Query()
+			require.NoError(t, err)
+			require.Equal(t, 1, vpProvider.refreshCalled)
+		})
+	}
+
+	// the VP is not refreshed if the error is not occurred by the authorization
+	unauthorizedStatus = http.StatusInternalServerError
+	vpProvider := &refreshingVPProvider{}
+	_, err = xplacs["lcd"].WithVPProvider(vpProvider).BankBalances(types.BankBalancesMsg{Address: address}).Query()
+	require.Error(t, err)
+	require.Equal(t, 0, vpProvider.refreshCalled)
+}
+
+// Bank query server which checks the VP of the gRPC metadata.
+type vpBankQueryServer struct {
+	banktypes.UnimplementedQueryServer
+	vp string
+}
+
+func (s *vpBankQueryServer) AllBalances(ctx context.Context, req *banktypes.QueryAllBalancesRequest) (*banktypes.QueryAllBalancesResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if vps := md.Get(util.VPHeader); len(vps) == 0 || vps[0] != s.vp {
+		return nil, status.Error(codes.Unauthenticated, "invalid VP")
+	}
+	return &banktypes.QueryAllBalancesResponse{}, nil
+}
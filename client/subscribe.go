@@ -0,0 +1,313 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmquery "github.com/tendermint/tendermint/libs/pubsub/query"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	jsonrpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	websocketEndpoint          = "/websocket"
+	defaultSubscribeBufferSize = 100
+	minSubscribeReconnectWait  = time.Second
+	maxSubscribeReconnectWait  = 30 * time.Second
+)
+
+// Subscribe new blocks by using the websocket of tendermint RPC.
+// Transactions in the block are decoded by the encoding configuration of the xpla client.
+// Events are delivered until the context is done, and then the event channel and the error channel are closed.
+// Errors which are occurred while receiving events (e.g. disconnection, decoding failure) are delivered
+// to the error channel, and the subscription is reconnected when the connection is lost.
+// The block is delivered even though some transactions of it cannot be decoded, and the failures are delivered
+// to the error channel.
+func (xplac *xplaClient) SubscribeNewBlock(ctx context.Context, options ...types.SubscribeOptions) (<-chan types.BlockEvent, <-chan error, error) {
+	query := tmtypes.QueryForEvent(tmtypes.EventNewBlock).String()
+
+	return subscribeEvents(ctx, xplac, query, options, func(result ctypes.ResultEvent, report func(error)) (types.BlockEvent, error) {
+		data, ok := result.Data.(tmtypes.EventDataNewBlock)
+		if !ok {
+			return types.BlockEvent{}, util.LogErr(errors.ErrParse, "invalid new block event data type")
+		}
+
+		var txs []sdk.Tx
+		for i, txBytes := range data.Block.Txs {
+			tx, err := xplac.GetEncoding().TxConfig.TxDecoder()(txBytes)
+			if err != nil {
+				report(util.LogErr(errors.ErrParse, "tx", i, "of block", data.Block.Height, ":", err))
+			}
+			txs = append(txs, tx)
+		}
+
+		return types.BlockEvent{
+			Block:            data.Block,
+			Txs:              txs,
+			ResultBeginBlock: data.ResultBeginBlock,
+			ResultEndBlock:   data.ResultEndBlock,
+		}, nil
+	})
+}
+
+// Subscribe transactions which are matched with the event query by using the websocket of tendermint RPC.
+// The query is the condition of tendermint events, e.g. "message.action='/xpla.private.v1beta1.MsgParticipate'",
+// and the condition of transaction event type is added if the query does not have "tm.event".
+// The transaction and its events are decoded by the encoding configuration of the xpla client.
+// Events are delivered until the context is done, and then the event channel and the error channel are closed.
+func (xplac *xplaClient) SubscribeTx(ctx context.Context, query string, options ...types.SubscribeOptions) (<-chan types.TxEvent, <-chan error, error) {
+	txQuery := tmtypes.QueryForEvent(tmtypes.EventTx).String()
+	if query != "" && !strings.Contains(query, tmtypes.EventTypeKey) {
+		txQuery = txQuery + " AND " + query
+	} else if query != "" {
+		txQuery = query
+	}
+
+	return subscribeEvents(ctx, xplac, txQuery, options, func(result ctypes.ResultEvent, _ func(error)) (types.TxEvent, error) {
+		data, ok := result.Data.(tmtypes.EventDataTx)
+		if !ok {
+			return types.TxEvent{}, util.LogErr(errors.ErrParse, "invalid tx event data type")
+		}
+
+		tx, err := xplac.GetEncoding().TxConfig.TxDecoder()(data.Tx)
+		if err != nil {
+			return types.TxEvent{}, util.LogErr(errors.ErrParse, err)
+		}
+
+		// Events which are not emitted as the typed event are only included in the string events.
+		var typedEvents []proto.Message
+		for _, event := range data.Result.Events {
+			typedEvent, err := sdk.ParseTypedEvent(event)
+			if err == nil {
+				typedEvents = append(typedEvents, typedEvent)
+			}
+		}
+
+		return types.TxEvent{
+			Height:      data.Height,
+			Index:       data.Index,
+			TxHash:      fmt.Sprintf("%X", tmtypes.Tx(data.Tx).Hash()),
+			Tx:          tx,
+			Result:      data.Result,
+			Events:      sdk.StringifyEvents(data.Result.Events),
+			TypedEvents: typedEvents,
+		}, nil
+	})
+}
+
+// Subscribe the query and deliver events which are decoded by the decode function.
+// The first connection is made before returning, so the invalid RPC URL or query is returned as the error.
+// The decode function reports errors which do not prevent delivering the event, and the event is dropped
+// only when the decode function returns the error.
+func subscribeEvents[T any](
+	ctx context.Context,
+	xplac *xplaClient,
+	query string,
+	options []types.SubscribeOptions,
+	decode func(ctypes.ResultEvent, func(error)) (T, error),
+) (<-chan T, <-chan error, error) {
+	if xplac.GetRpc() == "" {
+		return nil, nil, util.LogErr(errors.ErrNotSatisfiedOptions, "need RPC URL to subscribe events")
+	}
+
+	if _, err := tmquery.New(query); err != nil {
+		return nil, nil, util.LogErr(errors.ErrParse, err)
+	}
+
+	var option types.SubscribeOptions
+	if len(options) > 0 {
+		option = options[0]
+	}
+	if option.BufferSize <= 0 {
+		option.BufferSize = defaultSubscribeBufferSize
+	}
+
+	sub, err := dialSubscription(ctx, xplac.GetRpc(), query)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events := make(chan T, option.BufferSize)
+	errs := make(chan error, option.BufferSize)
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+
+		for {
+			err := receiveEvents(ctx, sub, option, decode, events, errs)
+			sub.ws.Stop()
+			if ctx.Err() != nil {
+				return
+			}
+			sendSubscribeErr(errs, err)
+
+			sub = reconnectSubscription(ctx, xplac.GetRpc(), query, errs)
+			if sub == nil {
+				return
+			}
+		}
+	}()
+
+	return events, errs, nil
+}
+
+// Websocket client of tendermint RPC which subscribes the query.
+type subscription struct {
+	ws *jsonrpcclient.WSClient
+
+	// It receives a value when the websocket client is reconnected by itself.
+	reconnected chan struct{}
+}
+
+// Connect to the websocket of tendermint RPC and subscribe the query.
+// The websocket client redials once when the connection is lost, but subscriptions are not kept after the redial,
+// so the reconnection is regarded as the disconnection, and the subscription is made again by the new websocket client.
+func dialSubscription(ctx context.Context, rpcUrl string, query string) (*subscription, error) {
+	reconnected := make(chan struct{}, 1)
+	ws, err := jsonrpcclient.NewWS(
+		rpcUrl,
+		websocketEndpoint,
+		jsonrpcclient.MaxReconnectAttempts(0),
+		jsonrpcclient.OnReconnect(func() {
+			select {
+			case reconnected <- struct{}{}:
+			default:
+			}
+		}),
+	)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrRpcRequest, err)
+	}
+
+	if err := ws.Start(); err != nil {
+		return nil, util.LogErr(errors.ErrRpcRequest, err)
+	}
+
+	if err := ws.Subscribe(ctx, query); err != nil {
+		ws.Stop()
+		return nil, util.LogErr(errors.ErrRpcRequest, err)
+	}
+
+	return &subscription{ws: ws, reconnected: reconnected}, nil
+}
+
+// Try to subscribe again with exponential backoff until the context is done.
+// It returns nil when the context is done.
+func reconnectSubscription(ctx context.Context, rpcUrl string, query string, errs chan<- error) *subscription {
+	wait := minSubscribeReconnectWait
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+
+		sub, err := dialSubscription(ctx, rpcUrl, query)
+		if err == nil {
+			return sub
+		}
+		sendSubscribeErr(errs, err)
+
+		wait = wait * 2
+		if wait > maxSubscribeReconnectWait {
+			wait = maxSubscribeReconnectWait
+		}
+	}
+}
+
+// Receive events from the websocket client until the connection is lost or the context is done.
+// It returns the reason of the disconnection.
+func receiveEvents[T any](
+	ctx context.Context,
+	sub *subscription,
+	option types.SubscribeOptions,
+	decode func(ctypes.ResultEvent, func(error)) (T, error),
+	events chan<- T,
+	errs chan<- error,
+) error {
+	report := func(err error) {
+		sendSubscribeErr(errs, err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-sub.reconnected:
+			return util.LogErr(errors.ErrRpcRequest, "websocket is reconnected without the subscription")
+
+		case res, ok := <-sub.ws.ResponsesCh:
+			if !ok {
+				return util.LogErr(errors.ErrRpcRequest, "websocket connection is closed")
+			}
+
+			// The error response is occurred when the subscription is cancelled by tendermint,
+			// e.g. the client is not pulling messages fast enough.
+			if res.Error != nil {
+				return util.LogErr(errors.ErrRpcRequest, res.Error)
+			}
+
+			var result ctypes.ResultEvent
+			if err := tmjson.Unmarshal(res.Result, &result); err != nil {
+				sendSubscribeErr(errs, util.LogErr(errors.ErrFailedToUnmarshal, err))
+				continue
+			}
+
+			// The response of the subscribe request has no event data.
+			if result.Data == nil {
+				continue
+			}
+
+			event, err := decode(result, report)
+			if err != nil {
+				sendSubscribeErr(errs, err)
+				continue
+			}
+
+			if !deliverEvent(ctx, option, event, events, errs) {
+				return nil
+			}
+		}
+	}
+}
+
+// Deliver the event to the event channel by the option.
+// It returns false when the context is done while waiting for the buffer of the event channel.
+func deliverEvent[T any](ctx context.Context, option types.SubscribeOptions, event T, events chan<- T, errs chan<- error) bool {
+	if option.DropWhenFull {
+		select {
+		case events <- event:
+		default:
+			sendSubscribeErr(errs, util.LogErr(errors.ErrRpcRequest, "event is dropped because the event channel is full"))
+		}
+		return true
+	}
+
+	select {
+	case events <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Deliver the error of the subscription.
+// The error is dropped if the error channel is full, in order not to block receiving events.
+func sendSubscribeErr(errs chan<- error, err error) {
+	select {
+	case errs <- err:
+	default:
+	}
+}
@@ -0,0 +1,216 @@
+package client
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	mevm "github.com/Moonyongjung/xpriv.go/core/evm"
+	"github.com/Moonyongjung/xpriv.go/provider"
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	evmtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+const defaultEvmLogPollInterval = time.Second
+
+// The message of the error which is responded by the node for the unknown filter,
+// e.g. "filter not found" or "filter 0x1 not found".
+var evmFilterNotFoundRegexp = regexp.MustCompile(`filter (\S+ )?not found`)
+
+// Subscribe evm logs which are matched with the filter, and deliver events which are decoded by the ABI JSON of the contract.
+// The log filter is installed by EthNewFilter, and changes of the filter are polled by EthGetFilterChanges
+// at the poll interval of the options. The filter is installed again if it is removed by the node, e.g. expired,
+// and logs which are emitted while the filter is removed are requested by EthGetLogs from the last block.
+// Removed logs by the chain reorganization are also delivered, and Log.Removed of them is true.
+// Events are delivered until the context is done, and then the filter is uninstalled and channels are closed.
+func (xplac *xplaClient) SubscribeEvmLogs(ctx context.Context, filterMsg types.EthNewFilterMsg, abiJson string, options ...types.SubscribeOptions) (<-chan types.EvmEventLog, <-chan error, error) {
+	if xplac.GetEvmRpc() == "" {
+		return nil, nil, util.LogErr(errors.ErrNotSatisfiedOptions, "need evm JSON-RPC URL to subscribe evm logs")
+	}
+
+	contractAbi, err := abi.JSON(strings.NewReader(abiJson))
+	if err != nil {
+		return nil, nil, util.LogErr(errors.ErrParse, err)
+	}
+
+	var option types.SubscribeOptions
+	if len(options) > 0 {
+		option = options[0]
+	}
+	if option.BufferSize <= 0 {
+		option.BufferSize = defaultSubscribeBufferSize
+	}
+	if option.PollInterval <= 0 {
+		option.PollInterval = defaultEvmLogPollInterval
+	}
+
+	c := xplac.WithContext(ctx)
+	// Logs after the block are delivered by the filter, or requested by EthGetLogs if the filter is removed.
+	lastBlock, err := evmBlockNumber(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	filterId, err := newEvmLogFilter(c, filterMsg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events := make(chan types.EvmEventLog, option.BufferSize)
+	errs := make(chan error, option.BufferSize)
+
+	go func() {
+		defer close(events)
+		defer close(errs)
+		defer func() {
+			if filterId != "" {
+				// The context of the subscription is done, so the filter is uninstalled by the context of the xpla client.
+				xplac.EthUninstallFilter(types.EthUninstallFilterMsg{FilterId: filterId}).Query()
+			}
+		}()
+
+		// Logs until the block are requested by EthGetLogs after the filter is installed again,
+		// so they are skipped in changes of the filter.
+		var backfilledBlock uint64
+		backfill := false
+
+		deliverLogs := func(logs []evmtypes.Log) bool {
+			for _, log := range logs {
+				if !log.Removed {
+					if log.BlockNumber <= backfilledBlock {
+						continue
+					}
+					if log.BlockNumber > lastBlock {
+						lastBlock = log.BlockNumber
+					}
+				}
+
+				event, err := mevm.DecodeEventLog(contractAbi, log)
+				if err != nil {
+					sendSubscribeErr(errs, err)
+					continue
+				}
+
+				if !deliverEvent(ctx, option, event, events, errs) {
+					return false
+				}
+			}
+			return true
+		}
+
+		ticker := time.NewTicker(option.PollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			if filterId == "" {
+				filterId, err = newEvmLogFilter(c, filterMsg)
+				if err != nil {
+					sendSubscribeErr(errs, err)
+					continue
+				}
+				backfill = true
+			}
+
+			if backfill {
+				logs, latestBlock, err := getEvmLogsAfter(c, filterMsg, lastBlock)
+				if err != nil {
+					if ctx.Err() != nil {
+						return
+					}
+					sendSubscribeErr(errs, err)
+					continue
+				}
+				if !deliverLogs(logs) {
+					return
+				}
+				backfill = false
+				backfilledBlock = latestBlock
+				if latestBlock > lastBlock {
+					lastBlock = latestBlock
+				}
+			}
+
+			var res types.EthGetFilterChangesResponse
+			err := c.EthGetFilterChanges(types.EthGetFilterChangesMsg{FilterId: filterId}).QueryTyped(&res)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				sendSubscribeErr(errs, err)
+				if isEvmFilterNotFoundErr(err) {
+					filterId = ""
+				}
+				continue
+			}
+
+			if !deliverLogs(res.Logs) {
+				return
+			}
+		}
+	}()
+
+	return events, errs, nil
+}
+
+// Install the log filter and get the filter ID.
+func newEvmLogFilter(xplac provider.XplaClient, filterMsg types.EthNewFilterMsg) (string, error) {
+	var res types.EthNewFilterResponse
+	if err := xplac.EthNewFilter(filterMsg).QueryTyped(&res); err != nil {
+		return "", err
+	}
+
+	filterId, ok := res.NewFilter.(string)
+	if !ok || filterId == "" {
+		return "", util.LogErr(errors.ErrEvmRpcRequest, "invalid filter ID:", res.NewFilter)
+	}
+
+	return filterId, nil
+}
+
+// Get logs of the filter from the block after the given block to the latest block.
+// The latest block is also returned, and it is the given block if no block is produced after it.
+func getEvmLogsAfter(xplac provider.XplaClient, filterMsg types.EthNewFilterMsg, block uint64) ([]evmtypes.Log, uint64, error) {
+	latestBlock, err := evmBlockNumber(xplac)
+	if err != nil {
+		return nil, 0, err
+	}
+	if latestBlock <= block {
+		return nil, block, nil
+	}
+
+	var res types.EthGetLogsResponse
+	err = xplac.EthGetLogs(types.EthGetLogsMsg{
+		FromBlock: util.FromUint64ToString(block + 1),
+		ToBlock:   util.FromUint64ToString(latestBlock),
+		Address:   filterMsg.Address,
+		Topics:    filterMsg.Topics,
+	}).QueryTyped(&res)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return res.Logs, latestBlock, nil
+}
+
+func evmBlockNumber(xplac provider.XplaClient) (uint64, error) {
+	var res types.EthBlockNumberResponse
+	if err := xplac.EthBlockNumber().QueryTyped(&res); err != nil {
+		return 0, err
+	}
+	return res.BlockNumber, nil
+}
+
+// The node removes the filter which is not polled for a while, and then it responds that the filter is not found.
+func isEvmFilterNotFoundErr(err error) bool {
+	return evmFilterNotFoundRegexp.MatchString(err.Error())
+}
@@ -0,0 +1,398 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Moonyongjung/xpriv.go/client"
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/util"
+	"github.com/Moonyongjung/xpriv.go/util/testutil"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcserver "github.com/tendermint/tendermint/rpc/jsonrpc/server"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+func (s *ClientTestSuite) TestSubscribeNewBlock() {
+	xplac := s.xplac.WithRpc(s.network.Validators[0].RPCAddress)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	blocks, errs, err := xplac.SubscribeNewBlock(ctx)
+	s.Require().NoError(err)
+
+	select {
+	case block := <-blocks:
+		s.Require().Greater(block.Block.Height, int64(0))
+		s.Require().Len(block.Txs, len(block.Block.Txs))
+	case err := <-errs:
+		s.Require().NoError(err)
+	case <-ctx.Done():
+		s.Fail("new block is not received")
+	}
+
+	// channels are closed after the context is done
+	cancel()
+	for range blocks {
+	}
+}
+
+// Listener which keeps accepted connections in order to close them as the lost connection.
+type connTrackingListener struct {
+	net.Listener
+	mu    sync.Mutex
+	conns []net.Conn
+}
+
+func (l *connTrackingListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err == nil {
+		l.mu.Lock()
+		l.conns = append(l.conns, conn)
+		l.mu.Unlock()
+	}
+	return conn, err
+}
+
+func (l *connTrackingListener) closeConns() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, conn := range l.conns {
+		conn.Close()
+	}
+	l.conns = nil
+}
+
+func TestSubscribeNewBlockResubscribes(t *testing.T) {
+	block := tmtypes.MakeBlock(1, []tmtypes.Tx{[]byte("invalid tx")}, nil, nil)
+
+	// Tendermint RPC sends the new block event whenever the query is subscribed.
+	var subscribed int32
+	subscribe := func(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, error) {
+		atomic.AddInt32(&subscribed, 1)
+		// events are sent with the ID of the subscribe request
+		go ctx.WSConn.WriteRPCResponse(context.Background(), rpctypes.NewRPCSuccessResponse(ctx.JSONReq.ID, ctypes.ResultEvent{
+			Query: query,
+			Data:  tmtypes.EventDataNewBlock{Block: block},
+		}))
+		return &ctypes.ResultSubscribe{}, nil
+	}
+	wm := rpcserver.NewWebsocketManager(map[string]*rpcserver.RPCFunc{
+		"subscribe": rpcserver.NewWSRPCFunc(subscribe, "query"),
+	})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/websocket", wm.WebsocketHandler)
+
+	server := httptest.NewUnstartedServer(mux)
+	listener := &connTrackingListener{Listener: server.Listener}
+	server.Listener = listener
+	server.Start()
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	xplac := client.NewXplaClient(testutil.TestChainId).WithRpc(server.URL)
+	blocks, errs, err := xplac.SubscribeNewBlock(ctx)
+	require.NoError(t, err)
+
+	// the block is delivered even though the tx cannot be decoded, and the failure is delivered as the error
+	blockEvent := <-blocks
+	require.Equal(t, block.Height, blockEvent.Block.Height)
+	require.Len(t, blockEvent.Txs, 1)
+	require.Nil(t, blockEvent.Txs[0])
+	require.Error(t, <-errs)
+
+	// the websocket client redials by itself when the connection is lost,
+	// and the query is subscribed again by the new connection.
+	listener.closeConns()
+	select {
+	case blockEvent, ok := <-blocks:
+		require.True(t, ok)
+		require.Equal(t, block.Height, blockEvent.Block.Height)
+	case <-ctx.Done():
+		require.Fail(t, "the query is not subscribed again")
+	}
+	require.Equal(t, int32(2), atomic.LoadInt32(&subscribed))
+}
+
+func (s *ClientTestSuite) TestSubscribeTx() {
+	from := s.accounts[0]
+	to := s.accounts[1]
+
+	xplac := s.xplac.
+		WithURL(s.apis[0]).
+		WithRpc(s.network.Validators[0].RPCAddress).
+		WithPrivateKey(from.PrivKey)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	txs, errs, err := xplac.SubscribeTx(ctx, "transfer.recipient='"+to.Address.String()+"'")
+	s.Require().NoError(err)
+
+	bankSendMsg := types.BankSendMsg{
+		FromAddress: from.Address.String(),
+		ToAddress:   to.Address.String(),
+		Amount:      testSendAmount,
+	}
+	txbytes, err := xplac.BankSend(bankSendMsg).CreateAndSignTx()
+	s.Require().NoError(err)
+
+	res, err := xplac.Broadcast(txbytes)
+	s.Require().NoError(err)
+
+	select {
+	case tx := <-txs:
+		s.Require().Equal(res.Response.TxHash, tx.TxHash)
+		s.Require().Greater(tx.Height, int64(0))
+		s.Require().Equal(uint32(0), tx.Result.Code)
+		s.Require().NotEmpty(tx.Events)
+
+		msgSend, ok := tx.Tx.GetMsgs()[0].(*banktypes.MsgSend)
+		s.Require().True(ok)
+		s.Require().Equal(to.Address.String(), msgSend.ToAddress)
+	case err := <-errs:
+		s.Require().NoError(err)
+	case <-ctx.Done():
+		s.Fail("tx is not received")
+	}
+
+	// invalid query
+	_, _, err = xplac.SubscribeTx(ctx, "transfer.recipient=")
+	s.Require().Error(err)
+
+	// need RPC URL
+	_, _, err = s.xplac.WithRpc("").SubscribeTx(ctx, "")
+	s.Require().Error(err)
+}
+
+func (s *ClientTestSuite) TestSubscribeEvmLogs() {
+	xplac := s.xplac.WithEvmRpc("http://" + s.network.Validators[0].AppConfig.JSONRPC.Address)
+
+	abiJson, err := util.AbiParsing("../util/testutil/test_files/abi.json")
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	filterMsg := types.EthNewFilterMsg{
+		Address: []string{"0x80E123317190cAf36292A04776b0De020136526F"},
+	}
+	events, errs, err := xplac.SubscribeEvmLogs(ctx, filterMsg, abiJson, types.SubscribeOptions{
+		PollInterval: 100 * time.Millisecond,
+	})
+	s.Require().NoError(err)
+
+	// the contract is not deployed, so no event is received while polling
+	select {
+	case event := <-events:
+		s.Fail("unexpected event", event)
+	case err := <-errs:
+		s.Require().NoError(err)
+	case <-time.After(time.Second):
+	}
+
+	// channels are closed after the context is done
+	cancel()
+	for range events {
+	}
+
+	// invalid ABI
+	_, _, err = xplac.SubscribeEvmLogs(context.Background(), filterMsg, "invalid")
+	s.Require().Error(err)
+
+	// need evm RPC URL
+	_, _, err = s.xplac.WithEvmRpc("").SubscribeEvmLogs(context.Background(), filterMsg, abiJson)
+	s.Require().Error(err)
+}
+
+const testTransferEventABI = `[
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[
+		{"name":"from","type":"address","indexed":true},
+		{"name":"value","type":"uint256","indexed":false}
+	]}
+]`
+
+// Fake eth JSON-RPC API of log filters.
+// A block is produced whenever changes of the filter are polled, and the filter is removed after it is polled once,
+// as the filter which is expired by the node.
+type fakeEvmFilterAPI struct {
+	mu sync.Mutex
+	// The last block which is polled by each filter.
+	filters   map[string]uint64
+	installed int
+	// Logs of each block. Logs of the block n are blocks[n-1].
+	blocks [][]ethtypes.Log
+	head   uint64
+	// From blocks of requested logs.
+	getLogsFrom []uint64
+}
+
+func (api *fakeEvmFilterAPI) logs(from, to uint64) []ethtypes.Log {
+	var logs []ethtypes.Log
+	for n := from; n <= to && n <= uint64(len(api.blocks)); n++ {
+		for _, log := range api.blocks[n-1] {
+			log.BlockNumber = n
+			logs = append(logs, log)
+		}
+	}
+	return logs
+}
+
+func (api *fakeEvmFilterAPI) BlockNumber() hexutil.Uint64 {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	return hexutil.Uint64(api.head)
+}
+
+func (api *fakeEvmFilterAPI) NewFilter(crit json.RawMessage) string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.installed++
+	filterId := hexutil.EncodeUint64(uint64(api.installed))
+	// The filter is installed before the last block is produced,
+	// so logs of the last block are delivered by both the filter and the requested logs.
+	api.filters[filterId] = api.head
+	if api.head > 0 {
+		api.filters[filterId] = api.head - 1
+	}
+	return filterId
+}
+
+func (api *fakeEvmFilterAPI) GetFilterChanges(filterId string) ([]ethtypes.Log, error) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.head++
+	polled, ok := api.filters[filterId]
+	if !ok {
+		return nil, fmt.Errorf("filter %s not found", filterId)
+	}
+	delete(api.filters, filterId)
+
+	return api.logs(polled+1, api.head), nil
+}
+
+func (api *fakeEvmFilterAPI) GetLogs(crit struct {
+	FromBlock hexutil.Uint64 `json:"fromBlock"`
+	ToBlock   hexutil.Uint64 `json:"toBlock"`
+}) []ethtypes.Log {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	api.getLogsFrom = append(api.getLogsFrom, uint64(crit.FromBlock))
+	return api.logs(uint64(crit.FromBlock), uint64(crit.ToBlock))
+}
+
+func (api *fakeEvmFilterAPI) UninstallFilter(filterId string) bool {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+
+	_, removed := api.filters[filterId]
+	delete(api.filters, filterId)
+	return removed
+}
+
+func TestSubscribeEvmLogsDecodesEvents(t *testing.T) {
+	contractAbi, err := abi.JSON(strings.NewReader(testTransferEventABI))
+	require.NoError(t, err)
+
+	contractAddr := common.HexToAddress("0x80E123317190cAf36292A04776b0De020136526F")
+	from := common.HexToAddress("0xf7777b36a51fb0b33dd0c5118361AfC94ff7f967")
+	transferLog := func(value int64) ethtypes.Log {
+		data, err := contractAbi.Events["Transfer"].Inputs.NonIndexed().Pack(big.NewInt(value))
+		require.NoError(t, err)
+		return ethtypes.Log{
+			Address: contractAddr,
+			Topics:  []common.Hash{contractAbi.Events["Transfer"].ID, common.BytesToHash(from.Bytes())},
+			Data:    data,
+		}
+	}
+	unknownLog := ethtypes.Log{
+		Address: contractAddr,
+		Topics:  []common.Hash{common.HexToHash("0x01")},
+	}
+
+	// The second transfer is emitted while the first filter is expired,
+	// so it is requested by the logs from the last block after the filter is installed again.
+	api := &fakeEvmFilterAPI{
+		filters: make(map[string]uint64),
+		blocks: [][]ethtypes.Log{
+			{transferLog(1000), unknownLog},
+			{transferLog(2000)},
+		},
+	}
+	rpcServer := rpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("eth", api))
+	server := httptest.NewServer(rpcServer)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	xplac := client.NewXplaClient(testutil.TestChainId).WithEvmRpc(server.URL)
+	filterMsg := types.EthNewFilterMsg{Address: []string{contractAddr.Hex()}}
+	events, errs, err := xplac.SubscribeEvmLogs(ctx, filterMsg, testTransferEventABI, types.SubscribeOptions{
+		PollInterval: 10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	var received []types.EvmEventLog
+	for len(received) < 2 {
+		select {
+		case event := <-events:
+			received = append(received, event)
+		// errors of the unknown log and the expired filter
+		case <-errs:
+		case <-ctx.Done():
+			t.Fatal("events are not received")
+		}
+	}
+
+	for i, value := range []int64{1000, 2000} {
+		require.Equal(t, "Transfer", received[i].Name)
+		require.Equal(t, from, received[i].Fields["from"])
+		require.Equal(t, big.NewInt(value), received[i].Fields["value"])
+		require.Equal(t, contractAddr, received[i].Log.Address)
+	}
+
+	// the log of the last block is delivered once even though the new filter also has it
+	select {
+	case event := <-events:
+		t.Fatalf("unexpected event: %v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	api.mu.Lock()
+	require.GreaterOrEqual(t, api.installed, 2)
+	require.Equal(t, uint64(2), api.getLogsFrom[0])
+	api.mu.Unlock()
+
+	// channels are closed after the context is done
+	cancel()
+	for range events {
+	}
+}
@@ -2,12 +2,8 @@ package client
 
 import (
 	"encoding/base64"
-	"encoding/json"
-	"os"
-	"path/filepath"
 
 	mevm "github.com/Moonyongjung/xpriv.go/core/evm"
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
@@ -15,38 +11,52 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
-	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	authcli "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // Create and sign a transaction before it is broadcasted to xpla chain.
 // Options required for create and sign are stored in the xpla client and reflected when the values of those options exist.
 // Create and sign transaction must be needed in order to send transaction to the chain.
 func (xplac *xplaClient) CreateAndSignTx() ([]byte, error) {
-	var err error
 	if xplac.GetErr() != nil {
 		return nil, xplac.GetErr()
 	}
 
+	// The VP is used for loading the account and simulating the transaction.
+	return requestWithVP(xplac, func(c *xplaClient) ([]byte, error) {
+		return c.createAndSignTx()
+	})
+}
+
+func (xplac *xplaClient) createAndSignTx() ([]byte, error) {
+	var err error
+
+	if xplac.GetSigner() == nil {
+		return nil, util.LogErr(errors.ErrNotSatisfiedOptions, "need private key or signer of xpla client's option")
+	}
+
+	// The returned client is the copy of the receiver,
+	// so default options below are not reflected to the client of the caller.
 	xplac, err = GetAccNumAndSeq(xplac)
 	if err != nil {
 		return nil, err
 	}
 
 	if xplac.GetGasAdjustment() == "" {
-		xplac.WithGasAdjustment(types.DefaultGasAdjustment)
+		xplac.opts.GasAdjustment = types.DefaultGasAdjustment
 	}
 
 	if xplac.GetGasPrice() == "" {
-		xplac.WithGasPrice(types.DefaultGasPrice)
+		xplac.opts.GasPrice = types.DefaultGasPrice
 	}
 
-	if xplac.GetModule() == mevm.EvmModule {
+	if xplac.GetModule() == mevm.EvmModule && len(xplac.GetBatchMsgs()) == 0 {
 		return xplac.createAndSignEvmTx()
 
 	} else {
@@ -67,10 +77,10 @@ func (xplac *xplaClient) CreateAndSignTx() ([]byte, error) {
 
 		// Set default sign mode (DIRECT=1)
 		if xplac.GetSignMode() == signing.SignMode_SIGN_MODE_UNSPECIFIED {
-			xplac.WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+			xplac.opts.SignMode = signing.SignMode_SIGN_MODE_DIRECT
 		}
 
-		privs := []cryptotypes.PrivKey{xplac.GetPrivateKey()}
+		signers := []types.Signer{xplac.GetSigner()}
 
 		accNumU64, err := util.FromStringToUint64(xplac.GetAccountNumber())
 		if err != nil {
@@ -85,7 +95,7 @@ func (xplac *xplaClient) CreateAndSignTx() ([]byte, error) {
 
 		var sigsV2 []signing.SignatureV2
 
-		err = txSignRound(xplac, sigsV2, privs, accSeqs, accNums, builder)
+		err = txSignRound(xplac, sigsV2, signers, accSeqs, accNums, builder)
 		if err != nil {
 			return nil, err
 		}
@@ -121,6 +131,13 @@ func (xplac *xplaClient) CreateUnsignedTx() ([]byte, error) {
 	if xplac.GetErr() != nil {
 		return nil, xplac.GetErr()
 	}
+
+	return requestWithVP(xplac, func(c *xplaClient) ([]byte, error) {
+		return c.createUnsignedTx()
+	})
+}
+
+func (xplac *xplaClient) createUnsignedTx() ([]byte, error) {
 	builder, err := setTxBuilderMsg(xplac)
 	if err != nil {
 		return nil, err
@@ -175,22 +192,23 @@ func (xplac *xplaClient) SignTx(signTxMsg types.SignTxMsg) ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
+	} else {
+		xplac = xplac.clone()
 	}
 
-	clientCtx, err := util.NewClient()
-	if err != nil {
-		return nil, err
+	signer := xplac.GetSigner()
+	if signer == nil {
+		return nil, util.LogErr(errors.ErrNotSatisfiedOptions, "need private key or signer of xpla client's option")
 	}
 
-	fromName := types.XplaToolDefaultName
-	err = clientCtx.Keyring.ImportPrivKey(fromName, key.EncryptArmorPrivKey(xplac.GetPrivateKey(), key.DefaultEncryptPassphrase), key.DefaultEncryptPassphrase)
+	clientCtx, err := util.NewClient()
 	if err != nil {
-		return nil, util.LogErr(errors.ErrKeyNotFound, err)
+		return nil, err
 	}
 
 	clientCtx.WithSignModeStr("direct")
 
-	clientCtx, txFactory, newTx, err := readTxAndInitContexts(clientCtx, signTxMsg.UnsignedFileName)
+	clientCtx, _, newTx, err := readTxAndInitContexts(clientCtx, signTxMsg.UnsignedFileName)
 	if err != nil {
 		return nil, err
 	}
@@ -223,24 +241,24 @@ func (xplac *xplaClient) SignTx(signTxMsg types.SignTxMsg) ([]byte, error) {
 			multisigAccSeq = signerAcc.GetSequence()
 		}
 
-		txFactory = txFactory.WithSignMode(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON).
-			WithChainID(xplac.GetChainId()).
-			WithAccountNumber(multisigAccNum).
-			WithSequence(multisigAccSeq)
-
 		if !isTxSigner(multisigAddr, txBuilder.GetTx().GetSigners()) {
-			return nil, util.LogErr(errors.ErrParse, err)
+			return nil, util.LogErr(errors.ErrParse, "the multisig address is not the signer of the transaction")
 		}
 
-		err = tx.Sign(txFactory, fromName, txBuilder, signTxMsg.Overwrite)
+		signerData := authsigning.SignerData{
+			ChainID:       xplac.GetChainId(),
+			AccountNumber: multisigAccNum,
+			Sequence:      multisigAccSeq,
+		}
+		err = appendSignBySigner(signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signerData, txBuilder, signer, txCfg, signTxMsg.Overwrite)
 		if err != nil {
-			return nil, util.LogErr(errors.ErrParse, err)
+			return nil, err
 		}
 		signatureOnly = true
 	} else {
 		// Set default sign mode (DIRECT=1)
 		if xplac.GetSignMode() == signing.SignMode_SIGN_MODE_UNSPECIFIED {
-			xplac.WithSignMode(signing.SignMode_SIGN_MODE_DIRECT)
+			xplac.opts.SignMode = signing.SignMode_SIGN_MODE_DIRECT
 		}
 
 		accNumU64, err := util.FromStringToUint64(xplac.GetAccountNumber())
@@ -252,13 +270,13 @@ func (xplac *xplaClient) SignTx(signTxMsg types.SignTxMsg) ([]byte, error) {
 			return nil, err
 		}
 
-		privs := []cryptotypes.PrivKey{xplac.GetPrivateKey()}
+		signers := []types.Signer{signer}
 		accNums := []uint64{accNumU64}
 		accSeqs := []uint64{accSeqU64}
 
 		var sigsV2 []signing.SignatureV2
 
-		err = txSignRound(xplac, sigsV2, privs, accSeqs, accNums, txBuilder)
+		err = txSignRound(xplac, sigsV2, signers, accSeqs, accNums, txBuilder)
 		if err != nil {
 			return nil, err
 		}
@@ -314,18 +332,12 @@ func (xplac *xplaClient) MultiSign(txMultiSignMsg types.TxMultiSignMsg) ([]byte,
 		return nil, util.LogErr(errors.ErrParse, "invalid keyring backend, must be "+util.BackendFile+", "+util.BackendTest+" or "+util.BackendMemory)
 	}
 
-	keyringPath := txMultiSignMsg.KeyringPath
-	if (txMultiSignMsg.KeyringBackend == keyring.BackendFile ||
-		txMultiSignMsg.KeyringBackend == keyring.BackendTest) && txMultiSignMsg.KeyringPath == "" {
-		userHomeDir, err := os.UserHomeDir()
-		if err != nil {
-			return nil, util.LogErr(errors.ErrParse, err)
-		}
-
-		keyringPath = filepath.Join(userHomeDir, ".xpla")
+	keyringPath, err := util.KeyringPath(txMultiSignMsg.KeyringBackend, txMultiSignMsg.KeyringPath)
+	if err != nil {
+		return nil, err
 	}
 
-	newKeyring, err := util.NewKeyring(txMultiSignMsg.KeyringBackend, keyringPath)
+	newKeyring, err := util.NewKeyring(txMultiSignMsg.KeyringBackend, keyringPath, txMultiSignMsg.KeyringPassphrase)
 	if err != nil {
 		return nil, util.LogErr(errors.ErrParse, err)
 	}
@@ -460,9 +472,9 @@ func (xplac *xplaClient) MultiSign(txMultiSignMsg types.TxMultiSignMsg) ([]byte,
 
 // Create and sign transaction of evm.
 func (xplac *xplaClient) createAndSignEvmTx() ([]byte, error) {
-	ethPrivKey, err := toECDSA(xplac.GetPrivateKey())
-	if err != nil {
-		return nil, util.LogErr(errors.ErrParse, err)
+	signer := xplac.GetSigner()
+	if signer == nil {
+		return nil, util.LogErr(errors.ErrNotSatisfiedOptions, "need private key or signer of xpla client's option")
 	}
 
 	chainId, err := util.ConvertEvmChainId(xplac.GetChainId())
@@ -474,6 +486,10 @@ func (xplac *xplaClient) createAndSignEvmTx() ([]byte, error) {
 		util.LogInfo("no create output document as tx of evm")
 	}
 
+	if xplac.GetMemo() != "" {
+		util.LogInfo("memo is not included in tx of evm")
+	}
+
 	gasPrice, err := util.FromStringToBigInt(xplac.GetGasPrice())
 	if err != nil {
 		return nil, err
@@ -504,49 +520,35 @@ func (xplac *xplaClient) createAndSignEvmTx() ([]byte, error) {
 			return nil, err
 		}
 
-		return evmTxSignRound(xplac, toAddr, gasPrice, gasLimit, amount, nil, chainId, ethPrivKey)
+		return evmTxSignRound(xplac, convertMsg.TxOptions, &toAddr, gasPrice, gasLimit, amount, nil, chainId, signer)
 
 	case xplac.GetMsgType() == mevm.EvmDeploySolContractMsgType:
-		gasLimit := xplac.GetGasLimit()
-		if gasLimit == "" {
-			gasLimit = "0"
-		}
-
 		convertMsg, ok := xplac.GetMsg().(mevm.ContractInfo)
 		if !ok {
 			return nil, util.LogErr(errors.ErrParse, "invalid msg")
 		}
-		nonce, err := util.FromStringToBigInt(xplac.GetSequence())
+
+		// Constructor arguments are packed and appended to the bytecode of the contract.
+		constructorArgs, err := util.GetAbiPack("", convertMsg.Abi, convertMsg.Bytecode, convertMsg.Args...)
 		if err != nil {
-			return nil, err
+			return nil, util.LogErr(errors.ErrParse, err)
 		}
+		deployByteData := append(common.FromHex(convertMsg.Bytecode), constructorArgs...)
 
 		value, err := util.FromStringToBigInt(util.DefaultSolidityValue)
 		if err != nil {
 			return nil, err
 		}
 
-		gasLimitU64, err := util.FromStringToUint64(gasLimit)
-		if err != nil {
-			return nil, util.LogErr(errors.ErrParse, err)
-		}
-
-		tx := mevm.DeploySolTx{
-			ChainId:  chainId,
-			Nonce:    nonce,
-			Value:    value,
-			GasLimit: gasLimitU64,
-			GasPrice: gasPrice,
-			ABI:      convertMsg.Abi,
-			Bytecode: convertMsg.Bytecode,
-		}
-
-		txbytes, err := util.JsonMarshalData(tx)
-		if err != nil {
-			return nil, util.LogErr(errors.ErrFailedToMarshal, err)
+		gasLimit := xplac.GetGasLimit()
+		if gasLimit == "" {
+			gasLimit, err = estimateEvmDeployGas(xplac, signer, convertMsg.Abi, deployByteData, value)
+			if err != nil {
+				return nil, err
+			}
 		}
 
-		return txbytes, nil
+		return evmTxSignRound(xplac, convertMsg.TxOptions, nil, gasPrice, gasLimit, value, deployByteData, chainId, signer)
 
 	case xplac.GetMsgType() == mevm.EvmInvokeSolContractMsgType:
 		convertMsg, ok := xplac.GetMsg().(types.InvokeSolContractMsg)
@@ -554,8 +556,7 @@ func (xplac *xplaClient) createAndSignEvmTx() ([]byte, error) {
 			return nil, util.LogErr(errors.ErrParse, "invalid msg")
 		}
 		var invokeByteData []byte
-		invokeByteData, err = util.GetAbiPack(convertMsg.ContractFuncCallName, convertMsg.ABI, convertMsg.Bytecode, mevm.Args...)
-		mevm.Args = nil
+		invokeByteData, err = util.GetAbiPack(convertMsg.ContractFuncCallName, convertMsg.ABI, convertMsg.Bytecode, convertMsg.Args...)
 		if err != nil {
 			return nil, util.LogErr(errors.ErrParse, err)
 		}
@@ -568,13 +569,11 @@ func (xplac *xplaClient) createAndSignEvmTx() ([]byte, error) {
 
 		gasLimit := xplac.GetGasLimit()
 		if gasLimit == "" {
-			estimateGas, err := xplac.EstimateGas(convertMsg).Query()
+			var estimateGasResponse types.EstimateGasResponse
+			err := xplac.EstimateGas(convertMsg).QueryTyped(&estimateGasResponse)
 			if err != nil {
 				return nil, err
 			}
-			var estimateGasResponse types.EstimateGasResponse
-			json.Unmarshal([]byte(estimateGas), &estimateGasResponse)
-			xplac.WithMsgType(mevm.EvmInvokeSolContractMsgType)
 
 			gasLimitAdjustment, err := util.GasLimitAdjustment(estimateGasResponse.EstimateGas, xplac.GetGasAdjustment())
 			if err != nil {
@@ -583,7 +582,7 @@ func (xplac *xplaClient) createAndSignEvmTx() ([]byte, error) {
 			gasLimit = gasLimitAdjustment
 		}
 
-		return evmTxSignRound(xplac, toAddr, gasPrice, gasLimit, amount, invokeByteData, chainId, ethPrivKey)
+		return evmTxSignRound(xplac, convertMsg.TxOptions, &toAddr, gasPrice, gasLimit, amount, invokeByteData, chainId, signer)
 
 	default:
 		return nil, util.LogErr(errors.ErrInvalidMsgType, "invalid EVM message type")
@@ -2,42 +2,116 @@ package client
 
 import (
 	"bytes"
-	"crypto/ecdsa"
-	"encoding/base64"
 	"math/big"
 	"os"
 
 	"github.com/Moonyongjung/xpriv.go/controller"
-	"github.com/Moonyongjung/xpriv.go/key"
+	mevm "github.com/Moonyongjung/xpriv.go/core/evm"
+	"github.com/Moonyongjung/xpriv.go/provider"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
-	"google.golang.org/grpc/metadata"
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
-	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
 	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	evmtypes "github.com/ethereum/go-ethereum/core/types"
-	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 )
 
 // Set message for transaction builder.
 // Interface type messages are converted to correct type.
+// If messages are accumulated for the multi-message transaction, all of them are set to the builder
+// in order, and the message which is set by the module method is set last.
 func setTxBuilderMsg(xplac *xplaClient) (cmclient.TxBuilder, error) {
 	if xplac.GetErr() != nil {
 		return nil, xplac.GetErr()
 	}
 
+	batchMsgs := xplac.GetBatchMsgs()
+	if xplac.GetModule() != "" {
+		batchMsgs = append(batchMsgs[:len(batchMsgs):len(batchMsgs)], provider.BatchMsg{
+			Module:  xplac.GetModule(),
+			MsgType: xplac.GetMsgType(),
+			Msg:     xplac.GetMsg(),
+		})
+	}
+	if len(batchMsgs) == 0 {
+		return nil, util.LogErr(errors.ErrInvalidRequest, "no message to make transaction")
+	}
+
 	builder := xplac.GetEncoding().TxConfig.NewTxBuilder()
 
-	return controller.Controller().Get(xplac.GetModule()).
-		NewTxRouter(builder, xplac.GetMsgType(), xplac.GetMsg())
+	var msgs []sdk.Msg
+	for _, batchMsg := range batchMsgs {
+		if len(batchMsgs) > 1 && batchMsg.Module == mevm.EvmModule {
+			return nil, util.LogErr(errors.ErrNotSupport, "message of evm cannot be included in the multi-message transaction")
+		}
+
+		coreModule := controller.Controller().Get(batchMsg.Module)
+		if coreModule == nil {
+			return nil, util.LogErr(errors.ErrInvalidRequest, "invalid module name:", batchMsg.Module)
+		}
+
+		// Each message is routed by its module with new builder, because the router replaces messages of the builder.
+		msgBuilder, err := coreModule.NewTxRouter(xplac.GetEncoding().TxConfig.NewTxBuilder(), batchMsg.MsgType, batchMsg.Msg)
+		if err != nil {
+			return nil, err
+		}
+
+		msgs = append(msgs, msgBuilder.GetTx().GetMsgs()...)
+		if memo := msgBuilder.GetTx().GetMemo(); memo != "" {
+			builder.SetMemo(memo)
+		}
+	}
+
+	err := builder.SetMsgs(msgs...)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrParse, err)
+	}
+
+	// The memo of the xpla client has priority over the memo which is set by the message router.
+	// It is set before simulation because the memo is included in the gas consumption.
+	if xplac.GetMemo() != "" {
+		builder.SetMemo(xplac.GetMemo())
+	}
+
+	err = validateMemo(xplac, builder.GetTx().GetMemo())
+	if err != nil {
+		return nil, err
+	}
+
+	return builder, nil
+}
+
+// Validate length of the memo by max memo characters of auth params.
+// If LCD or gRPC URL does not exist, the default max memo characters is used.
+func validateMemo(xplac *xplaClient, memo string) error {
+	if memo == "" {
+		return nil
+	}
+
+	maxMemoCharacters := authtypes.DefaultMaxMemoCharacters
+	if xplac.GetLcdURL() != "" || xplac.GetGrpcUrl() != "" {
+		var authParamsResponse authtypes.QueryParamsResponse
+		err := xplac.AuthParams().QueryTyped(&authParamsResponse)
+		if err != nil {
+			return err
+		}
+		maxMemoCharacters = authParamsResponse.Params.MaxMemoCharacters
+	}
+
+	if uint64(len(memo)) > maxMemoCharacters {
+		return util.LogErr(errors.ErrInvalidRequest, "maximum number of characters of memo is", maxMemoCharacters, "but received", len(memo))
+	}
+
+	return nil
 }
 
 // Set information for transaction builder.
@@ -59,10 +133,6 @@ func convertAndSetBuilder(xplac *xplaClient, builder cmclient.TxBuilder, gasLimi
 		}
 		builder.SetTimeoutHeight(h)
 	}
-	if types.Memo != "" {
-		builder.SetMemo(types.Memo)
-		types.Memo = ""
-	}
 	gasLimitStr, err := util.FromStringToUint64(gasLimit)
 	if err != nil {
 		return nil, err
@@ -75,17 +145,17 @@ func convertAndSetBuilder(xplac *xplaClient, builder cmclient.TxBuilder, gasLimi
 	return builder, nil
 }
 
-// Sign transaction by using given private key.
+// Sign transaction by using given signers.
 func txSignRound(xplac *xplaClient,
 	sigsV2 []signing.SignatureV2,
-	privs []cryptotypes.PrivKey,
+	signers []types.Signer,
 	accSeqs []uint64,
 	accNums []uint64,
 	builder cmclient.TxBuilder) error {
 
-	for i, priv := range privs {
+	for i, signer := range signers {
 		sigV2 := signing.SignatureV2{
-			PubKey: priv.PubKey(),
+			PubKey: signer.PubKey(),
 			Data: &signing.SingleSignatureData{
 				SignMode:  xplac.GetSignMode(),
 				Signature: nil,
@@ -101,22 +171,21 @@ func txSignRound(xplac *xplaClient,
 	}
 
 	sigsV2 = []signing.SignatureV2{}
-	for i, priv := range privs {
+	for i, signer := range signers {
 		signerData := xauthsigning.SignerData{
 			ChainID:       xplac.GetChainId(),
 			AccountNumber: accNums[i],
 			Sequence:      accSeqs[i],
 		}
-		sigV2, err := tx.SignWithPrivKey(
+		sigV2, err := signBySigner(
 			xplac.GetSignMode(),
 			signerData,
 			builder,
-			priv,
+			signer,
 			xplac.GetEncoding().TxConfig,
-			accSeqs[i],
 		)
 		if err != nil {
-			return util.LogErr(errors.ErrParse, err)
+			return err
 		}
 
 		sigsV2 = append(sigsV2, sigV2)
@@ -130,15 +199,90 @@ func txSignRound(xplac *xplaClient,
 	return nil
 }
 
-// Sign evm transaction by using given private key.
+// Make the signature of the transaction by the signer.
+// The sign bytes are made by the sign mode handler of the tx config, as tx.SignWithPrivKey of the cosmos sdk.
+func signBySigner(
+	signMode signing.SignMode,
+	signerData xauthsigning.SignerData,
+	builder cmclient.TxBuilder,
+	signer types.Signer,
+	txConfig cmclient.TxConfig) (signing.SignatureV2, error) {
+
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(signMode, signerData, builder.GetTx())
+	if err != nil {
+		return signing.SignatureV2{}, util.LogErr(errors.ErrParse, err)
+	}
+
+	signature, err := signer.Sign(signMode, signBytes)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+
+	return signing.SignatureV2{
+		PubKey: signer.PubKey(),
+		Data: &signing.SingleSignatureData{
+			SignMode:  signMode,
+			Signature: signature,
+		},
+		Sequence: signerData.Sequence,
+	}, nil
+}
+
+// Sign the transaction by the signer and append the signature to existing signatures of the transaction,
+// as tx.Sign of the cosmos sdk. Existing signatures are removed if overwrite is true.
+func appendSignBySigner(
+	signMode signing.SignMode,
+	signerData xauthsigning.SignerData,
+	builder cmclient.TxBuilder,
+	signer types.Signer,
+	txConfig cmclient.TxConfig,
+	overwrite bool) error {
+
+	var prevSignatures []signing.SignatureV2
+	var err error
+	if !overwrite {
+		prevSignatures, err = builder.GetTx().GetSignaturesV2()
+		if err != nil {
+			return util.LogErr(errors.ErrParse, err)
+		}
+	}
+
+	// The empty signature is set before making sign bytes, because the signer info is included in them.
+	emptySig := signing.SignatureV2{
+		PubKey: signer.PubKey(),
+		Data: &signing.SingleSignatureData{
+			SignMode:  signMode,
+			Signature: nil,
+		},
+		Sequence: signerData.Sequence,
+	}
+	if err := builder.SetSignatures(append(prevSignatures, emptySig)...); err != nil {
+		return util.LogErr(errors.ErrParse, err)
+	}
+
+	sig, err := signBySigner(signMode, signerData, builder, signer, txConfig)
+	if err != nil {
+		return err
+	}
+
+	if err := builder.SetSignatures(append(prevSignatures, sig)...); err != nil {
+		return util.LogErr(errors.ErrParse, err)
+	}
+
+	return nil
+}
+
+// Sign evm transaction by using given signer.
+// The type of the transaction is decided by the evm tx options, and the contract is created when the to address is nil.
 func evmTxSignRound(xplac *xplaClient,
-	toAddr common.Address,
+	txOptions types.EvmTxOptions,
+	toAddr *common.Address,
 	gasPrice *big.Int,
 	gasLimit string,
 	amount *big.Int,
 	invokeByteData []byte,
 	chainId *big.Int,
-	ethPrivKey *ecdsa.PrivateKey) ([]byte, error) {
+	signer types.Signer) ([]byte, error) {
 
 	seqU64, err := util.FromStringToUint64(xplac.GetSequence())
 	if err != nil {
@@ -149,20 +293,14 @@ func evmTxSignRound(xplac *xplaClient,
 		return nil, err
 	}
 
-	tx := evmtypes.NewTransaction(
-		seqU64,
-		toAddr,
-		amount,
-		gasLimitStr,
-		gasPrice,
-		invokeByteData,
-	)
-
-	signer := evmtypes.NewEIP155Signer(chainId)
+	tx, err := newEvmTx(xplac, txOptions, chainId, seqU64, toAddr, amount, gasLimitStr, gasPrice, invokeByteData)
+	if err != nil {
+		return nil, err
+	}
 
-	signedTx, err := evmtypes.SignTx(tx, signer, ethPrivKey)
+	signedTx, err := signEvmTx(tx, evmtypes.LatestSignerForChainID(chainId), signer)
 	if err != nil {
-		return nil, util.LogErr(errors.ErrParse, err)
+		return nil, err
 	}
 	txbytes, err := signedTx.MarshalJSON()
 	if err != nil {
@@ -172,6 +310,154 @@ func evmTxSignRound(xplac *xplaClient,
 	return txbytes, nil
 }
 
+// Estimate the gas limit of the contract deployment with the gas adjustment.
+// The revert reason of the constructor is decoded by the ABI.
+func estimateEvmDeployGas(xplac *xplaClient, signer types.Signer, abiJson string, deployByteData []byte, value *big.Int) (string, error) {
+	if xplac.GetEvmRpc() == "" {
+		return "", util.LogErr(errors.ErrNotSatisfiedOptions, "need evm RPC URL to estimate gas of the contract deployment, or set gas limit")
+	}
+	evmClient, err := util.NewEvmClient(xplac.GetEvmRpc(), xplac.GetContext())
+	if err != nil {
+		return "", err
+	}
+
+	estimateGas, err := evmClient.Client.EstimateGas(evmClient.Ctx, ethereum.CallMsg{
+		From:  common.BytesToAddress(signer.Address()),
+		Value: value,
+		Data:  deployByteData,
+	})
+	if err != nil {
+		if revertErr := mevm.RevertErrorOf(err, abiJson); revertErr != nil {
+			return "", revertErr
+		}
+		return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+	}
+
+	gasLimit, err := util.GasLimitAdjustment(estimateGas, xplac.GetGasAdjustment())
+	if err != nil {
+		return "", util.LogErr(errors.ErrParse, err)
+	}
+
+	return gasLimit, nil
+}
+
+// Make the evm transaction of the tx type.
+// The legacy transaction is made when the tx type is empty. The contract is created when the to address is nil.
+func newEvmTx(xplac *xplaClient,
+	txOptions types.EvmTxOptions,
+	chainId *big.Int,
+	nonce uint64,
+	toAddr *common.Address,
+	amount *big.Int,
+	gasLimit uint64,
+	gasPrice *big.Int,
+	data []byte) (*evmtypes.Transaction, error) {
+
+	accessList, err := util.FromEvmAccessTuplesToAccessList(txOptions.AccessList)
+	if err != nil {
+		return nil, err
+	}
+
+	switch txOptions.TxType {
+	case "", types.EvmLegacyTxType:
+		return evmtypes.NewTx(&evmtypes.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: gasPrice,
+			Gas:      gasLimit,
+			To:       toAddr,
+			Value:    amount,
+			Data:     data,
+		}), nil
+
+	case types.EvmAccessListTxType:
+		return evmtypes.NewTx(&evmtypes.AccessListTx{
+			ChainID:    chainId,
+			Nonce:      nonce,
+			GasPrice:   gasPrice,
+			Gas:        gasLimit,
+			To:         toAddr,
+			Value:      amount,
+			Data:       data,
+			AccessList: accessList,
+		}), nil
+
+	case types.EvmDynamicFeeTxType:
+		gasTipCap, gasFeeCap, err := evmFeeCaps(xplac, txOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		return evmtypes.NewTx(&evmtypes.DynamicFeeTx{
+			ChainID:    chainId,
+			Nonce:      nonce,
+			GasTipCap:  gasTipCap,
+			GasFeeCap:  gasFeeCap,
+			Gas:        gasLimit,
+			To:         toAddr,
+			Value:      amount,
+			Data:       data,
+			AccessList: accessList,
+		}), nil
+
+	default:
+		return nil, util.LogErr(errors.ErrInvalidRequest, "invalid evm tx type:", txOptions.TxType)
+	}
+}
+
+// Get the max priority fee per gas and the max fee per gas of the dynamic fee transaction.
+// The priority fee is the suggested gas tip cap and the max fee is the priority fee with
+// twice the base fee of the latest block, if they are not set by the evm tx options.
+func evmFeeCaps(xplac *xplaClient, txOptions types.EvmTxOptions) (*big.Int, *big.Int, error) {
+	var gasTipCap, gasFeeCap *big.Int
+	var err error
+	if txOptions.MaxPriorityFeePerGas != "" {
+		gasTipCap, err = util.FromStringToBigInt(util.DenomRemove(txOptions.MaxPriorityFeePerGas))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if txOptions.MaxFeePerGas != "" {
+		gasFeeCap, err = util.FromStringToBigInt(util.DenomRemove(txOptions.MaxFeePerGas))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if gasTipCap == nil || gasFeeCap == nil {
+		if xplac.GetEvmRpc() == "" {
+			return nil, nil, util.LogErr(errors.ErrNotSatisfiedOptions, "need evm RPC URL to get fee caps of the dynamic fee tx")
+		}
+		evmClient, err := util.NewEvmClient(xplac.GetEvmRpc(), xplac.GetContext())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if gasTipCap == nil {
+			gasTipCap, err = evmClient.Client.SuggestGasTipCap(evmClient.Ctx)
+			if err != nil {
+				return nil, nil, util.LogErr(errors.ErrEvmRpcRequest, err)
+			}
+		}
+
+		if gasFeeCap == nil {
+			header, err := evmClient.Client.HeaderByNumber(evmClient.Ctx, nil)
+			if err != nil {
+				return nil, nil, util.LogErr(errors.ErrEvmRpcRequest, err)
+			}
+			if header.BaseFee == nil {
+				return nil, nil, util.LogErr(errors.ErrInvalidRequest, "the chain does not support the dynamic fee tx, no base fee")
+			}
+			gasFeeCap = new(big.Int).Add(gasTipCap, new(big.Int).Mul(header.BaseFee, big.NewInt(2)))
+		}
+	}
+
+	if gasFeeCap.Cmp(gasTipCap) < 0 {
+		return nil, nil, util.LogErr(errors.ErrInvalidRequest, "max fee per gas", gasFeeCap, "is less than max priority fee per gas", gasTipCap)
+	}
+
+	return gasTipCap, gasFeeCap, nil
+}
+
 // Read transaction file and make standard transaction.
 func readTxAndInitContexts(clientCtx cmclient.Context, filename string) (cmclient.Context, tx.Factory, sdk.Tx, error) {
 	stdTx, err := authclient.ReadTxFromFile(clientCtx, filename)
@@ -207,9 +493,20 @@ func unmarshalSignatureJSON(clientCtx cmclient.Context, filename string) (sigs [
 	return clientCtx.TxConfig.UnmarshalSignatureJSON(bytes)
 }
 
-// The secp-256k1 private key converts ECDSA privatkey for using evm module.
-func toECDSA(privKey key.PrivateKey) (*ecdsa.PrivateKey, error) {
-	return ethcrypto.ToECDSA(privKey.Bytes())
+// Sign the evm transaction by the signer.
+// The hash of the transaction is signed, and the signature is set by the evm signer of the chain ID.
+func signEvmTx(tx *evmtypes.Transaction, evmSigner evmtypes.Signer, signer types.Signer) (*evmtypes.Transaction, error) {
+	sig, err := signer.SignEvmTxHash(evmSigner.Hash(tx).Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	signedTx, err := tx.WithSignature(evmSigner, sig)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrParse, err)
+	}
+
+	return signedTx, nil
 }
 
 // Get multiple signatures information. It returns keyring of cosmos sdk.
@@ -275,31 +572,127 @@ func isTxSigner(user sdk.AccAddress, signers []sdk.AccAddress) bool {
 	return false
 }
 
-// Get account number and sequence
+// Get account number and sequence.
+// It returns the copied xpla client which has account number and sequence, and the given client is not changed.
+// If the xpla client has the nonce manager, the sequence which is managed locally is used.
 func GetAccNumAndSeq(xplac *xplaClient) (*xplaClient, error) {
-	if xplac.GetAccountNumber() == "" || xplac.GetSequence() == "" {
-		if xplac.GetLcdURL() == "" && xplac.GetGrpcUrl() == "" {
-			xplac.WithAccountNumber(util.FromUint64ToString(types.DefaultAccNum))
-			xplac.WithSequence(util.FromUint64ToString(types.DefaultAccSeq))
+	c := xplac.clone()
+	if c.GetAccountNumber() == "" || c.GetSequence() == "" {
+		if !loadsAccNumAndSeq(c) {
+			c.opts.AccountNumber = util.FromUint64ToString(types.DefaultAccNum)
+			c.opts.Sequence = util.FromUint64ToString(types.DefaultAccSeq)
 		} else {
-			account, err := xplac.LoadAccount(sdk.AccAddress(xplac.GetPrivateKey().PubKey().Address()))
+			accNum, accSeq, err := loadAccNumAndSeq(c)
 			if err != nil {
 				return nil, err
 			}
-			xplac.WithAccountNumber(util.FromUint64ToString(account.GetAccountNumber()))
-			xplac.WithSequence(util.FromUint64ToString(account.GetSequence()))
+			c.opts.AccountNumber = util.FromUint64ToString(accNum)
+			c.opts.Sequence = util.FromUint64ToString(accSeq)
+		}
+	}
+	c.UpdateXplacInCoreModule()
+	return c, nil
+}
+
+// Check the account number and sequence which are not set are loaded from the chain or the nonce manager.
+func loadsAccNumAndSeq(xplac *xplaClient) bool {
+	return (xplac.GetAccountNumber() == "" || xplac.GetSequence() == "") &&
+		(xplac.GetLcdURL() != "" || xplac.GetGrpcUrl() != "")
+}
+
+// Load account number and sequence of the signer.
+// The account is loaded from the chain only when the nonce manager does not have it.
+func loadAccNumAndSeq(xplac *xplaClient) (uint64, uint64, error) {
+	address, err := util.GetAddrBySigner(xplac.GetSigner())
+	if err != nil {
+		return 0, 0, err
+	}
+
+	nonceManager := xplac.GetNonceManager()
+	if nonceManager != nil {
+		accNum, accSeq, ok := nonceManager.Get(address.String())
+		if ok {
+			return accNum, accSeq, nil
 		}
 	}
-	return xplac, nil
+
+	account, err := xplac.LoadAccount(address)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if nonceManager != nil {
+		nonceManager.Set(address.String(), account.GetAccountNumber(), account.GetSequence())
+	}
+
+	return account.GetAccountNumber(), account.GetSequence(), nil
 }
 
+// Update the sequence of the nonce manager after the transaction is broadcasted.
+// The sequence is incremented when the transaction uses the sequence,
+// and it is synchronized with the chain when the sequence of the transaction is mismatched.
+func updateNonce(xplac *xplaClient, usedSequence uint64, consumed bool, log string) {
+	nonceManager := xplac.GetNonceManager()
+	signer := xplac.GetSigner()
+	if nonceManager == nil || signer == nil {
+		return
+	}
+	address := signer.Address()
+
+	switch {
+	case consumed:
+		nonceManager.Increment(address.String(), usedSequence)
+
+	case util.IsSequenceMismatch(log):
+		account, err := xplac.LoadAccount(address)
+		if err != nil {
+			// The account is loaded again when the next transaction is created.
+			nonceManager.Delete(address.String())
+			return
+		}
+		nonceManager.Set(address.String(), account.GetAccountNumber(), account.GetSequence())
+	}
+}
+
+// Get the sequence of the signature which is signed by the signer of the xpla client.
+func signedSequence(xplac *xplaClient, txBytes []byte) (uint64, bool) {
+	signer := xplac.GetSigner()
+	if signer == nil {
+		return 0, false
+	}
+
+	sdkTx, err := xplac.GetEncoding().TxConfig.TxDecoder()(txBytes)
+	if err != nil {
+		return 0, false
+	}
+
+	sigTx, ok := sdkTx.(xauthsigning.SigVerifiableTx)
+	if !ok {
+		return 0, false
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return 0, false
+	}
+
+	pubKey := signer.PubKey()
+	for _, sig := range sigs {
+		if sig.PubKey != nil && sig.PubKey.Equals(pubKey) {
+			return sig.Sequence, true
+		}
+	}
+
+	return 0, false
+}
+
+// Put the VP into the context of the xpla client.
+// The VP is attached to every gRPC, LCD and evm JSON-RPC request which is sent with the context
+// by the VP interceptors of the gRPC connection and the VP transport of HTTP clients.
+// It is called while the xpla client is updated, so the given client must be the new copied client.
 func VPInputGrpcContext(xplac *xplaClient) *xplaClient {
 	if xplac.GetVPByte() != nil {
-		base64VP := base64.StdEncoding.EncodeToString(xplac.GetVPByte())
-
-		privateGrpcHeader := metadata.New(map[string]string{"x-vp": base64VP})
-		newContext := metadata.NewOutgoingContext(xplac.GetContext(), privateGrpcHeader)
-		xplac.WithContext(newContext)
+		xplac.context = util.ContextWithVP(xplac.GetContext(), xplac.GetVPByte())
 	}
 
 	return xplac
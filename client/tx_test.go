@@ -3,9 +3,11 @@ package client
 import (
 	"math/rand"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	mevm "github.com/Moonyongjung/xpriv.go/core/evm"
 	"github.com/Moonyongjung/xpriv.go/provider"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/util"
@@ -14,9 +16,15 @@ import (
 	xapp "github.com/Moonyongjung/xpla-private-chain/app"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	evmtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
@@ -30,9 +38,8 @@ type TestSuite struct {
 }
 
 const (
-	unsignedTxPath  = "../util/testutil/test_files/unsignedTx.json"
-	signedTxPath    = "../util/testutil/test_files/signedTx.json"
-	signedEVMTxPath = "../util/testutil/test_files/signedEVMTx.json"
+	unsignedTxPath = "../util/testutil/test_files/unsignedTx.json"
+	signedTxPath   = "../util/testutil/test_files/signedTx.json"
 )
 
 func (suite *TestSuite) SetupTest() {
@@ -60,7 +67,7 @@ func (suite *TestSuite) TestSimulateCreateUnsignedTx() {
 	fee := util.FromUint64ToString(util.MulUint64(gasLimitU64, gasPriceU64))
 
 	xplac := NewXplaClient(testutil.TestChainId)
-	xplac.WithOptions(
+	xplac = xplac.WithOptions(
 		provider.Options{
 			GasLimit:  types.DefaultGasLimit,
 			FeeAmount: fee,
@@ -94,7 +101,7 @@ func (suite *TestSuite) TestSimulateSignTx() {
 	from := accounts[0]
 
 	xplac := NewXplaClient(testutil.TestChainId)
-	xplac.WithOptions(
+	xplac = xplac.WithOptions(
 		provider.Options{
 			GasLimit:   types.DefaultGasLimit,
 			GasPrice:   types.DefaultGasPrice,
@@ -135,7 +142,7 @@ func (suite *TestSuite) TestSimulateSignatureOnly() {
 
 	accList := []simtypes.Account{from, to, m1, m2}
 	xplac := NewXplaClient(testutil.TestChainId)
-	xplac.WithOptions(
+	xplac = xplac.WithOptions(
 		provider.Options{
 			GasLimit: types.DefaultGasLimit,
 			GasPrice: types.DefaultGasPrice,
@@ -143,7 +150,7 @@ func (suite *TestSuite) TestSimulateSignatureOnly() {
 	)
 
 	for i, acc := range accList {
-		xplac.WithOptions(
+		xplac = xplac.WithOptions(
 			provider.Options{
 				PrivateKey: acc.PrivKey,
 			},
@@ -177,7 +184,7 @@ func (suite *TestSuite) TestSimulateCreateAndSignTx() {
 	coins := randomSendCoins(r, suite.ctx, from, suite.app.BankKeeper, suite.app.AccountKeeper)
 
 	xplac := NewXplaClient(testutil.TestChainId)
-	xplac.WithPrivateKey(from.PrivKey)
+	xplac = xplac.WithPrivateKey(from.PrivKey)
 
 	bankSendMsg := types.BankSendMsg{
 		FromAddress: from.Address.String(),
@@ -199,6 +206,148 @@ func (suite *TestSuite) TestSimulateCreateAndSignTx() {
 	suite.Require().Equal(txbytes, newTxbytes)
 }
 
+func (suite *TestSuite) TestSimulateMemo() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 4)
+	from := accounts[0]
+	to := accounts[1]
+	coins := randomSendCoins(r, suite.ctx, from, suite.app.BankKeeper, suite.app.AccountKeeper)
+
+	testMemo := "test memo"
+	unsignedMemoTxPath := filepath.Join(suite.T().TempDir(), "unsignedMemoTx.json")
+
+	xplac := NewXplaClient(testutil.TestChainId)
+	xplac = xplac.WithOptions(
+		provider.Options{
+			GasLimit:       types.DefaultGasLimit,
+			GasPrice:       types.DefaultGasPrice,
+			PrivateKey:     from.PrivKey,
+			Memo:           testMemo,
+			OutputDocument: unsignedMemoTxPath,
+		},
+	)
+	suite.Require().Equal(testMemo, xplac.GetMemo())
+
+	bankSendMsg := types.BankSendMsg{
+		FromAddress: from.Address.String(),
+		ToAddress:   to.Address.String(),
+		Amount:      coins.String(),
+	}
+
+	// create and sign tx
+	txbytes, err := xplac.WithOutputDocument("").BankSend(bankSendMsg).CreateAndSignTx()
+	suite.Require().NoError(err)
+
+	sdkTx, err := xplac.GetEncoding().TxConfig.TxDecoder()(txbytes)
+	suite.Require().NoError(err)
+	suite.Require().Equal(testMemo, sdkTx.(sdk.TxWithMemo).GetMemo())
+
+	// the memo is kept while the unsigned tx is signed
+	_, err = xplac.BankSend(bankSendMsg).CreateUnsignedTx()
+	suite.Require().NoError(err)
+
+	signTxMsg := types.SignTxMsg{
+		UnsignedFileName: unsignedMemoTxPath,
+	}
+	jsonTx, err := xplac.WithOutputDocument("").SignTx(signTxMsg)
+	suite.Require().NoError(err)
+
+	sdkTx, err = xplac.GetEncoding().TxConfig.TxJSONDecoder()(jsonTx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(testMemo, sdkTx.(sdk.TxWithMemo).GetMemo())
+
+	// the memo exceeds max memo characters
+	invalidMemo := strings.Repeat("m", int(authtypes.DefaultMaxMemoCharacters)+1)
+	_, err = xplac.WithOutputDocument("").WithMemo(invalidMemo).BankSend(bankSendMsg).CreateAndSignTx()
+	suite.Require().Error(err)
+	_, err = xplac.WithOutputDocument("").WithMemo(invalidMemo).BankSend(bankSendMsg).CreateUnsignedTx()
+	suite.Require().Error(err)
+}
+
+func (suite *TestSuite) TestSimulateBatchTx() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 4)
+	from := accounts[0]
+	to := accounts[1]
+	coins := randomSendCoins(r, suite.ctx, from, suite.app.BankKeeper, suite.app.AccountKeeper)
+
+	xplac := NewXplaClient(testutil.TestChainId)
+	xplac = xplac.WithOptions(
+		provider.Options{
+			GasLimit:   types.DefaultGasLimit,
+			GasPrice:   types.DefaultGasPrice,
+			PrivateKey: from.PrivKey,
+		},
+	)
+
+	bankSendMsg := types.BankSendMsg{
+		FromAddress: from.Address.String(),
+		ToAddress:   to.Address.String(),
+		Amount:      coins.String(),
+	}
+	delegateMsg := types.DelegateMsg{
+		Amount:  "1000",
+		ValAddr: sdk.ValAddress(from.Address).String(),
+	}
+	fundCommunityPoolMsg := types.FundCommunityPoolMsg{
+		Amount: "1000",
+	}
+
+	// append messages
+	appendTxbytes, err := xplac.
+		BankSend(bankSendMsg).AppendMsg().
+		Delegate(delegateMsg).AppendMsg().
+		FundCommunityPool(fundCommunityPoolMsg).
+		CreateAndSignTx()
+	suite.Require().NoError(err)
+	suite.Require().Len(xplac.GetBatchMsgs(), 0)
+
+	sdkTx, err := xplac.GetEncoding().TxConfig.TxDecoder()(appendTxbytes)
+	suite.Require().NoError(err)
+
+	msgs := sdkTx.GetMsgs()
+	suite.Require().Len(msgs, 3)
+	suite.Require().IsType(&banktypes.MsgSend{}, msgs[0])
+	suite.Require().IsType(&stakingtypes.MsgDelegate{}, msgs[1])
+	suite.Require().IsType(&distrtypes.MsgFundCommunityPool{}, msgs[2])
+
+	// batch messages
+	batchTxbytes, err := xplac.Batch(
+		xplac.BankSend(bankSendMsg),
+		xplac.Delegate(delegateMsg),
+		xplac.FundCommunityPool(fundCommunityPoolMsg),
+	).CreateAndSignTx()
+	suite.Require().NoError(err)
+	suite.Require().Equal(appendTxbytes, batchTxbytes)
+
+	// messages which are inherited from the receiver are not appended again
+	batchXplac := xplac.Batch(xplac.BankSend(bankSendMsg))
+	batchXplac = batchXplac.Batch(batchXplac.Delegate(delegateMsg))
+	suite.Require().NoError(batchXplac.GetErr())
+
+	batchMsgs := batchXplac.GetBatchMsgs()
+	suite.Require().Len(batchMsgs, 2)
+	suite.Require().Equal(bankSendMsg.FromAddress, batchMsgs[0].Msg.(banktypes.MsgSend).FromAddress)
+	suite.Require().IsType(stakingtypes.MsgDelegate{}, batchMsgs[1].Msg)
+
+	// no message to append
+	_, err = xplac.AppendMsg().CreateAndSignTx()
+	suite.Require().Error(err)
+
+	// evm message cannot be batched
+	_, err = xplac.Batch(
+		xplac.BankSend(bankSendMsg),
+		xplac.EvmSendCoin(types.SendCoinMsg{
+			FromAddress: from.Address.String(),
+			ToAddress:   to.Address.String(),
+			Amount:      "1000",
+		}),
+	).CreateAndSignTx()
+	suite.Require().Error(err)
+}
+
 func (suite *TestSuite) TestSimulateEVMCreateAndSignTx() {
 	s := rand.NewSource(1)
 	r := rand.New(s)
@@ -209,7 +358,7 @@ func (suite *TestSuite) TestSimulateEVMCreateAndSignTx() {
 	testBytecodeJsonFilePath := "../util/testutil/test_files/bytecode.json"
 
 	xplac := NewXplaClient(testutil.TestChainId)
-	xplac.WithPrivateKey(from.PrivKey)
+	xplac = xplac.WithPrivateKey(from.PrivKey).WithGasLimit("1000000")
 
 	// deploy
 	deploySolContractMsg := types.DeploySolContractMsg{
@@ -220,10 +369,65 @@ func (suite *TestSuite) TestSimulateEVMCreateAndSignTx() {
 	txbytes, err := xplac.DeploySolidityContract(deploySolContractMsg).CreateAndSignTx()
 	suite.Require().NoError(err)
 
-	file, err := os.ReadFile(signedEVMTxPath)
+	// the deployment tx is signed when it is created
+	signedTx, err := mevm.DecodeSignedEvmTx(txbytes)
+	suite.Require().NoError(err)
+	suite.Require().Nil(signedTx.To())
+	suite.Require().Equal(uint64(1000000), signedTx.Gas())
+	suite.Require().Equal(uint64(types.DefaultAccSeq), signedTx.Nonce())
+
+	bytecode, err := util.BytecodeParsing(testBytecodeJsonFilePath)
+	suite.Require().NoError(err)
+	suite.Require().Equal(common.FromHex(bytecode), signedTx.Data())
+
+	fromAddr := common.BytesToAddress(from.Address)
+	sender, err := evmtypes.Sender(evmtypes.LatestSignerForChainID(signedTx.ChainId()), signedTx)
 	suite.Require().NoError(err)
+	suite.Require().Equal(fromAddr, sender)
 
-	suite.Require().Equal(txbytes, file)
+	contractAddress, err := mevm.EvmContractAddress(signedTx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(ethcrypto.CreateAddress(fromAddr, signedTx.Nonce()), contractAddress)
+
+	// dynamic fee tx with fee caps
+	deploySolContractMsg.TxOptions = types.EvmTxOptions{
+		TxType:               types.EvmDynamicFeeTxType,
+		MaxFeePerGas:         "2000000000",
+		MaxPriorityFeePerGas: "1000000000",
+	}
+	txbytes, err = xplac.DeploySolidityContract(deploySolContractMsg).CreateAndSignTx()
+	suite.Require().NoError(err)
+
+	signedTx, err = mevm.DecodeSignedEvmTx(txbytes)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint8(evmtypes.DynamicFeeTxType), signedTx.Type())
+	suite.Require().Equal(int64(2000000000), signedTx.GasFeeCap().Int64())
+	suite.Require().Equal(int64(1000000000), signedTx.GasTipCap().Int64())
+}
+
+func (suite *TestSuite) TestSimulateIsEvmTx() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 4)
+	from := accounts[0]
+
+	xplac := NewXplaClient(testutil.TestChainId)
+	xplac = xplac.WithPrivateKey(from.PrivKey).WithGasLimit("1000000")
+
+	deploySolContractMsg := types.DeploySolContractMsg{
+		ABIJsonFilePath:      "../util/testutil/test_files/abi.json",
+		BytecodeJsonFilePath: "../util/testutil/test_files/bytecode.json",
+	}
+	evmTxbytes, err := xplac.DeploySolidityContract(deploySolContractMsg).CreateAndSignTx()
+	suite.Require().NoError(err)
+	suite.Require().True(isEvmTx(evmTxbytes))
+
+	// JSON encoded cosmos transactions, e.g. outputs of SignTx and MultiSign, are not evm transactions
+	for _, path := range []string{unsignedTxPath, signedTxPath} {
+		jsonTxbytes, err := convertJson(path)
+		suite.Require().NoError(err)
+		suite.Require().False(isEvmTx(jsonTxbytes))
+	}
 }
 
 func (suite *TestSuite) TestSimulateEncodeAndDecodeTx() {
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"github.com/Moonyongjung/xpriv.go/util"
+)
+
+// Get the VP from the VP provider of the xpla client, and set it to the copied xpla client.
+// If refresh is true, the new VP is requested to the VP provider.
+// The xpla client is returned as it is if the VP provider does not exist.
+func loadVP(xplac *xplaClient, refresh bool) (*xplaClient, error) {
+	vpProvider := xplac.GetVPProvider()
+	if vpProvider == nil {
+		return xplac, nil
+	}
+
+	var vp []byte
+	var err error
+	if refresh {
+		vp, err = vpProvider.Refresh(xplac.GetContext())
+	} else {
+		vp, err = vpProvider.VP(xplac.GetContext())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	c := xplac.clone()
+	c.VP = vp
+	c.UpdateXplacInCoreModule()
+	return c, nil
+}
+
+// Send the request with the VP of the VP provider.
+// The request is retried once with the refreshed VP if it is failed by the authorization of the VP.
+func requestWithVP[T any](xplac *xplaClient, request func(*xplaClient) (T, error)) (T, error) {
+	var empty T
+	c, err := loadVP(xplac, false)
+	if err != nil {
+		return empty, err
+	}
+
+	res, err := request(c)
+	if err == nil || xplac.GetVPProvider() == nil || !util.IsAuthorizationErr(err) {
+		return res, err
+	}
+
+	c, refreshErr := loadVP(xplac, true)
+	if refreshErr != nil {
+		return res, err
+	}
+
+	return request(c)
+}
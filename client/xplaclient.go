@@ -2,8 +2,6 @@ package client
 
 import (
 	"context"
-	"encoding/json"
-	"io"
 	"os"
 
 	"github.com/Moonyongjung/xpriv.go/core"
@@ -28,6 +26,7 @@ import (
 	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/provider"
 	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
 
 	paramsapp "github.com/Moonyongjung/xpla-private-chain/app/params"
@@ -42,6 +41,11 @@ var _ provider.XplaClient = &xplaClient{}
 
 // The xpla client is a client for performing all functions within the xpla.go library.
 // The user mandatorily inputs chain ID.
+//
+// The xpla client is immutable. Every "With" method and every message method of modules
+// returns a new xpla client which has the changed value, and the receiver is not changed.
+// Thus, a xpla client can be shared by goroutines, and each request chain (e.g. xplac.BankSend(msg).CreateAndSignTx())
+// carries its own message, pagination and options.
 type xplaClient struct {
 	chainId        string
 	encodingConfig paramsapp.EncodingConfig
@@ -49,12 +53,14 @@ type xplaClient struct {
 	context        context.Context
 	VP             []byte
 
-	opts provider.Options
+	opts       provider.Options
+	pagination *query.PageRequest
 
-	module  string
-	msgType string
-	msg     interface{}
-	err     error
+	module    string
+	msgType   string
+	msg       interface{}
+	batchMsgs []provider.BatchMsg
+	err       error
 
 	externalCoreModule
 }
@@ -75,8 +81,12 @@ func NewXplaClient(
 func (xplac *xplaClient) WithOptions(
 	options provider.Options,
 ) provider.XplaClient {
-	return xplac.
-		WithPrivateKey(options.PrivateKey).
+	c := xplac.WithPrivateKey(options.PrivateKey)
+	if options.Signer != nil {
+		c = c.WithSigner(options.Signer)
+	}
+
+	return c.
 		WithAccountNumber(options.AccountNumber).
 		WithBroadcastMode(options.BroadcastMode).
 		WithSequence(options.Sequence).
@@ -87,12 +97,15 @@ func (xplac *xplaClient) WithOptions(
 		WithSignMode(options.SignMode).
 		WithFeeGranter(options.FeeGranter).
 		WithTimeoutHeight(options.TimeoutHeight).
+		WithMemo(options.Memo).
+		WithNonceManager(options.NonceManager).
 		WithURL(options.LcdURL).
 		WithGrpc(options.GrpcURL).
 		WithRpc(options.RpcURL).
 		WithEvmRpc(options.EvmRpcURL).
 		WithPagination(options.Pagination).
 		WithOutputDocument(options.OutputDocument).
+		WithVPProvider(options.VPProvider).
 		UpdateXplacInCoreModule()
 }
 
@@ -120,6 +133,13 @@ type externalCoreModule struct {
 	wasm.WasmExternal
 }
 
+// Copy the xpla client.
+// The copied client shares connections with the receiver, but changing it does not affect the receiver.
+func (xplac *xplaClient) clone() *xplaClient {
+	c := *xplac
+	return &c
+}
+
 // Update xpla client if data in the xplaClient are changed.
 func (xplac *xplaClient) UpdateXplacInCoreModule() provider.XplaClient {
 	xplac.externalCoreModule = externalCoreModule{
@@ -147,214 +167,354 @@ func (xplac *xplaClient) UpdateXplacInCoreModule() provider.XplaClient {
 
 // Set chain ID
 func (xplac *xplaClient) WithChainId(chainId string) provider.XplaClient {
-	xplac.chainId = chainId
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.chainId = chainId
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set encoding configuration
 func (xplac *xplaClient) WithEncoding(encodingConfig paramsapp.EncodingConfig) provider.XplaClient {
-	xplac.encodingConfig = encodingConfig
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.encodingConfig = encodingConfig
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set xpla client context
 func (xplac *xplaClient) WithContext(ctx context.Context) provider.XplaClient {
-	xplac.context = ctx
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.context = ctx
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set private key
+// The private key is also set as the signer of the xpla client.
 func (xplac *xplaClient) WithPrivateKey(privateKey key.PrivateKey) provider.XplaClient {
-	xplac.opts.PrivateKey = privateKey
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.opts.PrivateKey = privateKey
+	c.opts.Signer = nil
+	if privateKey != nil {
+		c.opts.Signer = key.NewPrivKeySigner(privateKey)
+	}
+	return c.UpdateXplacInCoreModule()
+}
+
+// Set signer instead of the private key.
+// The address and the public key of the sender are derived from the signer, and the private key of the xpla client is removed.
+func (xplac *xplaClient) WithSigner(signer types.Signer) provider.XplaClient {
+	c := xplac.clone()
+	c.opts.PrivateKey = nil
+	c.opts.Signer = signer
+	return c.UpdateXplacInCoreModule()
+}
+
+// Set the key of the keyring as the signer.
+// The keyring of the backend, file, test or os, is opened at the path, and the key which name is key name
+// signs cosmos and evm transactions. The passphrase is required by the file backend.
+func (xplac *xplaClient) WithKeyring(backendType string, keyringPath string, keyName string, passphrase ...string) provider.XplaClient {
+	kr, err := key.OpenKeyring(backendType, keyringPath, passphrase...)
+	if err != nil {
+		return xplac.WithErr(err)
+	}
+
+	signer, err := key.NewKeyringSigner(kr, keyName)
+	if err != nil {
+		return xplac.WithErr(err)
+	}
+
+	return xplac.WithSigner(signer)
 }
 
 // Set LCD URL
 func (xplac *xplaClient) WithURL(lcdURL string) provider.XplaClient {
-	xplac.opts.LcdURL = lcdURL
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.opts.LcdURL = lcdURL
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set GRPC URL to query or broadcast tx
 func (xplac *xplaClient) WithGrpc(grpcUrl string) provider.XplaClient {
+	c := xplac.clone()
 	connUrl := util.GrpcUrlParsing(grpcUrl)
-	c, err := grpc.Dial(
+	conn, err := grpc.Dial(
 		connUrl, grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(util.VPUnaryClientInterceptor),
+		grpc.WithStreamInterceptor(util.VPStreamClientInterceptor),
 	)
 	if err != nil {
-		xplac.err = err
-		return xplac.UpdateXplacInCoreModule()
+		c.err = err
+		return c.UpdateXplacInCoreModule()
 	}
-	xplac.grpc = c
-	xplac.opts.GrpcURL = grpcUrl
-	return xplac.UpdateXplacInCoreModule()
+	c.grpc = conn
+	c.opts.GrpcURL = grpcUrl
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set RPC URL of tendermint core
 func (xplac *xplaClient) WithRpc(rpcUrl string) provider.XplaClient {
-	xplac.opts.RpcURL = rpcUrl
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.opts.RpcURL = rpcUrl
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set RPC URL for evm module
 func (xplac *xplaClient) WithEvmRpc(evmRpcUrl string) provider.XplaClient {
-	xplac.opts.EvmRpcURL = evmRpcUrl
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.opts.EvmRpcURL = evmRpcUrl
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set broadcast mode
 func (xplac *xplaClient) WithBroadcastMode(broadcastMode string) provider.XplaClient {
-	xplac.opts.BroadcastMode = broadcastMode
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.opts.BroadcastMode = broadcastMode
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set account number
 func (xplac *xplaClient) WithAccountNumber(accountNumber string) provider.XplaClient {
-	xplac.opts.AccountNumber = accountNumber
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.opts.AccountNumber = accountNumber
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set account sequence
 func (xplac *xplaClient) WithSequence(sequence string) provider.XplaClient {
-	xplac.opts.Sequence = sequence
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.opts.Sequence = sequence
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set gas limit
 func (xplac *xplaClient) WithGasLimit(gasLimit string) provider.XplaClient {
-	xplac.opts.GasLimit = gasLimit
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.opts.GasLimit = gasLimit
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set Gas price
 func (xplac *xplaClient) WithGasPrice(gasPrice string) provider.XplaClient {
-	xplac.opts.GasPrice = gasPrice
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.opts.GasPrice = gasPrice
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set Gas adjustment
 func (xplac *xplaClient) WithGasAdjustment(gasAdjustment string) provider.XplaClient {
-	xplac.opts.GasAdjustment = gasAdjustment
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.opts.GasAdjustment = gasAdjustment
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set fee amount
 func (xplac *xplaClient) WithFeeAmount(feeAmount string) provider.XplaClient {
-	xplac.opts.FeeAmount = feeAmount
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.opts.FeeAmount = feeAmount
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set transaction sign mode
 func (xplac *xplaClient) WithSignMode(signMode signing.SignMode) provider.XplaClient {
-	xplac.opts.SignMode = signMode
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.opts.SignMode = signMode
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set fee granter
 func (xplac *xplaClient) WithFeeGranter(feeGranter sdk.AccAddress) provider.XplaClient {
-	xplac.opts.FeeGranter = feeGranter
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.opts.FeeGranter = feeGranter
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set timeout block height
 func (xplac *xplaClient) WithTimeoutHeight(timeoutHeight string) provider.XplaClient {
-	xplac.opts.TimeoutHeight = timeoutHeight
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.opts.TimeoutHeight = timeoutHeight
+	return c.UpdateXplacInCoreModule()
+}
+
+// Set memo of transaction
+func (xplac *xplaClient) WithMemo(memo string) provider.XplaClient {
+	c := xplac.clone()
+	c.opts.Memo = memo
+	return c.UpdateXplacInCoreModule()
+}
+
+// Set nonce manager which manages account number and sequence of the private key locally
+func (xplac *xplaClient) WithNonceManager(nonceManager *util.NonceManager) provider.XplaClient {
+	c := xplac.clone()
+	c.opts.NonceManager = nonceManager
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set pagination
 func (xplac *xplaClient) WithPagination(pagination types.Pagination) provider.XplaClient {
+	c := xplac.clone()
 	emptyPagination := types.Pagination{}
 	if pagination != emptyPagination {
 		pageReq, err := core.ReadPageRequest(pagination)
 		if err != nil {
-			xplac.err = err
+			c.err = err
 		}
-		core.PageRequest = pageReq
+		c.pagination = pageReq
 	} else {
-		core.PageRequest = core.DefaultPagination()
+		c.pagination = core.DefaultPagination()
 	}
 
-	return xplac.UpdateXplacInCoreModule()
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set output document name
 func (xplac *xplaClient) WithOutputDocument(outputDocument string) provider.XplaClient {
-	xplac.opts.OutputDocument = outputDocument
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.opts.OutputDocument = outputDocument
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set module name
 func (xplac *xplaClient) WithModule(module string) provider.XplaClient {
-	xplac.module = module
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.module = module
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set message type of modules
 func (xplac *xplaClient) WithMsgType(msgType string) provider.XplaClient {
-	xplac.msgType = msgType
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.msgType = msgType
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set message
 func (xplac *xplaClient) WithMsg(msg interface{}) provider.XplaClient {
-	xplac.msg = msg
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.msg = msg
+	return c.UpdateXplacInCoreModule()
+}
+
+// Set messages for the multi-message transaction.
+func (xplac *xplaClient) WithBatchMsgs(batchMsgs []provider.BatchMsg) provider.XplaClient {
+	c := xplac.clone()
+	c.batchMsgs = append([]provider.BatchMsg(nil), batchMsgs...)
+	return c.UpdateXplacInCoreModule()
+}
+
+// Append the message which is set by the module method to the messages of the multi-message transaction.
+// The module and message of the returned client are cleared in order to set the next message.
+//
+//	txbytes, err := xplac.BankSend(bankSendMsg).AppendMsg().Delegate(delegateMsg).AppendMsg().CreateAndSignTx()
+func (xplac *xplaClient) AppendMsg() provider.XplaClient {
+	if xplac.GetErr() != nil {
+		return xplac
+	}
+	if xplac.GetModule() == "" {
+		return xplac.WithErr(util.LogErr(errors.ErrInvalidRequest, "no message to append"))
+	}
+
+	batchMsg := provider.BatchMsg{
+		Module:  xplac.GetModule(),
+		MsgType: xplac.GetMsgType(),
+		Msg:     xplac.GetMsg(),
+	}
+
+	batchMsgs := append([]provider.BatchMsg(nil), xplac.GetBatchMsgs()...)
+	return provider.ResetModuleAndMsgXplac(
+		xplac.WithBatchMsgs(append(batchMsgs, batchMsg)),
+	)
+}
+
+// Append messages of the given xpla clients to the messages of the multi-message transaction.
+// Each given client is made from the module method, and the first error of them is set to the returned client.
+// Only the message of the module method is appended, so messages which the given client inherits
+// from the receiver are not appended again.
+//
+//	txbytes, err := xplac.Batch(
+//		xplac.BankSend(bankSendMsg),
+//		xplac.Delegate(delegateMsg),
+//	).CreateAndSignTx()
+func (xplac *xplaClient) Batch(xplacs ...provider.XplaClient) provider.XplaClient {
+	if xplac.GetErr() != nil {
+		return xplac
+	}
+
+	batchMsgs := append([]provider.BatchMsg(nil), xplac.GetBatchMsgs()...)
+	for _, x := range xplacs {
+		if x.GetErr() != nil {
+			return xplac.WithErr(x.GetErr())
+		}
+
+		if x.GetModule() != "" {
+			batchMsgs = append(batchMsgs, provider.BatchMsg{
+				Module:  x.GetModule(),
+				MsgType: x.GetMsgType(),
+				Msg:     x.GetMsg(),
+			})
+		}
+	}
+
+	return xplac.WithBatchMsgs(batchMsgs)
 }
 
 // Set error
 func (xplac *xplaClient) WithErr(err error) provider.XplaClient {
-	xplac.err = err
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.err = err
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set trigger use VP
 func (xplac *xplaClient) WithUseVP(useVP bool) provider.XplaClient {
-	xplac.opts.UseVP = useVP
-	return xplac.UpdateXplacInCoreModule()
+	c := xplac.clone()
+	c.opts.UseVP = useVP
+	return c.UpdateXplacInCoreModule()
 }
 
 // Set Verifiable Presentation by file path
 func (xplac *xplaClient) WithVPByPath(vpPath string) provider.XplaClient {
-	if xplac.GetUseVP() {
-		if xplac.VP == nil {
-			f, err := os.Open(vpPath)
-			if err != nil {
-				xplac.err = err
-			}
-			defer f.Close()
-
-			jsonByte, err := io.ReadAll(f)
+	c := xplac.clone()
+	if c.GetUseVP() {
+		if c.VP == nil {
+			jsonByte, err := os.ReadFile(vpPath)
 			if err != nil {
-				xplac.err = err
+				c.err = err
+				return c.UpdateXplacInCoreModule()
 			}
 
-			xplac.VP = jsonByte
+			c.VP = jsonByte
 		}
 	}
 
-	return xplac.UpdateXplacInCoreModule()
+	return c.UpdateXplacInCoreModule()
 }
 
-// Set Verifiable Presentation by string
+// Set Verifiable Presentation by string.
+// The string is the VP JSON, and it is used as it is.
 func (xplac *xplaClient) WithVPByString(vp string) provider.XplaClient {
-	if xplac.GetUseVP() {
-		if xplac.VP == nil {
-			jsonByte, err := json.Marshal(vp)
-			if err != nil {
-				xplac.err = err
-			}
-
-			xplac.VP = jsonByte
+	c := xplac.clone()
+	if c.GetUseVP() {
+		if c.VP == nil {
+			c.VP = []byte(vp)
 		}
 	}
 
-	return xplac.UpdateXplacInCoreModule()
+	return c.UpdateXplacInCoreModule()
+}
+
+// Set VP provider which provides the VP whenever the xpla client sends a request.
+// The VP of the provider takes precedence over the VP which is set by WithVPByPath or WithVPByString.
+func (xplac *xplaClient) WithVPProvider(vpProvider types.VPProvider) provider.XplaClient {
+	c := xplac.clone()
+	c.opts.VPProvider = vpProvider
+	if vpProvider != nil {
+		c.opts.UseVP = true
+	}
+	return c.UpdateXplacInCoreModule()
 }
 
 // Get parameters of the xpla client
 func (xplac *xplaClient) GetChainId() string                    { return xplac.chainId }
 func (xplac *xplaClient) GetPrivateKey() key.PrivateKey         { return xplac.opts.PrivateKey }
+func (xplac *xplaClient) GetSigner() types.Signer               { return xplac.opts.Signer }
 func (xplac *xplaClient) GetEncoding() paramsapp.EncodingConfig { return xplac.encodingConfig }
 func (xplac *xplaClient) GetContext() context.Context           { return xplac.context }
 func (xplac *xplaClient) GetLcdURL() string                     { return xplac.opts.LcdURL }
@@ -372,11 +532,15 @@ func (xplac *xplaClient) GetFeeAmount() string                  { return xplac.o
 func (xplac *xplaClient) GetSignMode() signing.SignMode         { return xplac.opts.SignMode }
 func (xplac *xplaClient) GetFeeGranter() sdk.AccAddress         { return xplac.opts.FeeGranter }
 func (xplac *xplaClient) GetTimeoutHeight() string              { return xplac.opts.TimeoutHeight }
-func (xplac *xplaClient) GetPagination() *query.PageRequest     { return core.PageRequest }
+func (xplac *xplaClient) GetMemo() string                       { return xplac.opts.Memo }
+func (xplac *xplaClient) GetNonceManager() *util.NonceManager   { return xplac.opts.NonceManager }
+func (xplac *xplaClient) GetPagination() *query.PageRequest     { return xplac.pagination }
 func (xplac *xplaClient) GetOutputDocument() string             { return xplac.opts.OutputDocument }
 func (xplac *xplaClient) GetModule() string                     { return xplac.module }
 func (xplac *xplaClient) GetMsgType() string                    { return xplac.msgType }
 func (xplac *xplaClient) GetMsg() interface{}                   { return xplac.msg }
+func (xplac *xplaClient) GetBatchMsgs() []provider.BatchMsg     { return xplac.batchMsgs }
 func (xplac *xplaClient) GetErr() error                         { return xplac.err }
 func (xplac *xplaClient) GetUseVP() bool                        { return xplac.opts.UseVP }
 func (xplac *xplaClient) GetVPByte() []byte                     { return xplac.VP }
+func (xplac *xplaClient) GetVPProvider() types.VPProvider       { return xplac.opts.VPProvider }
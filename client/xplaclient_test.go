@@ -2,12 +2,21 @@ package client_test
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"io"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/Moonyongjung/xpriv.go/client"
 	mbank "github.com/Moonyongjung/xpriv.go/core/bank"
+	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/provider"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/util"
@@ -21,6 +30,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
@@ -31,6 +41,7 @@ import (
 var (
 	testBroadcastMode  = "sync"
 	testTimeoutHeight  = "1000"
+	testMemo           = "memo"
 	testLcdUrl         = "https://cube-lcd.xpla.dev"
 	testGrpcUrl        = "https://cube-grpc.xpla.dev"
 	testRpcUrl         = "https://cube-rpc.xpla.dev"
@@ -74,6 +85,7 @@ func TestNewXplaClient(t *testing.T) {
 		SignMode:       signing.SignMode_SIGN_MODE_DIRECT,
 		FeeGranter:     feegranter.Address,
 		TimeoutHeight:  testTimeoutHeight,
+		Memo:           testMemo,
 		LcdURL:         testLcdUrl,
 		GrpcURL:        testGrpcUrl,
 		RpcURL:         testRpcUrl,
@@ -83,9 +95,9 @@ func TestNewXplaClient(t *testing.T) {
 	}
 
 	xplac := client.NewXplaClient(testutil.TestChainId).WithOptions(newClientOption)
-	xplac.Total()
+	xplac = xplac.Total()
 
-	totalMsg, err := mbank.MakeTotalSupplyMsg()
+	totalMsg, err := mbank.MakeTotalSupplyMsg(xplac.GetPagination())
 	assert.NoError(t, err)
 
 	assert.Equal(t, testutil.TestChainId, xplac.GetChainId())
@@ -105,6 +117,7 @@ func TestNewXplaClient(t *testing.T) {
 	assert.Equal(t, signing.SignMode_SIGN_MODE_DIRECT, xplac.GetSignMode())
 	assert.Equal(t, feegranter.Address, xplac.GetFeeGranter())
 	assert.Equal(t, testTimeoutHeight, xplac.GetTimeoutHeight())
+	assert.Equal(t, testMemo, xplac.GetMemo())
 	assert.Equal(t, testPagination.Reverse, xplac.GetPagination().Reverse)
 	assert.Equal(t, testOutputDocument, xplac.GetOutputDocument())
 	assert.Equal(t, mbank.BankModule, xplac.GetModule())
@@ -113,6 +126,209 @@ func TestNewXplaClient(t *testing.T) {
 	assert.Equal(t, totalMsg, xplac.GetMsg())
 }
 
+func TestXplaClientImmutable(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := testutil.RandomAccounts(r, 2)
+
+	xplac := client.NewXplaClient(testutil.TestChainId).
+		WithPrivateKey(accounts[0].PrivKey)
+
+	newXplac := xplac.WithGasLimit(types.DefaultGasLimit).
+		WithPagination(types.Pagination{Limit: 10})
+	assert.Equal(t, "", xplac.GetGasLimit())
+	assert.Nil(t, xplac.GetPagination())
+	assert.Equal(t, types.DefaultGasLimit, newXplac.GetGasLimit())
+	assert.Equal(t, uint64(10), newXplac.GetPagination().Limit)
+
+	bankSendMsg := types.BankSendMsg{
+		FromAddress: accounts[0].Address.String(),
+		ToAddress:   accounts[1].Address.String(),
+		Amount:      "1000",
+	}
+	sendXplac := xplac.BankSend(bankSendMsg)
+	assert.Equal(t, "", xplac.GetModule())
+	assert.Nil(t, xplac.GetMsg())
+	assert.Equal(t, mbank.BankModule, sendXplac.GetModule())
+	assert.Equal(t, mbank.BankSendMsgType, sendXplac.GetMsgType())
+
+	_, err := sendXplac.CreateAndSignTx()
+	assert.NoError(t, err)
+	assert.Equal(t, "", sendXplac.GetAccountNumber())
+	assert.Equal(t, "", sendXplac.GetSequence())
+}
+
+func TestXplaClientSigner(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := testutil.RandomAccounts(r, 2)
+
+	bankSendMsg := types.BankSendMsg{
+		FromAddress: accounts[0].Address.String(),
+		ToAddress:   accounts[1].Address.String(),
+		Amount:      "1000",
+	}
+
+	// the signer is used without the private key
+	signer := key.NewPrivKeySigner(accounts[0].PrivKey)
+	xplac := client.NewXplaClient(testutil.TestChainId).WithSigner(signer)
+	assert.Nil(t, xplac.GetPrivateKey())
+	assert.Equal(t, signer, xplac.GetSigner())
+
+	unjailXplac := xplac.Unjail()
+	assert.NoError(t, unjailXplac.GetErr())
+	assert.Equal(t, sdk.ValAddress(accounts[0].Address).String(), unjailXplac.GetMsg().(slashingtypes.MsgUnjail).ValidatorAddr)
+
+	signerTxbytes, err := xplac.BankSend(bankSendMsg).CreateAndSignTx()
+	assert.NoError(t, err)
+
+	privKeyTxbytes, err := xplac.WithPrivateKey(accounts[0].PrivKey).BankSend(bankSendMsg).CreateAndSignTx()
+	assert.NoError(t, err)
+	assert.Equal(t, privKeyTxbytes, signerTxbytes)
+
+	// neither the private key nor the signer
+	xplac = client.NewXplaClient(testutil.TestChainId)
+	assert.Nil(t, xplac.GetSigner())
+	assert.Error(t, xplac.Unjail().GetErr())
+
+	_, err = xplac.BankSend(bankSendMsg).CreateAndSignTx()
+	assert.Error(t, err)
+}
+
+func TestVPTransport(t *testing.T) {
+	vp := `{"type":["VerifiablePresentation"]}`
+
+	// VP string is used as it is
+	xplac := client.NewXplaClient(testutil.TestChainId).WithUseVP(true).WithVPByString(vp)
+	assert.Equal(t, []byte(vp), xplac.GetVPByte())
+
+	var header string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get(util.VPHeader)
+	}))
+	defer server.Close()
+
+	_, err := util.CtxHttpClient("GET", server.URL, nil, xplac.GetContext())
+	assert.NoError(t, err)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte(vp)), header)
+
+	// without VP
+	_, err = util.CtxHttpClient("GET", server.URL, nil, context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "", header)
+}
+
+func TestModuleLcdQueryWithVP(t *testing.T) {
+	vp := `{"type":["VerifiablePresentation"]}`
+
+	var method, header string
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		header = r.Header.Get(util.VPHeader)
+		body, _ = io.ReadAll(r.Body)
+		w.Write([]byte(`{"balances":[],"pagination":null}`))
+	}))
+	defer server.Close()
+
+	xplac := client.NewXplaClient(testutil.TestChainId).WithURL(server.URL).WithUseVP(true).WithVPByString(vp)
+	_, err := xplac.BankBalances(types.BankBalancesMsg{Address: sdk.AccAddress(make([]byte, 20)).String()}).Query()
+	assert.NoError(t, err)
+
+	// the VP is sent by the body of the private chain, and it is also attached to the header
+	assert.Equal(t, http.MethodPost, method)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte(vp)), header)
+	assert.Equal(t, vp, string(body))
+}
+
+func TestFileVPProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vp.json")
+	modTime := time.Now().Add(-time.Hour)
+	writeVP := func(vp string, modTime time.Time) {
+		assert.NoError(t, os.WriteFile(path, []byte(vp), 0600))
+		assert.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	writeVP(`{"holder":"old"}`, modTime)
+	vpProvider := util.NewFileVPProvider(path)
+
+	vp, err := vpProvider.VP(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, `{"holder":"old"}`, string(vp))
+
+	// the VP which is renewed before the expiry is loaded when the file is changed
+	modTime = modTime.Add(time.Minute)
+	writeVP(`{"holder":"renewed"}`, modTime)
+	vp, err = vpProvider.VP(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, `{"holder":"renewed"}`, string(vp))
+
+	// the loaded VP is used while the modification time is not changed, but refresh reads the file
+	writeVP(`{"holder":"refreshed"}`, modTime)
+	vp, err = vpProvider.VP(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, `{"holder":"renewed"}`, string(vp))
+
+	vp, err = vpProvider.Refresh(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, `{"holder":"refreshed"}`, string(vp))
+
+	// the removed file
+	assert.NoError(t, os.Remove(path))
+	_, err = vpProvider.VP(context.Background())
+	assert.Error(t, err)
+}
+
+func TestConcurrentXplaClient(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := testutil.RandomAccounts(r, 2)
+
+	xplac := client.NewXplaClient(testutil.TestChainId).
+		WithPrivateKey(accounts[0].PrivKey)
+
+	var wg sync.WaitGroup
+	for i := 1; i <= 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			// tx builds
+			amount := util.FromIntToString(i)
+			bankSendMsg := types.BankSendMsg{
+				FromAddress: accounts[0].Address.String(),
+				ToAddress:   accounts[1].Address.String(),
+				Amount:      amount,
+			}
+			txbytes, err := xplac.BankSend(bankSendMsg).CreateAndSignTx()
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			sdkTx, err := xplac.GetEncoding().TxConfig.TxDecoder()(txbytes)
+			if !assert.NoError(t, err) {
+				return
+			}
+			sendMsg, ok := sdkTx.GetMsgs()[0].(*banktypes.MsgSend)
+			if assert.True(t, ok) {
+				assert.Equal(t, amount, sendMsg.Amount.AmountOf(types.XplaDenom).String())
+			}
+
+			// query msgs with pagination
+			pageXplac := xplac.WithPagination(types.Pagination{Limit: uint64(i)})
+			totalMsg, ok := pageXplac.Total().GetMsg().(banktypes.QueryTotalSupplyRequest)
+			if assert.True(t, ok) {
+				assert.Equal(t, uint64(i), totalMsg.Pagination.Limit)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, "", xplac.GetModule())
+	assert.Nil(t, xplac.GetMsg())
+	assert.Nil(t, xplac.GetPagination())
+}
+
 var (
 	validatorNumber = 2
 	testSendAmount  = "1000"
@@ -0,0 +1,42 @@
+// Command xpriv-bindgen generates the typed Go binding of the solidity contract from the ABI JSON.
+// It is able to be used by go generate, e.g.
+//
+//	//go:generate go run github.com/Moonyongjung/xpriv.go/cmd/xpriv-bindgen -abi abi.json -bin bytecode.json -pkg store -type Store -out store.go
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/Moonyongjung/xpriv.go/core/evm/bindgen"
+)
+
+func main() {
+	abiPath := flag.String("abi", "", "path of the ABI JSON file of the contract")
+	binPath := flag.String("bin", "", "path of the bytecode file of the contract (optional, required to generate the deploy function)")
+	pkg := flag.String("pkg", "", "package name of the generated file")
+	typeName := flag.String("type", "", "type name of the contract binding")
+	out := flag.String("out", "", "output file path (default stdout)")
+	flag.Parse()
+
+	if *abiPath == "" || *pkg == "" || *typeName == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	source, err := bindgen.GenerateFromFiles(*pkg, *typeName, *abiPath, *binPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if *out == "" {
+		os.Stdout.Write(source)
+		return
+	}
+	if err := os.WriteFile(*out, source, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
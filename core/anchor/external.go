@@ -18,11 +18,11 @@ func NewAnchorExternal(xplac provider.XplaClient) (e AnchorExternal) {
 
 // Register anchor account of the validator in the private chain
 func (e AnchorExternal) RegisterAnchorAcc(registerAnchorAccMsg types.RegisterAnchorAccMsg) provider.XplaClient {
-	msg, err := MakeRegisterAnchorAccMsg(registerAnchorAccMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeRegisterAnchorAccMsg(registerAnchorAccMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(AnchorModule).
+	e.Xplac = e.Xplac.WithModule(AnchorModule).
 		WithMsgType(AnchorRegisterAnchorAccMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -30,11 +30,11 @@ func (e AnchorExternal) RegisterAnchorAcc(registerAnchorAccMsg types.RegisterAnc
 
 // Change anchor account of the validator in the private chain
 func (e AnchorExternal) ChangeAnchorAcc(changeAnchorAccMsg types.ChangeAnchorAccMsg) provider.XplaClient {
-	msg, err := MakeChangeAnchorAccMsg(changeAnchorAccMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeChangeAnchorAccMsg(changeAnchorAccMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(AnchorModule).
+	e.Xplac = e.Xplac.WithModule(AnchorModule).
 		WithMsgType(AnchorChangeAnchorAccMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -48,7 +48,7 @@ func (e AnchorExternal) AnchorAcc(anchorAccMsg types.AnchorAccMsg) provider.Xpla
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(AnchorModule).
+	e.Xplac = e.Xplac.WithModule(AnchorModule).
 		WithMsgType(AnchorQueryAnchorAccMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -56,11 +56,11 @@ func (e AnchorExternal) AnchorAcc(anchorAccMsg types.AnchorAccMsg) provider.Xpla
 
 // Query aggregated blocks are saved in the state DB.
 func (e AnchorExternal) AllAggregatedBlocks() provider.XplaClient {
-	msg, err := MakeAllAggregatedBlocksMsg()
+	msg, err := MakeAllAggregatedBlocksMsg(e.Xplac.GetPagination())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(AnchorModule).
+	e.Xplac = e.Xplac.WithModule(AnchorModule).
 		WithMsgType(AnchorAllAggregatedBlocksMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -72,7 +72,7 @@ func (e AnchorExternal) AnchorInfo(anchorInfoMsg types.AnchorInfoMsg) provider.X
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(AnchorModule).
+	e.Xplac = e.Xplac.WithModule(AnchorModule).
 		WithMsgType(AnchorAnchorInfoMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -84,7 +84,7 @@ func (e AnchorExternal) AnchorBlock(anchorBlockMsg types.AnchorBlockMsg) provide
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(AnchorModule).
+	e.Xplac = e.Xplac.WithModule(AnchorModule).
 		WithMsgType(AnchorAnchorBlockMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -96,7 +96,7 @@ func (e AnchorExternal) AnchorTxBody(anchorTxBodyMsg types.AnchorTxBodyMsg) prov
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(AnchorModule).
+	e.Xplac = e.Xplac.WithModule(AnchorModule).
 		WithMsgType(AnchorAnchorTxBodyMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -108,7 +108,7 @@ func (e AnchorExternal) AnchorVerify(anchorVerifyMsg types.AnchorVerifyMsg) prov
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(AnchorModule).
+	e.Xplac = e.Xplac.WithModule(AnchorModule).
 		WithMsgType(AnchorVerifyMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -120,7 +120,7 @@ func (e AnchorExternal) AnchorBalances(anchorBalancesMsg types.AnchorBalancesMsg
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(AnchorModule).
+	e.Xplac = e.Xplac.WithModule(AnchorModule).
 		WithMsgType(AnchorAnchorBalancesMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -132,7 +132,7 @@ func (e AnchorExternal) AnchorParams() provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(AnchorModule).
+	e.Xplac = e.Xplac.WithModule(AnchorModule).
 		WithMsgType(AnchorParamsMsgType).
 		WithMsg(msg)
 	return e.Xplac
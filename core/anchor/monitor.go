@@ -0,0 +1,294 @@
+package anchor
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Moonyongjung/xpriv.go/core"
+	"github.com/Moonyongjung/xpriv.go/provider"
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	anchortypes "github.com/Moonyongjung/xpla-private-chain/x/anchor/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const DefaultAnchorMonitorInterval = time.Minute
+
+type AnchorAlertType string
+
+const (
+	// The validator has no anchor account.
+	AnchorAlertNoAccount AnchorAlertType = "no-anchor-account"
+	// The anchor account is changed.
+	AnchorAlertAccountChanged AnchorAlertType = "anchor-account-changed"
+	// The balance of the anchor account is lower than the minimum balance.
+	AnchorAlertLowBalance AnchorAlertType = "low-balance"
+	// The gap between the latest height and the latest anchored height is larger than the max gap.
+	AnchorAlertAnchorGap AnchorAlertType = "anchor-gap"
+)
+
+// Options of the anchor account monitor.
+type AnchorMonitorOptions struct {
+	// Validator operator addresses of the private chain, e.g. "xprivvaloper1...".
+	Validators []string
+	// Interval of checking. Default value is 1 minute.
+	Interval time.Duration
+	// Alert when the balance of the anchor account is lower than it, e.g. "1000000000000000000axpla".
+	// If it is empty, the balance is not checked.
+	MinBalance string
+	// Alert when the latest height minus the latest anchored height is larger than it.
+	// If it is 0, the gap is not checked.
+	MaxAnchorGap int64
+
+	// Called when the threshold is crossed, and when the alert is resolved.
+	OnAlert func(AnchorAlert)
+	// Called with the status of each validator for every check.
+	OnStatus func(AnchorAccountStatus)
+	// Called when the check is failed. The monitor keeps running.
+	OnError func(error)
+}
+
+// Status of the anchor account of the validator.
+type AnchorAccountStatus struct {
+	ValidatorAddr        string    `json:"validator_addr"`
+	AnchorAccountAddr    string    `json:"anchor_account_addr"`
+	Balances             sdk.Coins `json:"balances"`
+	LatestHeight         int64     `json:"latest_height"`
+	LatestAnchoredHeight int64     `json:"latest_anchored_height"`
+	AnchorGap            int64     `json:"anchor_gap"`
+	CheckedAt            time.Time `json:"checked_at"`
+}
+
+// Alert of the anchor account monitor.
+type AnchorAlert struct {
+	Type AnchorAlertType `json:"type"`
+	// True if the alert condition is cleared.
+	Resolved bool                `json:"resolved"`
+	Message  string              `json:"message"`
+	Status   AnchorAccountStatus `json:"status"`
+}
+
+// Monitor of anchor accounts of validators.
+// It tracks the anchor account, the balance of it and the anchor gap of each validator, and emits alerts
+// by callbacks when thresholds are crossed. Alerts are emitted only when the state is changed.
+type AnchorMonitor struct {
+	xplac      provider.XplaClient
+	options    AnchorMonitorOptions
+	minBalance *sdk.Coin
+
+	mu       sync.Mutex
+	accounts map[string]string
+	alerts   map[string]bool
+}
+
+// Make the anchor account monitor.
+func NewAnchorMonitor(xplac provider.XplaClient, options AnchorMonitorOptions) (*AnchorMonitor, error) {
+	if len(options.Validators) == 0 {
+		return nil, util.LogErr(errors.ErrInsufficientParams, "need validators to monitor")
+	}
+	if options.Interval <= 0 {
+		options.Interval = DefaultAnchorMonitorInterval
+	}
+
+	m := &AnchorMonitor{
+		xplac:    xplac,
+		options:  options,
+		accounts: make(map[string]string),
+		alerts:   make(map[string]bool),
+	}
+
+	if options.MinBalance != "" {
+		minBalance, err := sdk.ParseCoinNormalized(options.MinBalance)
+		if err != nil {
+			return nil, util.LogErr(errors.ErrParse, err)
+		}
+		m.minBalance = &minBalance
+	}
+
+	return m, nil
+}
+
+// Run the monitor until the context is done.
+func (m *AnchorMonitor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := m.Check(ctx); err != nil && m.options.OnError != nil {
+			m.options.OnError(err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check anchor accounts of validators once, and emit alerts and statuses by callbacks.
+func (m *AnchorMonitor) Check(ctx context.Context) ([]AnchorAccountStatus, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	xplac := m.xplac.WithContext(ctx)
+
+	latestHeight, err := queryLatestHeight(xplac)
+	if err != nil {
+		return nil, err
+	}
+	latestAnchoredHeight, err := queryLatestAnchoredHeight(ctx, xplac)
+	if err != nil {
+		return nil, err
+	}
+
+	// The anchor gap is same for all validators, so the alert of it has no validator.
+	if m.options.MaxAnchorGap > 0 {
+		gapStatus := AnchorAccountStatus{
+			LatestHeight:         latestHeight,
+			LatestAnchoredHeight: latestAnchoredHeight,
+			AnchorGap:            latestHeight - latestAnchoredHeight,
+			CheckedAt:            time.Now(),
+		}
+		m.setAlert(AnchorAlertAnchorGap, gapStatus, gapStatus.AnchorGap > m.options.MaxAnchorGap,
+			fmt.Sprintf("anchor gap is %d, larger than %d", gapStatus.AnchorGap, m.options.MaxAnchorGap))
+	}
+
+	var statuses []AnchorAccountStatus
+	for _, validator := range m.options.Validators {
+		status := AnchorAccountStatus{
+			ValidatorAddr:        validator,
+			LatestHeight:         latestHeight,
+			LatestAnchoredHeight: latestAnchoredHeight,
+			AnchorGap:            latestHeight - latestAnchoredHeight,
+			CheckedAt:            time.Now(),
+		}
+
+		anchorAccountAddr, err := queryAnchorAccount(xplac, validator)
+		if err != nil {
+			return statuses, err
+		}
+		status.AnchorAccountAddr = anchorAccountAddr
+		m.setAlert(AnchorAlertNoAccount, status, status.AnchorAccountAddr == "",
+			fmt.Sprintf("validator %s has no anchor account", validator))
+
+		if status.AnchorAccountAddr != "" {
+			if prev, ok := m.accounts[validator]; ok && prev != status.AnchorAccountAddr {
+				m.emit(AnchorAlert{
+					Type:    AnchorAlertAccountChanged,
+					Message: fmt.Sprintf("anchor account of validator %s is changed from %s to %s", validator, prev, status.AnchorAccountAddr),
+					Status:  status,
+				})
+			}
+			m.accounts[validator] = status.AnchorAccountAddr
+
+			balances, err := queryAnchorBalances(xplac, validator)
+			if err != nil {
+				return statuses, err
+			}
+			status.Balances = balances
+
+			if m.minBalance != nil {
+				balance := balances.AmountOf(m.minBalance.Denom)
+				m.setAlert(AnchorAlertLowBalance, status, balance.LT(m.minBalance.Amount),
+					fmt.Sprintf("balance of anchor account %s is %s%s, lower than %s", status.AnchorAccountAddr, balance, m.minBalance.Denom, m.minBalance))
+			}
+		}
+
+		if m.options.OnStatus != nil {
+			m.options.OnStatus(status)
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// Emit the alert when the state of the alert is changed.
+func (m *AnchorMonitor) setAlert(alertType AnchorAlertType, status AnchorAccountStatus, active bool, message string) {
+	key := string(alertType) + "/" + status.ValidatorAddr
+	if m.alerts[key] == active {
+		return
+	}
+	m.alerts[key] = active
+
+	if !active {
+		message = fmt.Sprintf("%s alert is resolved", alertType)
+		if status.ValidatorAddr != "" {
+			message = fmt.Sprintf("%s alert of validator %s is resolved", alertType, status.ValidatorAddr)
+		}
+	}
+	m.emit(AnchorAlert{
+		Type:     alertType,
+		Resolved: !active,
+		Message:  message,
+		Status:   status,
+	})
+}
+
+func (m *AnchorMonitor) emit(alert AnchorAlert) {
+	if m.options.OnAlert != nil {
+		m.options.OnAlert(alert)
+	}
+}
+
+// Query the latest height of the private chain.
+func queryLatestHeight(xplac provider.XplaClient) (int64, error) {
+	block, _, err := queryPrivChainBlock(xplac, nil)
+	if err != nil {
+		return 0, err
+	}
+	return block.Height, nil
+}
+
+// Query the latest anchored height which is the largest end height of all aggregated blocks.
+func queryLatestAnchoredHeight(ctx context.Context, xplac provider.XplaClient) (int64, error) {
+	endHeights, err := core.QueryAll(ctx, xplac.AllAggregatedBlocks(), func(res *anchortypes.QueryAllAggregatedBlocksResponse) []int64 {
+		var heights []int64
+		for _, block := range res.AggregatedBlocks {
+			heights = append(heights, int64(block.EndHeight))
+		}
+		return heights
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var latest int64
+	for _, height := range endHeights {
+		if height > latest {
+			latest = height
+		}
+	}
+
+	return latest, nil
+}
+
+// Query the anchor account of the validator.
+// It returns the empty address if the validator has no anchor account.
+func queryAnchorAccount(xplac provider.XplaClient, validator string) (string, error) {
+	var res anchortypes.QueryAnchorAccountResponse
+	err := xplac.AnchorAcc(types.AnchorAccMsg{ValidatorAddr: validator}).QueryTyped(&res)
+	if util.IsNotFoundErr(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return res.AnchorAccount, nil
+}
+
+// Query balances of the anchor account of the validator in the public chain.
+func queryAnchorBalances(xplac provider.XplaClient, validator string) (sdk.Coins, error) {
+	var res anchortypes.QueryAnchorBalancesResponse
+	if err := xplac.AnchorBalances(types.AnchorBalancesMsg{ValidatorAddr: validator}).QueryTyped(&res); err != nil {
+		return nil, err
+	}
+
+	return res.Balances, nil
+}
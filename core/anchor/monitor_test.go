@@ -0,0 +1,142 @@
+package anchor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const testValidator = "xprivvaloper1jmf9krhvv9l0ds6ughst5ffd30dvmjf57y9hdd"
+
+type testAlert struct {
+	alertType AnchorAlertType
+	resolved  bool
+}
+
+func TestAnchorMonitor(t *testing.T) {
+	chain := &fakeAnchorChain{}
+
+	var alerts []testAlert
+	monitor, err := NewAnchorMonitor(newFakeAnchorClient(t, chain), AnchorMonitorOptions{
+		Validators:   []string{testValidator},
+		MinBalance:   "100axpla",
+		MaxAnchorGap: 10,
+		OnAlert: func(alert AnchorAlert) {
+			alerts = append(alerts, testAlert{alert.Type, alert.Resolved})
+		},
+	})
+	require.NoError(t, err)
+
+	// Each step changes the chain state, checks once and expects alerts of the check.
+	steps := []struct {
+		name                 string
+		latestHeight         int64
+		aggregatedEndHeights [][]uint64
+		anchorAccount        string
+		balance              int64
+		expectAnchoredHeight int64
+		expectAlerts         []testAlert
+	}{
+		{
+			name:                 "no alert",
+			latestHeight:         20,
+			aggregatedEndHeights: [][]uint64{{5, 15}, {10}},
+			anchorAccount:        "xpla1anchor1",
+			balance:              200,
+			expectAnchoredHeight: 15,
+		},
+		{
+			name:                 "anchor gap and low balance",
+			latestHeight:         30,
+			aggregatedEndHeights: [][]uint64{{5, 15}, {10}},
+			anchorAccount:        "xpla1anchor1",
+			balance:              50,
+			expectAnchoredHeight: 15,
+			expectAlerts: []testAlert{
+				{AnchorAlertAnchorGap, false},
+				{AnchorAlertLowBalance, false},
+			},
+		},
+		{
+			name:                 "alerts are not emitted again",
+			latestHeight:         31,
+			aggregatedEndHeights: [][]uint64{{5, 15}, {10}},
+			anchorAccount:        "xpla1anchor1",
+			balance:              50,
+			expectAnchoredHeight: 15,
+		},
+		{
+			name:                 "alerts are resolved",
+			latestHeight:         32,
+			aggregatedEndHeights: [][]uint64{{5, 15}, {10}, {30}},
+			anchorAccount:        "xpla1anchor1",
+			balance:              100,
+			expectAnchoredHeight: 30,
+			expectAlerts: []testAlert{
+				{AnchorAlertAnchorGap, true},
+				{AnchorAlertLowBalance, true},
+			},
+		},
+		{
+			name:                 "anchor account is changed",
+			latestHeight:         33,
+			aggregatedEndHeights: [][]uint64{{30}},
+			anchorAccount:        "xpla1anchor2",
+			balance:              100,
+			expectAnchoredHeight: 30,
+			expectAlerts: []testAlert{
+				{AnchorAlertAccountChanged, false},
+			},
+		},
+		{
+			name:                 "no anchor account",
+			latestHeight:         34,
+			aggregatedEndHeights: [][]uint64{{30}},
+			expectAnchoredHeight: 30,
+			expectAlerts: []testAlert{
+				{AnchorAlertNoAccount, false},
+			},
+		},
+		{
+			name:                 "anchor account is registered again",
+			latestHeight:         35,
+			aggregatedEndHeights: [][]uint64{{30}},
+			anchorAccount:        "xpla1anchor2",
+			balance:              100,
+			expectAnchoredHeight: 30,
+			expectAlerts: []testAlert{
+				{AnchorAlertNoAccount, true},
+			},
+		},
+	}
+
+	for _, step := range steps {
+		chain.block = newTestBlock(step.latestHeight)
+		chain.aggregatedEndHeights = step.aggregatedEndHeights
+		chain.anchorAccounts = map[string]string{}
+		chain.balances = map[string]sdk.Coins{}
+		if step.anchorAccount != "" {
+			chain.anchorAccounts[testValidator] = step.anchorAccount
+			chain.balances[testValidator] = sdk.NewCoins(sdk.NewInt64Coin("axpla", step.balance))
+		}
+		alerts = nil
+
+		statuses, err := monitor.Check(context.Background())
+		require.NoError(t, err, step.name)
+		require.Len(t, statuses, 1, step.name)
+		require.Equal(t, step.latestHeight, statuses[0].LatestHeight, step.name)
+		require.Equal(t, step.expectAnchoredHeight, statuses[0].LatestAnchoredHeight, step.name)
+		require.Equal(t, step.anchorAccount, statuses[0].AnchorAccountAddr, step.name)
+		require.Equal(t, step.expectAlerts, alerts, step.name)
+	}
+
+	// the check is failed by the error which is not the not found error
+	chain.anchorAccErr = util.LogErr(errors.ErrGrpcRequest, "unavailable")
+	_, err = monitor.Check(context.Background())
+	require.Error(t, err)
+}
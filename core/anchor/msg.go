@@ -1,21 +1,21 @@
 package anchor
 
 import (
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/util"
 
 	anchortypes "github.com/Moonyongjung/xpla-private-chain/x/anchor/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // (Tx) make msg - register anchor account
-func MakeRegisterAnchorAccMsg(registerAnchorAccMsg types.RegisterAnchorAccMsg, privKey key.PrivateKey) (anchortypes.MsgRegisterAnchorAcc, error) {
-	return ParseRegisterAnchorAccArgs(registerAnchorAccMsg, privKey)
+func MakeRegisterAnchorAccMsg(registerAnchorAccMsg types.RegisterAnchorAccMsg, signer types.Signer) (anchortypes.MsgRegisterAnchorAcc, error) {
+	return ParseRegisterAnchorAccArgs(registerAnchorAccMsg, signer)
 }
 
 // (Tx) make msg - change anchor account
-func MakeChangeAnchorAccMsg(changeAnchorAccMsg types.ChangeAnchorAccMsg, privKey key.PrivateKey) (anchortypes.MsgChangeAnchorAcc, error) {
-	return ParseChangeAnchorAccArgs(changeAnchorAccMsg, privKey)
+func MakeChangeAnchorAccMsg(changeAnchorAccMsg types.ChangeAnchorAccMsg, signer types.Signer) (anchortypes.MsgChangeAnchorAcc, error) {
+	return ParseChangeAnchorAccArgs(changeAnchorAccMsg, signer)
 }
 
 // (Query) make msg - query anchor account
@@ -26,8 +26,10 @@ func MakeAnchorAccMsg(anchorAccMsg types.AnchorAccMsg) (anchortypes.QueryAnchorA
 }
 
 // (Query) make msg - all aggregated blocks
-func MakeAllAggregatedBlocksMsg() (anchortypes.QueryAllAggregatedBlocksRequest, error) {
-	return anchortypes.QueryAllAggregatedBlocksRequest{}, nil
+func MakeAllAggregatedBlocksMsg(pageReq *query.PageRequest) (anchortypes.QueryAllAggregatedBlocksRequest, error) {
+	return anchortypes.QueryAllAggregatedBlocksRequest{
+		Pagination: pageReq,
+	}, nil
 }
 
 // (Query) make msg - anchor info
@@ -1,19 +1,17 @@
 package anchor
 
 import (
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
-	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
 
 	anchortypes "github.com/Moonyongjung/xpla-private-chain/x/anchor/types"
 )
 
 // Parsing - register anchor account
-func ParseRegisterAnchorAccArgs(registerAnchorAccMsg types.RegisterAnchorAccMsg, privKey key.PrivateKey) (anchortypes.MsgRegisterAnchorAcc, error) {
-	fromAddress, err := util.GetAddrByPrivKey(privKey)
+func ParseRegisterAnchorAccArgs(registerAnchorAccMsg types.RegisterAnchorAccMsg, signer types.Signer) (anchortypes.MsgRegisterAnchorAcc, error) {
+	fromAddress, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return anchortypes.MsgRegisterAnchorAcc{}, util.LogErr(errors.ErrParse, err)
+		return anchortypes.MsgRegisterAnchorAcc{}, err
 	}
 
 	return anchortypes.NewMsgRegisterAnchorAcc(
@@ -24,10 +22,10 @@ func ParseRegisterAnchorAccArgs(registerAnchorAccMsg types.RegisterAnchorAccMsg,
 }
 
 // Parsing - change anchor account
-func ParseChangeAnchorAccArgs(changeAnchorAccMsg types.ChangeAnchorAccMsg, privKey key.PrivateKey) (anchortypes.MsgChangeAnchorAcc, error) {
-	fromAddress, err := util.GetAddrByPrivKey(privKey)
+func ParseChangeAnchorAccArgs(changeAnchorAccMsg types.ChangeAnchorAccMsg, signer types.Signer) (anchortypes.MsgChangeAnchorAcc, error) {
+	fromAddress, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return anchortypes.MsgChangeAnchorAcc{}, util.LogErr(errors.ErrParse, err)
+		return anchortypes.MsgChangeAnchorAcc{}, err
 	}
 
 	return anchortypes.NewMsgChangeAnchorAcc(
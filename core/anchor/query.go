@@ -9,12 +9,8 @@ import (
 	"github.com/gogo/protobuf/proto"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for Anchor module.
-func QueryAnchor(i core.QueryClient) (string, error) {
+func QueryAnchor(i core.QueryClient) (*core.QueryResponse, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcAnchor(i)
 	} else {
@@ -23,7 +19,10 @@ func QueryAnchor(i core.QueryClient) (string, error) {
 
 }
 
-func queryByGrpcAnchor(i core.QueryClient) (string, error) {
+func queryByGrpcAnchor(i core.QueryClient) (*core.QueryResponse, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := anchortypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -35,7 +34,7 @@ func queryByGrpcAnchor(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		// All aggregated blocks
@@ -46,7 +45,7 @@ func queryByGrpcAnchor(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		// anchor info
@@ -57,7 +56,7 @@ func queryByGrpcAnchor(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		// anchor block
@@ -68,7 +67,7 @@ func queryByGrpcAnchor(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		// anchor tx body
@@ -79,7 +78,7 @@ func queryByGrpcAnchor(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		// anchor verify
@@ -90,7 +89,7 @@ func queryByGrpcAnchor(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		// anchor balances
@@ -101,7 +100,7 @@ func queryByGrpcAnchor(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		// params
@@ -112,19 +111,14 @@ func queryByGrpcAnchor(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", err
-	}
-
-	return string(out), nil
+	return core.ProtoQueryResponse(i, res), nil
 }
 
 const (
@@ -138,7 +132,7 @@ const (
 	anchorParamsLabel              = "params"
 )
 
-func queryByLcdAnchor(i core.QueryClient) (string, error) {
+func queryByLcdAnchor(i core.QueryClient) (*core.QueryResponse, error) {
 	url := "/xpla/anchor/v1beta1/"
 
 	switch {
@@ -148,7 +142,7 @@ func queryByLcdAnchor(i core.QueryClient) (string, error) {
 		url = url + util.MakeQueryLabels(anchorAnchorAccLabel, convertMsg.ValidatorAddress)
 
 		// all aggregated blocks
-	case i.Ixplac.GetMsgType() == AnchorQueryAnchorAccMsgType:
+	case i.Ixplac.GetMsgType() == AnchorAllAggregatedBlocksMsgType:
 		url = url + util.MakeQueryLabels(anchorAllAggregatedBlocksLabel)
 
 		// anchor info
@@ -181,13 +175,13 @@ func queryByLcdAnchor(i core.QueryClient) (string, error) {
 		url = url + util.MakeQueryLabels(anchorParamsLabel)
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+url, i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
+	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+core.LcdPaginationURL(url, i.Ixplac.GetMsg()), i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return string(out), nil
+	return core.RawQueryResponse(i, out), nil
 }
@@ -0,0 +1,178 @@
+package anchor
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/Moonyongjung/xpriv.go/provider"
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	anchortypes "github.com/Moonyongjung/xpla-private-chain/x/anchor/types"
+	cmclient "github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// Report of the anchor proof which links the block of the private chain to the anchor contract of the public chain.
+// Hashes are upper case hex strings.
+type AnchorProofReport struct {
+	PrivChainHeight    int64  `json:"priv_chain_height"`
+	AnchorContractAddr string `json:"anchor_contract_addr"`
+
+	// Block hash and data hash which are recomputed from the header and transactions of the block.
+	BlockHash string   `json:"block_hash"`
+	DataHash  string   `json:"data_hash"`
+	TxHashes  []string `json:"tx_hashes"`
+	// Block hash which is reported by the private chain node.
+	ReportedBlockHash string `json:"reported_block_hash"`
+
+	// Anchored data of responses of anchor queries.
+	AnchorTxHash string `json:"anchor_tx_hash"`
+	AnchoredHash string `json:"anchored_hash"`
+
+	// Responses of anchor queries.
+	AnchorInfo   *anchortypes.QueryAnchorInfoResponse   `json:"anchor_info"`
+	AnchorBlock  *anchortypes.QueryAnchorBlockResponse  `json:"anchor_block"`
+	AnchorTxBody *anchortypes.QueryAnchorTxBodyResponse `json:"anchor_tx_body"`
+	AnchorVerify *anchortypes.QueryVerifyResponse       `json:"anchor_verify"`
+
+	// Results of checks.
+	BlockHashMatched    bool `json:"block_hash_matched"`
+	DataHashMatched     bool `json:"data_hash_matched"`
+	AnchoredHashMatched bool `json:"anchored_hash_matched"`
+	// Result of the verify query of the anchor module.
+	ChainVerified bool `json:"chain_verified"`
+	Verified      bool `json:"verified"`
+}
+
+// Verify the anchor proof of the private chain height.
+// It fetches the anchor info, the anchor block in the anchor contract, the anchor tx body and the verify result,
+// and checks the block hash which is recomputed from the block of the private chain against the anchored hash.
+// The returned error is only for failures of fetching, and the result of checks is recorded in the report.
+func VerifyAnchorProof(xplac provider.XplaClient, anchorVerifyMsg types.AnchorVerifyMsg) (*AnchorProofReport, error) {
+	height, err := util.FromStringToInt64(anchorVerifyMsg.PrivChainHeight)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrInvalidRequest, err)
+	}
+
+	report := &AnchorProofReport{
+		PrivChainHeight:    height,
+		AnchorContractAddr: anchorVerifyMsg.AnchorContractAddr,
+	}
+
+	block, reportedBlockHash, err := queryPrivChainBlock(xplac, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHash := block.Hash()
+	report.BlockHash = blockHash.String()
+	report.ReportedBlockHash = reportedBlockHash.String()
+	report.BlockHashMatched = bytes.Equal(blockHash, reportedBlockHash)
+
+	dataHash := block.Data.Txs.Hash()
+	report.DataHash = strings.ToUpper(hex.EncodeToString(dataHash))
+	report.DataHashMatched = bytes.Equal(dataHash, block.Header.DataHash)
+	for _, tx := range block.Data.Txs {
+		report.TxHashes = append(report.TxHashes, strings.ToUpper(hex.EncodeToString(tx.Hash())))
+	}
+
+	var anchorInfo anchortypes.QueryAnchorInfoResponse
+	if err := xplac.AnchorInfo(types.AnchorInfoMsg{PrivChainHeight: anchorVerifyMsg.PrivChainHeight}).QueryTyped(&anchorInfo); err != nil {
+		return nil, err
+	}
+	report.AnchorInfo = &anchorInfo
+	report.AnchorTxHash = anchorInfo.TxHash
+
+	var anchorBlock anchortypes.QueryAnchorBlockResponse
+	if err := xplac.AnchorBlock(types.AnchorBlockMsg{
+		PrivChainHeight:    anchorVerifyMsg.PrivChainHeight,
+		AnchorContractAddr: anchorVerifyMsg.AnchorContractAddr,
+	}).QueryTyped(&anchorBlock); err != nil {
+		return nil, err
+	}
+	report.AnchorBlock = &anchorBlock
+	report.AnchoredHash = anchorBlock.BlockHash
+	if anchoredHash, ok := decodeHash(report.AnchoredHash); ok {
+		report.AnchoredHashMatched = bytes.Equal(blockHash, anchoredHash)
+	}
+
+	var anchorTxBody anchortypes.QueryAnchorTxBodyResponse
+	if err := xplac.AnchorTxBody(types.AnchorTxBodyMsg{PrivChainHeight: anchorVerifyMsg.PrivChainHeight}).QueryTyped(&anchorTxBody); err != nil {
+		return nil, err
+	}
+	report.AnchorTxBody = &anchorTxBody
+
+	var anchorVerify anchortypes.QueryVerifyResponse
+	if err := xplac.AnchorVerify(anchorVerifyMsg).QueryTyped(&anchorVerify); err != nil {
+		return nil, err
+	}
+	report.AnchorVerify = &anchorVerify
+	report.ChainVerified = anchorVerify.Result
+
+	report.Verified = report.BlockHashMatched && report.DataHashMatched && report.AnchoredHashMatched
+
+	return report, nil
+}
+
+// Query the block of the private chain, and the block hash which is reported by the node.
+// The latest block is queried if the height is nil.
+// The tendermint RPC is used if it is set, otherwise gRPC or LCD is used.
+func queryPrivChainBlock(xplac provider.XplaClient, height *int64) (*tmtypes.Block, []byte, error) {
+	if xplac.GetRpc() != "" {
+		client, err := cmclient.NewClientFromNode(xplac.GetRpc())
+		if err != nil {
+			return nil, nil, util.LogErr(errors.ErrRpcRequest, err)
+		}
+		res, err := client.Block(xplac.GetContext(), height)
+		if err != nil {
+			return nil, nil, util.LogErr(errors.ErrRpcRequest, err)
+		}
+		return res.Block, res.BlockID.Hash, nil
+	}
+
+	var protoBlock *tmproto.Block
+	var blockID *tmproto.BlockID
+	if height == nil {
+		var res tmservice.GetLatestBlockResponse
+		if err := xplac.Block().QueryTyped(&res); err != nil {
+			return nil, nil, err
+		}
+		protoBlock, blockID = res.Block, res.BlockId
+	} else {
+		var res tmservice.GetBlockByHeightResponse
+		if err := xplac.Block(types.BlockMsg{Height: util.FromInt64ToString(*height)}).QueryTyped(&res); err != nil {
+			return nil, nil, err
+		}
+		protoBlock, blockID = res.Block, res.BlockId
+	}
+	if protoBlock == nil || blockID == nil {
+		return nil, nil, util.LogErr(errors.ErrNotFound, "no block of the private chain")
+	}
+
+	block, err := tmtypes.BlockFromProto(protoBlock)
+	if err != nil {
+		return nil, nil, util.LogErr(errors.ErrParse, err)
+	}
+
+	return block, blockID.Hash, nil
+}
+
+// Decode the hash which is hex or base64 encoded.
+func decodeHash(hash string) ([]byte, bool) {
+	if hash == "" {
+		return nil, false
+	}
+	if decoded, err := hex.DecodeString(strings.TrimPrefix(hash, "0x")); err == nil {
+		return decoded, true
+	}
+	if decoded, err := base64.StdEncoding.DecodeString(hash); err == nil {
+		return decoded, true
+	}
+	return nil, false
+}
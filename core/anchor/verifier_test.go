@@ -0,0 +1,224 @@
+package anchor
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"strconv"
+	"testing"
+
+	"github.com/Moonyongjung/xpriv.go/provider"
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+	"github.com/Moonyongjung/xpriv.go/util/testutil"
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	anchortypes "github.com/Moonyongjung/xpla-private-chain/x/anchor/types"
+)
+
+const testAnchorTxHash = "B5A3BC6FAAE87A9D0B1F9A0E3FB0C38E4DC1CC5D3B2B0B7F3D0C0D1E2F3A4B5C"
+
+// Chain state of the fake xpla client for anchor queries.
+type fakeAnchorChain struct {
+	block *tmtypes.Block
+	// The block hash which is reported by the node. Default is the hash of the block.
+	reportedBlockHash []byte
+	anchoredHash      string
+	verified          bool
+	anchorErr         error
+
+	// End heights of aggregated blocks for each page.
+	aggregatedEndHeights [][]uint64
+	// Anchor accounts and balances of validators. The anchor account query of the validator which
+	// is not in anchor accounts returns the not found error.
+	anchorAccounts map[string]string
+	balances       map[string]sdk.Coins
+	anchorAccErr   error
+}
+
+// Make the fake xpla client which handles anchor queries by the chain state.
+func newFakeAnchorClient(t *testing.T, chain *fakeAnchorChain) provider.XplaClient {
+	return testutil.NewFakeXplaClient(t, testutil.FakeXplaClientHandlers{
+		Msg: func(method string, msg interface{}) interface{} {
+			if method == "AllAggregatedBlocks" {
+				return anchortypes.QueryAllAggregatedBlocksRequest{}
+			}
+			return msg
+		},
+		QueryTyped: func(c *testutil.FakeXplaClient, res interface{}) error {
+			switch c.Method {
+			case "Block":
+				protoBlock, err := chain.block.ToProto()
+				if err != nil {
+					return err
+				}
+				blockID := &tmproto.BlockID{Hash: chain.block.Hash()}
+				if chain.reportedBlockHash != nil {
+					blockID.Hash = chain.reportedBlockHash
+				}
+				// The latest block is queried without the block message.
+				if c.GetMsg() != nil {
+					res.(*tmservice.GetBlockByHeightResponse).Block = protoBlock
+					res.(*tmservice.GetBlockByHeightResponse).BlockId = blockID
+				} else {
+					res.(*tmservice.GetLatestBlockResponse).Block = protoBlock
+					res.(*tmservice.GetLatestBlockResponse).BlockId = blockID
+				}
+			case "AnchorInfo":
+				if chain.anchorErr != nil {
+					return chain.anchorErr
+				}
+				res.(*anchortypes.QueryAnchorInfoResponse).TxHash = testAnchorTxHash
+			case "AnchorBlock":
+				res.(*anchortypes.QueryAnchorBlockResponse).BlockHash = chain.anchoredHash
+			case "AnchorTxBody":
+			case "AnchorVerify":
+				res.(*anchortypes.QueryVerifyResponse).Result = chain.verified
+			case "AllAggregatedBlocks":
+				// The page key is the index of the page.
+				var page int
+				if pageReq := c.GetMsg().(anchortypes.QueryAllAggregatedBlocksRequest).Pagination; pageReq != nil && len(pageReq.Key) > 0 {
+					page, _ = strconv.Atoi(string(pageReq.Key))
+				}
+				allRes := res.(*anchortypes.QueryAllAggregatedBlocksResponse)
+				for _, endHeight := range chain.aggregatedEndHeights[page] {
+					allRes.AggregatedBlocks = append(allRes.AggregatedBlocks, &anchortypes.AggregatedBlock{EndHeight: endHeight})
+				}
+				allRes.Pagination = &query.PageResponse{}
+				if page+1 < len(chain.aggregatedEndHeights) {
+					allRes.Pagination.NextKey = []byte(strconv.Itoa(page + 1))
+				}
+			case "AnchorAcc":
+				if chain.anchorAccErr != nil {
+					return chain.anchorAccErr
+				}
+				anchorAccount, ok := chain.anchorAccounts[c.GetMsg().(types.AnchorAccMsg).ValidatorAddr]
+				if !ok {
+					return util.LogErr(errors.ErrGrpcRequest, status.Error(codes.NotFound, "anchor account not found"))
+				}
+				res.(*anchortypes.QueryAnchorAccountResponse).AnchorAccount = anchorAccount
+			case "AnchorBalances":
+				res.(*anchortypes.QueryAnchorBalancesResponse).Balances = chain.balances[c.GetMsg().(types.AnchorBalancesMsg).ValidatorAddr]
+			default:
+				return c.Unexpected()
+			}
+			return nil
+		},
+	})
+}
+
+func newTestBlock(height int64) *tmtypes.Block {
+	block := tmtypes.MakeBlock(height, []tmtypes.Tx{tmtypes.Tx("tx1"), tmtypes.Tx("tx2")}, &tmtypes.Commit{}, nil)
+	block.ProposerAddress = make([]byte, crypto.AddressSize)
+	// The block hash is nil without the validators hash.
+	block.ValidatorsHash = tmhash.Sum([]byte("validators"))
+	return block
+}
+
+func TestVerifyAnchorProof(t *testing.T) {
+	block := newTestBlock(20)
+	blockHash := block.Hash()
+	otherBlockHash := newTestBlock(21).Hash()
+
+	testCases := []struct {
+		name   string
+		chain  fakeAnchorChain
+		height string
+		check  func(t *testing.T, report *AnchorProofReport)
+		// The error is returned only for failures of fetching.
+		expectErr bool
+	}{
+		{
+			name:  "hex anchored hash is matched",
+			chain: fakeAnchorChain{anchoredHash: hex.EncodeToString(blockHash), verified: true},
+			check: func(t *testing.T, report *AnchorProofReport) {
+				require.True(t, report.Verified)
+				require.True(t, report.ChainVerified)
+				require.True(t, report.BlockHashMatched)
+				require.True(t, report.DataHashMatched)
+				require.True(t, report.AnchoredHashMatched)
+				require.Equal(t, blockHash.String(), report.BlockHash)
+				require.Equal(t, testAnchorTxHash, report.AnchorTxHash)
+				require.Len(t, report.TxHashes, 2)
+			},
+		},
+		{
+			name:  "base64 anchored hash is matched",
+			chain: fakeAnchorChain{anchoredHash: base64.StdEncoding.EncodeToString(blockHash), verified: true},
+			check: func(t *testing.T, report *AnchorProofReport) {
+				require.True(t, report.Verified)
+				require.True(t, report.AnchoredHashMatched)
+			},
+		},
+		{
+			name:  "anchored hash of the other block",
+			chain: fakeAnchorChain{anchoredHash: hex.EncodeToString(otherBlockHash)},
+			check: func(t *testing.T, report *AnchorProofReport) {
+				require.False(t, report.Verified)
+				require.False(t, report.ChainVerified)
+				require.True(t, report.BlockHashMatched)
+				require.False(t, report.AnchoredHashMatched)
+			},
+		},
+		{
+			name:  "no anchored hash",
+			chain: fakeAnchorChain{},
+			check: func(t *testing.T, report *AnchorProofReport) {
+				require.False(t, report.Verified)
+				require.False(t, report.AnchoredHashMatched)
+			},
+		},
+		{
+			name:  "reported block hash is different",
+			chain: fakeAnchorChain{anchoredHash: hex.EncodeToString(blockHash), reportedBlockHash: otherBlockHash},
+			check: func(t *testing.T, report *AnchorProofReport) {
+				require.False(t, report.Verified)
+				require.False(t, report.BlockHashMatched)
+				require.True(t, report.AnchoredHashMatched)
+			},
+		},
+		{
+			name:      "invalid height",
+			chain:     fakeAnchorChain{},
+			height:    "height",
+			expectErr: true,
+		},
+		{
+			name:      "anchor query is failed",
+			chain:     fakeAnchorChain{anchorErr: util.LogErr(errors.ErrGrpcRequest, "anchor info")},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chain := tc.chain
+			chain.block = block
+			height := tc.height
+			if height == "" {
+				height = "20"
+			}
+
+			report, err := VerifyAnchorProof(newFakeAnchorClient(t, &chain), types.AnchorVerifyMsg{
+				PrivChainHeight:    height,
+				AnchorContractAddr: "xpla1fyr2mptjswz4w6xmgnpgm93x0q4s4wdl6srv3rtz3utc4f6fmxeqajvryg",
+			})
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, int64(20), report.PrivChainHeight)
+			tc.check(t, report)
+		})
+	}
+}
@@ -22,7 +22,7 @@ func (e AuthExternal) AuthParams() provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(AuthModule).
+	e.Xplac = e.Xplac.WithModule(AuthModule).
 		WithMsgType(AuthQueryParamsMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -34,7 +34,7 @@ func (e AuthExternal) AccAddress(queryAccAddresMsg types.QueryAccAddressMsg) pro
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(AuthModule).
+	e.Xplac = e.Xplac.WithModule(AuthModule).
 		WithMsgType(AuthQueryAccAddressMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -42,11 +42,11 @@ func (e AuthExternal) AccAddress(queryAccAddresMsg types.QueryAccAddressMsg) pro
 
 // Query all accounts.
 func (e AuthExternal) Accounts() provider.XplaClient {
-	msg, err := MakeQueryAccountsMsg()
+	msg, err := MakeQueryAccountsMsg(e.Xplac.GetPagination())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(AuthModule).
+	e.Xplac = e.Xplac.WithModule(AuthModule).
 		WithMsgType(AuthQueryAccountsMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -58,7 +58,7 @@ func (e AuthExternal) TxsByEvents(txsByEventsMsg types.QueryTxsByEventsMsg) prov
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(AuthModule).
+	e.Xplac = e.Xplac.WithModule(AuthModule).
 		WithMsgType(AuthQueryTxsByEventsMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -70,7 +70,7 @@ func (e AuthExternal) Tx(queryTxMsg types.QueryTxMsg) provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(AuthModule).
+	e.Xplac = e.Xplac.WithModule(AuthModule).
 		WithMsgType(AuthQueryTxMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -7,7 +7,7 @@ import (
 
 func (s *IntegrationTestSuite) TestAuth() {
 	// auth params
-	s.xplac.AuthParams()
+	s.xplac = s.xplac.AuthParams()
 
 	authParamMsg, err := mauth.MakeAuthParamMsg()
 	s.Require().NoError(err)
@@ -20,7 +20,7 @@ func (s *IntegrationTestSuite) TestAuth() {
 	queryAccAddressMsg := types.QueryAccAddressMsg{
 		Address: s.accounts[0].Address.String(),
 	}
-	s.xplac.AccAddress(queryAccAddressMsg)
+	s.xplac = s.xplac.AccAddress(queryAccAddressMsg)
 
 	accAddressMsg, err := mauth.MakeQueryAccAddressMsg(queryAccAddressMsg)
 	s.Require().NoError(err)
@@ -30,9 +30,9 @@ func (s *IntegrationTestSuite) TestAuth() {
 	s.Require().Equal(mauth.AuthQueryAccAddressMsgType, s.xplac.GetMsgType())
 
 	// accounts
-	s.xplac.Accounts()
+	s.xplac = s.xplac.Accounts()
 
-	accountsMsg, err := mauth.MakeQueryAccountsMsg()
+	accountsMsg, err := mauth.MakeQueryAccountsMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(accountsMsg, s.xplac.GetMsg())
@@ -43,7 +43,7 @@ func (s *IntegrationTestSuite) TestAuth() {
 	queryTxsByEventsMsg := types.QueryTxsByEventsMsg{
 		Events: "transfer.recipient=" + s.accounts[0].Address.String(),
 	}
-	s.xplac.TxsByEvents(queryTxsByEventsMsg)
+	s.xplac = s.xplac.TxsByEvents(queryTxsByEventsMsg)
 	txsByEventMsg, err := mauth.MakeTxsByEventsMsg(queryTxsByEventsMsg)
 	s.Require().NoError(err)
 
@@ -55,7 +55,7 @@ func (s *IntegrationTestSuite) TestAuth() {
 	queryTxMsg := types.QueryTxMsg{
 		Value: s.testTxHash,
 	}
-	s.xplac.Tx(queryTxMsg)
+	s.xplac = s.xplac.Tx(queryTxMsg)
 
 	txMsg, err := mauth.MakeQueryTxMsg(queryTxMsg)
 	s.Require().NoError(err)
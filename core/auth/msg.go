@@ -1,11 +1,11 @@
 package auth
 
 import (
-	"github.com/Moonyongjung/xpriv.go/core"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -26,9 +26,9 @@ func MakeQueryAccAddressMsg(queryAccAddressMsg types.QueryAccAddressMsg) (authty
 }
 
 // (Query) make msg - auth accounts
-func MakeQueryAccountsMsg() (authtypes.QueryAccountsRequest, error) {
+func MakeQueryAccountsMsg(pageReq *query.PageRequest) (authtypes.QueryAccountsRequest, error) {
 	return authtypes.QueryAccountsRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageReq,
 	}, nil
 }
 
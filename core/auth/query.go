@@ -13,12 +13,8 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for auth module.
-func QueryAuth(i core.QueryClient) (string, error) {
+func QueryAuth(i core.QueryClient) (*core.QueryResponse, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcAuth(i)
 	} else {
@@ -26,7 +22,10 @@ func QueryAuth(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcAuth(i core.QueryClient) (string, error) {
+func queryByGrpcAuth(i core.QueryClient) (*core.QueryResponse, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := authtypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -38,7 +37,7 @@ func queryByGrpcAuth(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Auth account
@@ -49,7 +48,7 @@ func queryByGrpcAuth(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Auth accounts
@@ -60,59 +59,54 @@ func queryByGrpcAuth(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Auth tx by event
 	case i.Ixplac.GetMsgType() == AuthQueryTxsByEventsMsgType:
 		if i.Ixplac.GetRpc() == "" {
-			return "", util.LogErr(errors.ErrNotSatisfiedOptions, "query txs by events, need RPC URL when txs methods")
+			return nil, util.LogErr(errors.ErrNotSatisfiedOptions, "query txs by events, need RPC URL when txs methods")
 		}
 		convertMsg := i.Ixplac.GetMsg().(QueryTxsByEventParseMsg)
 		clientCtx, err := core.ClientForQuery(i)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		res, err = authtx.QueryTxsByEvents(clientCtx, convertMsg.TmEvents, convertMsg.Page, convertMsg.Limit, "")
 		if err != nil {
-			return "", util.LogErr(errors.ErrRpcRequest, err)
+			return nil, util.LogErr(errors.ErrRpcRequest, err)
 		}
 
 	// Auth tx
 	case i.Ixplac.GetMsgType() == AuthQueryTxMsgType:
 		if i.Ixplac.GetRpc() == "" {
-			return "", util.LogErr(errors.ErrNotSatisfiedOptions, "auth query tx msg, need RPC URL when txs methods")
+			return nil, util.LogErr(errors.ErrNotSatisfiedOptions, "auth query tx msg, need RPC URL when txs methods")
 		}
 		convertMsg := i.Ixplac.GetMsg().(QueryTxParseMsg)
 
 		clientCtx, err := core.ClientForQuery(i)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		if convertMsg.TxType == "hash" {
 			res, err = authtx.QueryTx(clientCtx, convertMsg.TmEvents[0])
 			if err != nil {
-				return "", util.LogErr(errors.ErrRpcRequest, err)
+				return nil, util.LogErr(errors.ErrRpcRequest, err)
 			}
 		} else {
 			res, err = authtx.QueryTxsByEvents(clientCtx, convertMsg.TmEvents, rest.DefaultPage, rest.DefaultLimit, "")
 			if err != nil {
-				return "", util.LogErr(errors.ErrRpcRequest, err)
+				return nil, util.LogErr(errors.ErrRpcRequest, err)
 			}
 		}
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", err
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	return string(out), nil
+	return core.ProtoQueryResponse(i, res), nil
 }
 
 const (
@@ -121,7 +115,7 @@ const (
 	authTxsLabel      = "txs"
 )
 
-func queryByLcdAuth(i core.QueryClient) (string, error) {
+func queryByLcdAuth(i core.QueryClient) (*core.QueryResponse, error) {
 
 	url := util.MakeQueryLcdUrl(authv1beta1.Query_ServiceDesc.Metadata.(string))
 
@@ -144,7 +138,7 @@ func queryByLcdAuth(i core.QueryClient) (string, error) {
 		convertMsg := i.Ixplac.GetMsg().(QueryTxsByEventParseMsg)
 
 		if len(convertMsg.TmEvents) > 1 {
-			return "", util.LogErr(errors.ErrNotSupport, "support only one event on the LCD")
+			return nil, util.LogErr(errors.ErrNotSupport, "support only one event on the LCD")
 		}
 
 		parsedEvent := convertMsg.TmEvents[0]
@@ -163,7 +157,7 @@ func queryByLcdAuth(i core.QueryClient) (string, error) {
 		convertMsg := i.Ixplac.GetMsg().(QueryTxParseMsg)
 
 		if len(convertMsg.TmEvents) > 1 {
-			return "", util.LogErr(errors.ErrNotSupport, "support only one event on the LCD")
+			return nil, util.LogErr(errors.ErrNotSupport, "support only one event on the LCD")
 		}
 
 		parsedValue := convertMsg.TmEvents
@@ -175,7 +169,7 @@ func queryByLcdAuth(i core.QueryClient) (string, error) {
 
 		} else if parsedTxType == "signature" {
 			// inactivate
-			return "", util.LogErr(errors.ErrNotSupport, "inactivate GetTxEvent('signature') when using LCD because of sometimes generating parsing error that based64 encoded signature has '='")
+			return nil, util.LogErr(errors.ErrNotSupport, "inactivate GetTxEvent('signature') when using LCD because of sometimes generating parsing error that based64 encoded signature has '='")
 			// events := "?events=" + parsedValue
 			// page := "&pagination.page=" + util.FromIntToString(rest.DefaultPage)
 			// limit := "&pagination.limit=" + util.FromIntToString(rest.DefaultLimit)
@@ -190,13 +184,13 @@ func queryByLcdAuth(i core.QueryClient) (string, error) {
 		}
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+url, i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
+	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+core.LcdPaginationURL(url, i.Ixplac.GetMsg()), i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return string(out), nil
+	return core.RawQueryResponse(i, out), nil
 }
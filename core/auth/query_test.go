@@ -147,9 +147,9 @@ func (s *IntegrationTestSuite) TearDownSuite() {
 func (s *IntegrationTestSuite) TestQueryParams() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.AuthParams().Query()
@@ -172,9 +172,9 @@ func (s *IntegrationTestSuite) TestAccAddress() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		queryAccAddressMsg := types.QueryAccAddressMsg{
@@ -201,9 +201,9 @@ func (s *IntegrationTestSuite) TestAccAddress() {
 func (s *IntegrationTestSuite) TestAccounts() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.Accounts().Query()
@@ -234,12 +234,12 @@ func (s *IntegrationTestSuite) TestQueryTxByEventAndQueryTx() {
 
 	txHash := txRes.TxHash
 
-	s.xplac.WithRpc(s.network.Validators[0].RPCAddress)
+	s.xplac = s.xplac.WithRpc(s.network.Validators[0].RPCAddress)
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		queryTxsByEventsMsg := types.QueryTxsByEventsMsg{
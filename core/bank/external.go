@@ -20,11 +20,11 @@ func NewBankExternal(xplac provider.XplaClient) (e BankExternal) {
 
 // Send funds from one account to another.
 func (e BankExternal) BankSend(bankSendMsg types.BankSendMsg) provider.XplaClient {
-	msg, err := MakeBankSendMsg(bankSendMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeBankSendMsg(bankSendMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(BankModule).
+	e.Xplac = e.Xplac.WithModule(BankModule).
 		WithMsgType(BankSendMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -35,11 +35,11 @@ func (e BankExternal) BankSend(bankSendMsg types.BankSendMsg) provider.XplaClien
 // Query for account balances by address
 func (e BankExternal) BankBalances(bankBalancesMsg types.BankBalancesMsg) provider.XplaClient {
 	if bankBalancesMsg.Denom == "" {
-		msg, err := MakeBankAllBalancesMsg(bankBalancesMsg)
+		msg, err := MakeBankAllBalancesMsg(bankBalancesMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(BankModule).
+		e.Xplac = e.Xplac.WithModule(BankModule).
 			WithMsgType(BankAllBalancesMsgType).
 			WithMsg(msg)
 	} else {
@@ -47,7 +47,7 @@ func (e BankExternal) BankBalances(bankBalancesMsg types.BankBalancesMsg) provid
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(BankModule).
+		e.Xplac = e.Xplac.WithModule(BankModule).
 			WithMsgType(BankBalanceMsgType).
 			WithMsg(msg)
 	}
@@ -62,7 +62,7 @@ func (e BankExternal) DenomMetadata(denomMetadataMsg ...types.DenomMetadataMsg)
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(BankModule).
+		e.Xplac = e.Xplac.WithModule(BankModule).
 			WithMsgType(BankDenomsMetadataMsgType).
 			WithMsg(msg)
 	} else if len(denomMetadataMsg) == 1 {
@@ -70,11 +70,11 @@ func (e BankExternal) DenomMetadata(denomMetadataMsg ...types.DenomMetadataMsg)
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(BankModule).
+		e.Xplac = e.Xplac.WithModule(BankModule).
 			WithMsgType(BankDenomMetadataMsgType).
 			WithMsg(msg)
 	} else {
-		provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
+		e.Xplac = provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
 	}
 	return e.Xplac
 }
@@ -82,11 +82,11 @@ func (e BankExternal) DenomMetadata(denomMetadataMsg ...types.DenomMetadataMsg)
 // Query the total supply of coins of the chain.
 func (e BankExternal) Total(totalMsg ...types.TotalMsg) provider.XplaClient {
 	if len(totalMsg) == 0 {
-		msg, err := MakeTotalSupplyMsg()
+		msg, err := MakeTotalSupplyMsg(e.Xplac.GetPagination())
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(BankModule).
+		e.Xplac = e.Xplac.WithModule(BankModule).
 			WithMsgType(BankTotalMsgType).
 			WithMsg(msg)
 	} else if len(totalMsg) == 1 {
@@ -94,11 +94,11 @@ func (e BankExternal) Total(totalMsg ...types.TotalMsg) provider.XplaClient {
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(BankModule).
+		e.Xplac = e.Xplac.WithModule(BankModule).
 			WithMsgType(BankTotalSupplyOfMsgType).
 			WithMsg(msg)
 	} else {
-		provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
+		e.Xplac = provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
 	}
 	return e.Xplac
 }
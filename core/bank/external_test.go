@@ -7,16 +7,16 @@ import (
 )
 
 func (s *IntegrationTestSuite) TestBankTx() {
-	s.xplac.WithPrivateKey(s.accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(s.accounts[0].PrivKey)
 	// bank send
 	bankSendMsg := types.BankSendMsg{
 		FromAddress: s.accounts[0].Address.String(),
 		ToAddress:   s.accounts[1].Address.String(),
 		Amount:      "1000",
 	}
-	s.xplac.BankSend(bankSendMsg)
+	s.xplac = s.xplac.BankSend(bankSendMsg)
 
-	makeBankSendMsg, err := mbank.MakeBankSendMsg(bankSendMsg, s.xplac.GetPrivateKey())
+	makeBankSendMsg, err := mbank.MakeBankSendMsg(bankSendMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeBankSendMsg, s.xplac.GetMsg())
@@ -36,9 +36,9 @@ func (s *IntegrationTestSuite) TestBank() {
 	bankBalancesMsg := types.BankBalancesMsg{
 		Address: s.accounts[0].Address.String(),
 	}
-	s.xplac.BankBalances(bankBalancesMsg)
+	s.xplac = s.xplac.BankBalances(bankBalancesMsg)
 
-	makeBankAllBalancesMsg, err := mbank.MakeBankAllBalancesMsg(bankBalancesMsg)
+	makeBankAllBalancesMsg, err := mbank.MakeBankAllBalancesMsg(bankBalancesMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeBankAllBalancesMsg, s.xplac.GetMsg())
@@ -50,7 +50,7 @@ func (s *IntegrationTestSuite) TestBank() {
 		Address: s.accounts[0].Address.String(),
 		Denom:   types.XplaDenom,
 	}
-	s.xplac.BankBalances(bankBalancesMsg)
+	s.xplac = s.xplac.BankBalances(bankBalancesMsg)
 
 	makeBankBalanceMsg, err := mbank.MakeBankBalanceMsg(bankBalancesMsg)
 	s.Require().NoError(err)
@@ -60,7 +60,7 @@ func (s *IntegrationTestSuite) TestBank() {
 	s.Require().Equal(mbank.BankBalanceMsgType, s.xplac.GetMsgType())
 
 	// denoms metadata
-	s.xplac.DenomMetadata()
+	s.xplac = s.xplac.DenomMetadata()
 
 	makeDenomsMetaDataMsg, err := mbank.MakeDenomsMetaDataMsg()
 	s.Require().NoError(err)
@@ -73,7 +73,7 @@ func (s *IntegrationTestSuite) TestBank() {
 	denomMetadataMsg := types.DenomMetadataMsg{
 		Denom: types.XplaDenom,
 	}
-	s.xplac.DenomMetadata(denomMetadataMsg)
+	s.xplac = s.xplac.DenomMetadata(denomMetadataMsg)
 
 	makeDenomMetaDataMsg, err := mbank.MakeDenomMetaDataMsg(denomMetadataMsg)
 	s.Require().NoError(err)
@@ -83,9 +83,9 @@ func (s *IntegrationTestSuite) TestBank() {
 	s.Require().Equal(mbank.BankDenomMetadataMsgType, s.xplac.GetMsgType())
 
 	// total supply
-	s.xplac.Total()
+	s.xplac = s.xplac.Total()
 
-	makeTotalSupplyMsg, err := mbank.MakeTotalSupplyMsg()
+	makeTotalSupplyMsg, err := mbank.MakeTotalSupplyMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeTotalSupplyMsg, s.xplac.GetMsg())
@@ -96,7 +96,7 @@ func (s *IntegrationTestSuite) TestBank() {
 	totalMsg := types.TotalMsg{
 		Denom: types.XplaDenom,
 	}
-	s.xplac.Total(totalMsg)
+	s.xplac = s.xplac.Total(totalMsg)
 
 	makeSupplyOfMsg, err := mbank.MakeSupplyOfMsg(totalMsg)
 	s.Require().NoError(err)
@@ -13,7 +13,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 	src := rand.NewSource(1)
 	r := rand.New(src)
 	accounts := testutil.RandomAccounts(r, 2)
-	s.xplac.WithPrivateKey(accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(accounts[0].PrivKey)
 
 	c := bank.NewCoreModule()
 
@@ -31,7 +31,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 		Amount:      "1000",
 	}
 
-	makeBankSendMsg, err := bank.MakeBankSendMsg(bankSendMsg, s.xplac.GetPrivateKey())
+	makeBankSendMsg, err := bank.MakeBankSendMsg(bankSendMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	testMsg = makeBankSendMsg
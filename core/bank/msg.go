@@ -1,27 +1,26 @@
 package bank
 
 import (
-	"github.com/Moonyongjung/xpriv.go/core"
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // (Tx) make msg - bank send
-func MakeBankSendMsg(bankSendMsg types.BankSendMsg, privKey key.PrivateKey) (banktypes.MsgSend, error) {
-	return parseBankSendArgs(bankSendMsg, privKey)
+func MakeBankSendMsg(bankSendMsg types.BankSendMsg, signer types.Signer) (banktypes.MsgSend, error) {
+	return parseBankSendArgs(bankSendMsg, signer)
 }
 
 // (Query) make msg - all balances
-func MakeBankAllBalancesMsg(bankBalancesMsg types.BankBalancesMsg) (banktypes.QueryAllBalancesRequest, error) {
+func MakeBankAllBalancesMsg(bankBalancesMsg types.BankBalancesMsg, pageReq *query.PageRequest) (banktypes.QueryAllBalancesRequest, error) {
 	if (types.BankBalancesMsg{}) == bankBalancesMsg {
 		return banktypes.QueryAllBalancesRequest{}, util.LogErr(errors.ErrInsufficientParams, "Empty request or type of parameter is not correct")
 	}
 
-	return parseBankAllBalancesArgs(bankBalancesMsg)
+	return parseBankAllBalancesArgs(bankBalancesMsg, pageReq)
 }
 
 // (Query) make msg - balance
@@ -46,8 +45,8 @@ func MakeDenomMetaDataMsg(denomMetadataMsg types.DenomMetadataMsg) (banktypes.Qu
 }
 
 // (Query) make msg - total supply
-func MakeTotalSupplyMsg() (banktypes.QueryTotalSupplyRequest, error) {
-	return banktypes.QueryTotalSupplyRequest{Pagination: core.PageRequest}, nil
+func MakeTotalSupplyMsg(pageReq *query.PageRequest) (banktypes.QueryTotalSupplyRequest, error) {
+	return banktypes.QueryTotalSupplyRequest{Pagination: pageReq}, nil
 }
 
 // (Query) make msg - supply of
@@ -1,18 +1,17 @@
 package bank
 
 import (
-	"github.com/Moonyongjung/xpriv.go/core"
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
 // Parsing - bank send
-func parseBankSendArgs(bankSendMsg types.BankSendMsg, privKey key.PrivateKey) (banktypes.MsgSend, error) {
+func parseBankSendArgs(bankSendMsg types.BankSendMsg, signer types.Signer) (banktypes.MsgSend, error) {
 	denom := types.XplaDenom
 
 	if bankSendMsg.FromAddress == "" || bankSendMsg.ToAddress == "" || bankSendMsg.Amount == "" {
@@ -35,13 +34,13 @@ func parseBankSendArgs(bankSendMsg types.BankSendMsg, privKey key.PrivateKey) (b
 }
 
 // Parsing - all balances
-func parseBankAllBalancesArgs(bankBalancesMsg types.BankBalancesMsg) (banktypes.QueryAllBalancesRequest, error) {
+func parseBankAllBalancesArgs(bankBalancesMsg types.BankBalancesMsg, pageReq *query.PageRequest) (banktypes.QueryAllBalancesRequest, error) {
 	addr, err := sdk.AccAddressFromBech32(bankBalancesMsg.Address)
 	if err != nil {
 		return banktypes.QueryAllBalancesRequest{}, util.LogErr(errors.ErrInvalidRequest, err)
 	}
 
-	params := *banktypes.NewQueryAllBalancesRequest(addr, core.PageRequest)
+	params := *banktypes.NewQueryAllBalancesRequest(addr, pageReq)
 	return params, nil
 }
 
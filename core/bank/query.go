@@ -11,12 +11,8 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for bank module.
-func QueryBank(i core.QueryClient) (string, error) {
+func QueryBank(i core.QueryClient) (*core.QueryResponse, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcBank(i)
 	} else {
@@ -24,7 +20,10 @@ func QueryBank(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcBank(i core.QueryClient) (string, error) {
+func queryByGrpcBank(i core.QueryClient) (*core.QueryResponse, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := banktypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -36,7 +35,7 @@ func queryByGrpcBank(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Bank balance
@@ -47,7 +46,7 @@ func queryByGrpcBank(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Bank denominations metadata
@@ -58,7 +57,7 @@ func queryByGrpcBank(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Bank denomination metadata
@@ -69,7 +68,7 @@ func queryByGrpcBank(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Bank total
@@ -80,7 +79,7 @@ func queryByGrpcBank(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Bank total supply
@@ -91,19 +90,14 @@ func queryByGrpcBank(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", err
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	return string(out), nil
+	return core.ProtoQueryResponse(i, res), nil
 }
 
 const (
@@ -112,7 +106,7 @@ const (
 	bankSupplyLabel        = "supply"
 )
 
-func queryByLcdBank(i core.QueryClient) (string, error) {
+func queryByLcdBank(i core.QueryClient) (*core.QueryResponse, error) {
 	url := util.MakeQueryLcdUrl(bankv1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
@@ -146,13 +140,13 @@ func queryByLcdBank(i core.QueryClient) (string, error) {
 		url = url + util.MakeQueryLabels(bankSupplyLabel, convertMsg.Denom)
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+url, i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
+	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+core.LcdPaginationURL(url, i.Ixplac.GetMsg()), i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return string(out), nil
+	return core.RawQueryResponse(i, out), nil
 }
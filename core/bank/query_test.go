@@ -126,9 +126,9 @@ func (s *IntegrationTestSuite) TestAllBalancesAndBalance() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		bankBalancesMsg := types.BankBalancesMsg{
@@ -176,9 +176,9 @@ func (s *IntegrationTestSuite) TestAllBalancesAndBalance() {
 func (s *IntegrationTestSuite) TestDenomMetadata() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.DenomMetadata().Query()
@@ -208,9 +208,9 @@ func (s *IntegrationTestSuite) TestDenomMetadata() {
 func (s *IntegrationTestSuite) TestBankTotal() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		bal2, err := util.FromStringToBigInt("1000000000000000000000")
@@ -248,6 +248,40 @@ func (s *IntegrationTestSuite) TestBankTotal() {
 	s.xplac = provider.ResetXplac(s.xplac)
 }
 
+func (s *IntegrationTestSuite) TestTypedQuery() {
+	validator := s.network.Validators[0]
+	addr := validator.Address.String()
+
+	for i, api := range s.apis {
+		if i == 0 {
+			s.xplac = s.xplac.WithURL(api)
+		} else {
+			s.xplac = s.xplac.WithGrpc(api)
+		}
+
+		bankBalancesMsg := types.BankBalancesMsg{
+			Address: addr,
+		}
+
+		res, err := s.xplac.BankBalances(bankBalancesMsg).Query()
+		s.Require().NoError(err)
+
+		var allBalancesResponse banktypes.QueryAllBalancesResponse
+		err = jsonpb.Unmarshal(strings.NewReader(res), &allBalancesResponse)
+		s.Require().NoError(err)
+
+		var typedResponse banktypes.QueryAllBalancesResponse
+		err = s.xplac.BankBalances(bankBalancesMsg).QueryTyped(&typedResponse)
+		s.Require().NoError(err)
+		s.Require().Equal(allBalancesResponse.Balances, typedResponse.Balances)
+
+		genericResponse, err := client.QueryAs[banktypes.QueryAllBalancesResponse](s.xplac.BankBalances(bankBalancesMsg))
+		s.Require().NoError(err)
+		s.Require().Equal(allBalancesResponse.Balances, genericResponse.Balances)
+	}
+	s.xplac = provider.ResetXplac(s.xplac)
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	cfg := network.DefaultConfig()
 	cfg.NumValidators = validatorNumber
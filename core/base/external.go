@@ -24,7 +24,7 @@ func (e BaseExternal) NodeInfo() provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(Base).
+	e.Xplac = e.Xplac.WithModule(Base).
 		WithMsgType(BaseNodeInfoMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -36,7 +36,7 @@ func (e BaseExternal) Syncing() provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(Base).
+	e.Xplac = e.Xplac.WithModule(Base).
 		WithMsgType(BaseSyncingMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -49,7 +49,7 @@ func (e BaseExternal) Block(blockMsg ...types.BlockMsg) provider.XplaClient {
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(Base).
+		e.Xplac = e.Xplac.WithModule(Base).
 			WithMsgType(BaseLatestBlockMsgtype).
 			WithMsg(msg)
 	} else if len(blockMsg) == 1 {
@@ -57,11 +57,11 @@ func (e BaseExternal) Block(blockMsg ...types.BlockMsg) provider.XplaClient {
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(Base).
+		e.Xplac = e.Xplac.WithModule(Base).
 			WithMsgType(BaseBlockByHeightMsgType).
 			WithMsg(msg)
 	} else {
-		provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
+		e.Xplac = provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
 	}
 	return e.Xplac
 }
@@ -73,7 +73,7 @@ func (e BaseExternal) ValidatorSet(validatorSetMsg ...types.ValidatorSetMsg) pro
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(Base).
+		e.Xplac = e.Xplac.WithModule(Base).
 			WithMsgType(BaseLatestValidatorSetMsgType).
 			WithMsg(msg)
 	} else if len(validatorSetMsg) == 1 {
@@ -81,11 +81,11 @@ func (e BaseExternal) ValidatorSet(validatorSetMsg ...types.ValidatorSetMsg) pro
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(Base).
+		e.Xplac = e.Xplac.WithModule(Base).
 			WithMsgType(BaseValidatorSetByHeightMsgType).
 			WithMsg(msg)
 	} else {
-		provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
+		e.Xplac = provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
 	}
 	return e.Xplac
 }
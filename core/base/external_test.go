@@ -7,7 +7,7 @@ import (
 
 func (s *IntegrationTestSuite) TestBase() {
 	// node info
-	s.xplac.NodeInfo()
+	s.xplac = s.xplac.NodeInfo()
 
 	makeBaseNodeInfoMsg, err := mbase.MakeBaseNodeInfoMsg()
 	s.Require().NoError(err)
@@ -17,7 +17,7 @@ func (s *IntegrationTestSuite) TestBase() {
 	s.Require().Equal(mbase.BaseNodeInfoMsgType, s.xplac.GetMsgType())
 
 	// syncing
-	s.xplac.Syncing()
+	s.xplac = s.xplac.Syncing()
 
 	makeBaseSyncingMsg, err := mbase.MakeBaseSyncingMsg()
 	s.Require().NoError(err)
@@ -27,7 +27,7 @@ func (s *IntegrationTestSuite) TestBase() {
 	s.Require().Equal(mbase.BaseSyncingMsgType, s.xplac.GetMsgType())
 
 	// latest block
-	s.xplac.Block()
+	s.xplac = s.xplac.Block()
 
 	makeBaseLatestBlockMsg, err := mbase.MakeBaseLatestBlockMsg()
 	s.Require().NoError(err)
@@ -40,7 +40,7 @@ func (s *IntegrationTestSuite) TestBase() {
 	blockMsg := types.BlockMsg{
 		Height: "1",
 	}
-	s.xplac.Block(blockMsg)
+	s.xplac = s.xplac.Block(blockMsg)
 
 	makeBaseBlockByheightMsg, err := mbase.MakeBaseBlockByHeightMsg(blockMsg)
 	s.Require().NoError(err)
@@ -50,7 +50,7 @@ func (s *IntegrationTestSuite) TestBase() {
 	s.Require().Equal(mbase.BaseBlockByHeightMsgType, s.xplac.GetMsgType())
 
 	// latest validator set
-	s.xplac.ValidatorSet()
+	s.xplac = s.xplac.ValidatorSet()
 
 	makeLatestValidatorSetMsg, err := mbase.MakeLatestValidatorSetMsg()
 	s.Require().NoError(err)
@@ -63,7 +63,7 @@ func (s *IntegrationTestSuite) TestBase() {
 	validatorSetMsg := types.ValidatorSetMsg{
 		Height: "1",
 	}
-	s.xplac.ValidatorSet(validatorSetMsg)
+	s.xplac = s.xplac.ValidatorSet(validatorSetMsg)
 
 	makeValidatorSetByHeightMsg, err := mbase.MakeValidatorSetByHeightMsg(validatorSetMsg)
 	s.Require().NoError(err)
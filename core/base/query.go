@@ -12,12 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for bank module.
-func QueryBase(i core.QueryClient) (string, error) {
+func QueryBase(i core.QueryClient) (*core.QueryResponse, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcBase(i)
 	} else {
@@ -26,7 +22,10 @@ func QueryBase(i core.QueryClient) (string, error) {
 
 }
 
-func queryByGrpcBase(i core.QueryClient) (string, error) {
+func queryByGrpcBase(i core.QueryClient) (*core.QueryResponse, error) {
+	var res proto.Message
+	var err error
+
 	serviceClient := tmservice.NewServiceClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -38,7 +37,7 @@ func queryByGrpcBase(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Syncing
@@ -49,7 +48,7 @@ func queryByGrpcBase(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Latest block
@@ -65,7 +64,7 @@ func queryByGrpcBase(i core.QueryClient) (string, error) {
 				&convertMsg,
 			)
 			if err != nil {
-				return "", util.LogErr(errors.ErrGrpcRequest, err)
+				return nil, util.LogErr(errors.ErrGrpcRequest, err)
 			}
 		}
 
@@ -82,7 +81,7 @@ func queryByGrpcBase(i core.QueryClient) (string, error) {
 				&convertMsg,
 			)
 			if err != nil {
-				return "", util.LogErr(errors.ErrGrpcRequest, err)
+				return nil, util.LogErr(errors.ErrGrpcRequest, err)
 			}
 		}
 
@@ -94,7 +93,7 @@ func queryByGrpcBase(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Validator set by height
@@ -105,19 +104,14 @@ func queryByGrpcBase(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", err
-	}
-
-	return string(out), nil
+	return core.ProtoQueryResponse(i, res), nil
 }
 
 const (
@@ -128,7 +122,7 @@ const (
 	baseValidatorsetsLabel = "validatorsets"
 )
 
-func queryByLcdBase(i core.QueryClient) (string, error) {
+func queryByLcdBase(i core.QueryClient) (*core.QueryResponse, error) {
 	url := util.MakeQueryLcdUrl(tmv1beta1.Service_ServiceDesc.Metadata.(string))
 
 	switch {
@@ -159,29 +153,25 @@ func queryByLcdBase(i core.QueryClient) (string, error) {
 		url = url + util.MakeQueryLabels(baseValidatorsetsLabel, util.FromInt64ToString(convertMsg.Height))
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
 	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+url, i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return string(out), nil
+	return core.RawQueryResponse(i, out), nil
 }
 
-func queryBlockByRpc(i core.QueryClient, height *int64) (string, error) {
+func queryBlockByRpc(i core.QueryClient, height *int64) (*core.QueryResponse, error) {
 	client, err := cmclient.NewClientFromNode(i.Ixplac.GetRpc())
 	if err != nil {
-		return "", util.LogErr(errors.ErrGrpcRequest, err)
+		return nil, util.LogErr(errors.ErrGrpcRequest, err)
 	}
 	res, err := client.Block(i.Ixplac.GetContext(), height)
 	if err != nil {
-		return "", util.LogErr(errors.ErrGrpcRequest, err)
-	}
-	out, err := core.PrintObjectLegacy(i, res)
-	if err != nil {
-		return "", util.LogErr(errors.ErrGrpcRequest, err)
+		return nil, util.LogErr(errors.ErrGrpcRequest, err)
 	}
-	return string(out), nil
+	return core.LegacyQueryResponse(i, res), nil
 }
@@ -56,9 +56,9 @@ func (s *IntegrationTestSuite) TearDownSuite() {
 func (s *IntegrationTestSuite) TestNodeInfo() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.NodeInfo().Query()
@@ -76,9 +76,9 @@ func (s *IntegrationTestSuite) TestNodeInfo() {
 func (s *IntegrationTestSuite) TestSyncing() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.Syncing().Query()
@@ -95,9 +95,9 @@ func (s *IntegrationTestSuite) TestSyncing() {
 func (s *IntegrationTestSuite) TestBlock() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res1, err := s.xplac.Block().Query()
@@ -141,9 +141,9 @@ func (s *IntegrationTestSuite) TestValidatorSet() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.ValidatorSet().Query()
@@ -12,6 +12,7 @@ type CoreModule interface {
 	NewTxRouter(cmclient.TxBuilder, string, interface{}) (cmclient.TxBuilder, error)
 
 	// Route query requests by gRPC or HTTP.
-	// Queries are returned with string type regardless of communication protocol.
-	NewQueryRouter(QueryClient) (string, error)
+	// Queries are returned as the query response which keeps the received type,
+	// and it is able to be converted to string or decoded to the typed response.
+	NewQueryRouter(QueryClient) (*QueryResponse, error)
 }
@@ -18,11 +18,11 @@ func NewCrisisExternal(xplac provider.XplaClient) (e CrisisExternal) {
 
 // Submit proof that an invariant broken to halt the chain.
 func (e CrisisExternal) InvariantBroken(invariantBrokenMsg types.InvariantBrokenMsg) provider.XplaClient {
-	msg, err := MakeInvariantRouteMsg(invariantBrokenMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeInvariantRouteMsg(invariantBrokenMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(CrisisModule).
+	e.Xplac = e.Xplac.WithModule(CrisisModule).
 		WithMsgType(CrisisInvariantBrokenMsgType).
 		WithMsg(msg)
 	return e.Xplac
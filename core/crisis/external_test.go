@@ -13,15 +13,15 @@ func (s *IntegrationTestSuite) TestCrisisTx() {
 	r := rand.New(src)
 	accounts := testutil.RandomAccounts(r, 2)
 
-	s.xplac.WithPrivateKey(accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(accounts[0].PrivKey)
 	// invariant broken
 	invariantBrokenMsg := types.InvariantBrokenMsg{
 		ModuleName:     "bank",
 		InvariantRoute: "total-supply",
 	}
-	s.xplac.InvariantBroken(invariantBrokenMsg)
+	s.xplac = s.xplac.InvariantBroken(invariantBrokenMsg)
 
-	makeInvariantRouteMsg, err := mcrisis.MakeInvariantRouteMsg(invariantBrokenMsg, s.xplac.GetPrivateKey())
+	makeInvariantRouteMsg, err := mcrisis.MakeInvariantRouteMsg(invariantBrokenMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeInvariantRouteMsg, s.xplac.GetMsg())
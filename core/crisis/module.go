@@ -32,6 +32,6 @@ func (c *coreModule) NewTxRouter(builder cmclient.TxBuilder, msgType string, msg
 	return builder, nil
 }
 
-func (c *coreModule) NewQueryRouter(q core.QueryClient) (string, error) {
-	return "", util.LogErr(errors.ErrInvalidRequest, c.Name(), "module has not query")
+func (c *coreModule) NewQueryRouter(q core.QueryClient) (*core.QueryResponse, error) {
+	return nil, util.LogErr(errors.ErrInvalidRequest, c.Name(), "module has not query")
 }
@@ -60,7 +60,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 	src := rand.NewSource(1)
 	r := rand.New(src)
 	accounts := testutil.RandomAccounts(r, 2)
-	s.xplac.WithPrivateKey(accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(accounts[0].PrivKey)
 
 	c := crisis.NewCoreModule()
 
@@ -76,9 +76,9 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 		ModuleName:     "bank",
 		InvariantRoute: "total-supply",
 	}
-	s.xplac.InvariantBroken(invariantBrokenMsg)
+	s.xplac = s.xplac.InvariantBroken(invariantBrokenMsg)
 
-	makeInvariantRouteMsg, err := crisis.MakeInvariantRouteMsg(invariantBrokenMsg, s.xplac.GetPrivateKey())
+	makeInvariantRouteMsg, err := crisis.MakeInvariantRouteMsg(invariantBrokenMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	testMsg = makeInvariantRouteMsg
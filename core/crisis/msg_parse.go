@@ -1,7 +1,6 @@
 package crisis
 
 import (
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
@@ -10,14 +9,14 @@ import (
 )
 
 // Parsing - invariant broken
-func parseInvariantBrokenArgs(invariantBrokenMsg types.InvariantBrokenMsg, privKey key.PrivateKey) (crisistypes.MsgVerifyInvariant, error) {
+func parseInvariantBrokenArgs(invariantBrokenMsg types.InvariantBrokenMsg, signer types.Signer) (crisistypes.MsgVerifyInvariant, error) {
 	if invariantBrokenMsg.ModuleName == "" || invariantBrokenMsg.InvariantRoute == "" {
 		return crisistypes.MsgVerifyInvariant{}, util.LogErr(errors.ErrInsufficientParams, "invalid module name or invariant route")
 	}
 
-	senderAddr, err := util.GetAddrByPrivKey(privKey)
+	senderAddr, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return crisistypes.MsgVerifyInvariant{}, util.LogErr(errors.ErrParse, err)
+		return crisistypes.MsgVerifyInvariant{}, err
 	}
 	msg := crisistypes.NewMsgVerifyInvariant(senderAddr, invariantBrokenMsg.ModuleName, invariantBrokenMsg.InvariantRoute)
 
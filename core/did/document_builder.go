@@ -0,0 +1,311 @@
+package did
+
+import (
+	"github.com/Moonyongjung/xpriv.go/provider"
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	xcrypto "github.com/Moonyongjung/xpla-private-chain/crypto"
+	didtypes "github.com/Moonyongjung/xpla-private-chain/x/did/types"
+)
+
+// The DID key which signs the updated DID document.
+// It must be the key of the authentication in the current DID document.
+type DIDDocumentSigner struct {
+	KeyID         string
+	DIDPassphrase string
+	DIDKeyPath    string
+	// If it is set, the DID key is loaded from it instead of the DID key path.
+	DIDKeyStore types.DIDKeyStore
+}
+
+// Builder of the DID document for updating DID.
+// It starts from the current DID document on the chain, and the first error of building is returned by Build.
+//
+// e.g.
+//
+//	builder, err := did.NewDIDDocumentBuilder(xplac, "did:xpla:...")
+//	txbytes, err := builder.
+//	    AddVerificationMethod("key2", pubKey).
+//	    AddAuthentication("key2").
+//	    AddService("service1", "LinkedDomains", "https://example.com").
+//	    UpdateDID(signer).
+//	    CreateAndSignTx()
+type DIDDocumentBuilder struct {
+	xplac    provider.XplaClient
+	did      string
+	doc      didtypes.DIDDocument
+	sequence uint64
+	err      error
+}
+
+// Make the DID document builder by fetching the current DID document and the sequence of it.
+func NewDIDDocumentBuilder(xplac provider.XplaClient, did string) (*DIDDocumentBuilder, error) {
+	did, err := didtypes.ParseDID(did)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrParse, err)
+	}
+
+	didDocumentWithSeq, err := util.GetDIDDocByQueryClient(did, xplac.GetLcdURL(), xplac.GetGrpcUrl(), xplac.GetGrpcClient(), xplac.GetContext())
+	if err != nil {
+		return nil, util.LogErr(errors.ErrInvalidRequest, err)
+	}
+	if didDocumentWithSeq.Document == nil {
+		return nil, util.LogErr(errors.ErrNotFound, "no DID document of", did)
+	}
+
+	return newDIDDocumentBuilder(xplac, did, *didDocumentWithSeq.Document, didDocumentWithSeq.Sequence), nil
+}
+
+func newDIDDocumentBuilder(xplac provider.XplaClient, did string, doc didtypes.DIDDocument, sequence uint64) *DIDDocumentBuilder {
+	b := &DIDDocumentBuilder{
+		xplac:    xplac,
+		did:      did,
+		doc:      doc,
+		sequence: sequence,
+	}
+
+	// Slices are copied so that the fetched document is not changed by the builder.
+	b.doc.VerificationMethods = append([]*didtypes.VerificationMethod(nil), doc.VerificationMethods...)
+	for _, relationships := range b.relationships() {
+		*relationships = append([]didtypes.VerificationRelationship(nil), *relationships...)
+	}
+	b.doc.Services = append([]*didtypes.Service(nil), doc.Services...)
+
+	return b
+}
+
+// Add the verification method of the secp256k1 public key.
+func (b *DIDDocumentBuilder) AddVerificationMethod(keyID string, pubKey []byte) *DIDDocumentBuilder {
+	if b.err != nil {
+		return b
+	}
+	if b.verificationMethodIndex(keyID) >= 0 {
+		b.err = util.LogErr(errors.ErrInvalidRequest, "verification method already exists:", keyID)
+		return b
+	}
+
+	verificationMethodID := didtypes.NewVerificationMethodID(b.did, keyID)
+	verificationMethod := didtypes.NewVerificationMethod(verificationMethodID, didtypes.ES256K_2019, b.did, pubKey)
+	b.doc.VerificationMethods = append(b.doc.VerificationMethods, &verificationMethod)
+
+	return b
+}
+
+// Remove the verification method. All verification relationships which refer it are removed as well,
+// e.g. the authentication, the assertion method and the key agreement.
+func (b *DIDDocumentBuilder) RemoveVerificationMethod(keyID string) *DIDDocumentBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	i := b.verificationMethodIndex(keyID)
+	if i < 0 {
+		b.err = util.LogErr(errors.ErrNotFound, "no verification method:", keyID)
+		return b
+	}
+
+	verificationMethods := append([]*didtypes.VerificationMethod(nil), b.doc.VerificationMethods[:i]...)
+	b.doc.VerificationMethods = append(verificationMethods, b.doc.VerificationMethods[i+1:]...)
+
+	id := b.verificationMethodID(keyID)
+	for _, relationships := range b.relationships() {
+		var kept []didtypes.VerificationRelationship
+		for _, relationship := range *relationships {
+			if !refersVerificationMethod(relationship, id) {
+				kept = append(kept, relationship)
+			}
+		}
+		*relationships = kept
+	}
+
+	return b
+}
+
+// Add the authentication which refers the verification method.
+func (b *DIDDocumentBuilder) AddAuthentication(keyID string) *DIDDocumentBuilder {
+	if b.err != nil {
+		return b
+	}
+	if b.verificationMethodIndex(keyID) < 0 {
+		b.err = util.LogErr(errors.ErrNotFound, "no verification method:", keyID)
+		return b
+	}
+	if b.authenticationIndex(keyID) >= 0 {
+		b.err = util.LogErr(errors.ErrInvalidRequest, "authentication already exists:", keyID)
+		return b
+	}
+
+	b.doc.Authentications = append(b.doc.Authentications, didtypes.NewVerificationRelationship(b.verificationMethodID(keyID)))
+
+	return b
+}
+
+// Remove the authentication of the verification method.
+func (b *DIDDocumentBuilder) RemoveAuthentication(keyID string) *DIDDocumentBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	i := b.authenticationIndex(keyID)
+	if i < 0 {
+		b.err = util.LogErr(errors.ErrNotFound, "no authentication:", keyID)
+		return b
+	}
+
+	authentications := append([]didtypes.VerificationRelationship(nil), b.doc.Authentications[:i]...)
+	b.doc.Authentications = append(authentications, b.doc.Authentications[i+1:]...)
+
+	return b
+}
+
+// Add the service. The service ID is the fragment of the DID, e.g. "service1" is "did:xpla:...#service1".
+func (b *DIDDocumentBuilder) AddService(serviceID, serviceType, serviceEndpoint string) *DIDDocumentBuilder {
+	if b.err != nil {
+		return b
+	}
+	if b.serviceIndex(serviceID) >= 0 {
+		b.err = util.LogErr(errors.ErrInvalidRequest, "service already exists:", serviceID)
+		return b
+	}
+
+	b.doc.Services = append(b.doc.Services, newDIDService(b.did, serviceID, serviceType, serviceEndpoint))
+
+	return b
+}
+
+// Remove the service.
+func (b *DIDDocumentBuilder) RemoveService(serviceID string) *DIDDocumentBuilder {
+	if b.err != nil {
+		return b
+	}
+
+	i := b.serviceIndex(serviceID)
+	if i < 0 {
+		b.err = util.LogErr(errors.ErrNotFound, "no service:", serviceID)
+		return b
+	}
+
+	services := append([]*didtypes.Service(nil), b.doc.Services[:i]...)
+	b.doc.Services = append(services, b.doc.Services[i+1:]...)
+
+	return b
+}
+
+// Rotate the DID key. The verification method of the new key is added with the authentication,
+// and the verification method of the old key is removed.
+// The updated document must be signed by the old key because the chain verifies the signature by the current document.
+// The new DID private key should be saved to the DID key store, e.g. key.ImportDIDKey.
+func (b *DIDDocumentBuilder) RotateKey(oldKeyID, newKeyID string, newPrivKey secp256k1.PrivKey) *DIDDocumentBuilder {
+	if b.err != nil {
+		return b
+	}
+	if b.authenticationIndex(oldKeyID) < 0 {
+		b.err = util.LogErr(errors.ErrNotFound, "no authentication of the rotated key:", oldKeyID)
+		return b
+	}
+
+	pubKey := xcrypto.PubKeyBytes(xcrypto.DerivePubKey(newPrivKey))
+	return b.AddVerificationMethod(newKeyID, pubKey).
+		AddAuthentication(newKeyID).
+		RemoveVerificationMethod(oldKeyID)
+}
+
+// Get the built DID document.
+func (b *DIDDocumentBuilder) Document() (didtypes.DIDDocument, error) {
+	if b.err != nil {
+		return didtypes.DIDDocument{}, b.err
+	}
+	if len(b.doc.Authentications) == 0 {
+		return didtypes.DIDDocument{}, util.LogErr(errors.ErrInvalidRequest, "DID document needs at least one authentication")
+	}
+	if !b.doc.Valid() {
+		return didtypes.DIDDocument{}, util.LogErr(errors.ErrInvalidRequest, "invalid DID document")
+	}
+
+	return b.doc, nil
+}
+
+// Get the sequence of the current DID document which is used for signing.
+func (b *DIDDocumentBuilder) Sequence() uint64 {
+	return b.sequence
+}
+
+// Sign the built DID document with the current sequence, and make the update DID msg.
+// The fee payer of the msg is the account of the signer of the xpla client.
+func (b *DIDDocumentBuilder) Build(docSigner DIDDocumentSigner) (didtypes.MsgUpdateDID, error) {
+	doc, err := b.Document()
+	if err != nil {
+		return didtypes.MsgUpdateDID{}, err
+	}
+
+	return signUpdateDID(b.did, doc, b.sequence, docSigner, b.xplac.GetSigner())
+}
+
+// Build the update DID msg and set it to the xpla client, e.g. for creating the transaction.
+func (b *DIDDocumentBuilder) UpdateDID(docSigner DIDDocumentSigner) provider.XplaClient {
+	msg, err := b.Build(docSigner)
+	if err != nil {
+		return provider.ResetModuleAndMsgXplac(b.xplac).WithErr(err)
+	}
+
+	return b.xplac.WithModule(DidModule).
+		WithMsgType(DidUpdateDidMsgType).
+		WithMsg(msg)
+}
+
+func (b *DIDDocumentBuilder) verificationMethodID(keyID string) string {
+	return string(didtypes.NewVerificationMethodID(b.did, keyID))
+}
+
+func (b *DIDDocumentBuilder) verificationMethodIndex(keyID string) int {
+	id := b.verificationMethodID(keyID)
+	for i, verificationMethod := range b.doc.VerificationMethods {
+		if verificationMethod.Id == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func (b *DIDDocumentBuilder) authenticationIndex(keyID string) int {
+	id := b.verificationMethodID(keyID)
+	for i, relationship := range b.doc.Authentications {
+		if refersVerificationMethod(relationship, id) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Verification relationships of the DID document.
+func (b *DIDDocumentBuilder) relationships() []*[]didtypes.VerificationRelationship {
+	return []*[]didtypes.VerificationRelationship{
+		&b.doc.Authentications,
+		&b.doc.AssertionMethods,
+		&b.doc.KeyAgreements,
+		&b.doc.CapabilityInvocations,
+		&b.doc.CapabilityDelegations,
+	}
+}
+
+// The relationship refers the verification method by the ID, or it embeds the verification method.
+func refersVerificationMethod(relationship didtypes.VerificationRelationship, verificationMethodID string) bool {
+	if relationship.GetVerificationMethodId() == verificationMethodID {
+		return true
+	}
+	verificationMethod := relationship.GetVerificationMethod()
+	return verificationMethod != nil && verificationMethod.Id == verificationMethodID
+}
+
+func (b *DIDDocumentBuilder) serviceIndex(serviceID string) int {
+	id := b.did + "#" + serviceID
+	for i, service := range b.doc.Services {
+		if service.Id == id {
+			return i
+		}
+	}
+	return -1
+}
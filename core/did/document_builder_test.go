@@ -0,0 +1,189 @@
+package did
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	xcrypto "github.com/Moonyongjung/xpla-private-chain/crypto"
+	didtypes "github.com/Moonyongjung/xpla-private-chain/x/did/types"
+)
+
+func testPubKey() []byte {
+	return xcrypto.PubKeyBytes(xcrypto.DerivePubKey(secp256k1.GenPrivKey()))
+}
+
+// Make the DID document which has "key1" for all relationships and "key2" for the assertion method.
+func newTestDIDDocument(t *testing.T) (string, didtypes.DIDDocument) {
+	pubKey := testPubKey()
+	did := didtypes.NewDID(pubKey)
+
+	key1ID := string(didtypes.NewVerificationMethodID(did, "key1"))
+	key1 := didtypes.NewVerificationMethod(key1ID, didtypes.ES256K_2019, did, pubKey)
+	key2ID := string(didtypes.NewVerificationMethodID(did, "key2"))
+	key2 := didtypes.NewVerificationMethod(key2ID, didtypes.ES256K_2019, did, testPubKey())
+
+	doc := didtypes.NewDIDDocument(did,
+		didtypes.WithVerificationMethods([]*didtypes.VerificationMethod{&key1, &key2}),
+		didtypes.WithAuthentications([]didtypes.VerificationRelationship{didtypes.NewVerificationRelationship(key1ID)}),
+		didtypes.WithAssertionMethods([]didtypes.VerificationRelationship{
+			didtypes.NewVerificationRelationship(key1ID),
+			didtypes.NewVerificationRelationship(key2ID),
+		}),
+		didtypes.WithKeyAgreements([]didtypes.VerificationRelationship{didtypes.NewVerificationRelationship(key1ID)}),
+	)
+	require.True(t, doc.Valid())
+
+	return did, doc
+}
+
+func relationshipIDs(relationships []didtypes.VerificationRelationship) []string {
+	var ids []string
+	for _, relationship := range relationships {
+		ids = append(ids, relationship.GetVerificationMethodId())
+	}
+	return ids
+}
+
+func TestDIDDocumentBuilder(t *testing.T) {
+	did, doc := newTestDIDDocument(t)
+	key1ID := string(didtypes.NewVerificationMethodID(did, "key1"))
+	key2ID := string(didtypes.NewVerificationMethodID(did, "key2"))
+	key3ID := string(didtypes.NewVerificationMethodID(did, "key3"))
+
+	testCases := []struct {
+		name  string
+		build func(b *DIDDocumentBuilder) *DIDDocumentBuilder
+		check func(t *testing.T, doc didtypes.DIDDocument)
+		// The error is returned by Document.
+		expectErr bool
+	}{
+		{
+			name: "add verification method, authentication and service",
+			build: func(b *DIDDocumentBuilder) *DIDDocumentBuilder {
+				return b.AddVerificationMethod("key3", testPubKey()).
+					AddAuthentication("key3").
+					AddService("service1", "LinkedDomains", "https://example.com")
+			},
+			check: func(t *testing.T, doc didtypes.DIDDocument) {
+				require.Len(t, doc.VerificationMethods, 3)
+				require.Equal(t, []string{key1ID, key3ID}, relationshipIDs(doc.Authentications))
+				require.Len(t, doc.Services, 1)
+				require.Equal(t, did+"#service1", doc.Services[0].Id)
+			},
+		},
+		{
+			name: "remove verification method and all relationships of it",
+			build: func(b *DIDDocumentBuilder) *DIDDocumentBuilder {
+				return b.AddVerificationMethod("key3", testPubKey()).
+					AddAuthentication("key3").
+					RemoveVerificationMethod("key1")
+			},
+			check: func(t *testing.T, doc didtypes.DIDDocument) {
+				require.Len(t, doc.VerificationMethods, 2)
+				require.Equal(t, []string{key3ID}, relationshipIDs(doc.Authentications))
+				require.Equal(t, []string{key2ID}, relationshipIDs(doc.AssertionMethods))
+				require.Empty(t, doc.KeyAgreements)
+			},
+		},
+		{
+			name: "rotate key",
+			build: func(b *DIDDocumentBuilder) *DIDDocumentBuilder {
+				return b.RotateKey("key1", "key3", secp256k1.GenPrivKey())
+			},
+			check: func(t *testing.T, doc didtypes.DIDDocument) {
+				require.Len(t, doc.VerificationMethods, 2)
+				require.Equal(t, key2ID, doc.VerificationMethods[0].Id)
+				require.Equal(t, key3ID, doc.VerificationMethods[1].Id)
+				require.Equal(t, []string{key3ID}, relationshipIDs(doc.Authentications))
+				require.Equal(t, []string{key2ID}, relationshipIDs(doc.AssertionMethods))
+				require.Empty(t, doc.KeyAgreements)
+			},
+		},
+		{
+			name: "remove service",
+			build: func(b *DIDDocumentBuilder) *DIDDocumentBuilder {
+				return b.AddService("service1", "LinkedDomains", "https://example.com").
+					AddService("service2", "LinkedDomains", "https://example.org").
+					RemoveService("service1")
+			},
+			check: func(t *testing.T, doc didtypes.DIDDocument) {
+				require.Len(t, doc.Services, 1)
+				require.Equal(t, did+"#service2", doc.Services[0].Id)
+			},
+		},
+		{
+			name: "remove the only authentication",
+			build: func(b *DIDDocumentBuilder) *DIDDocumentBuilder {
+				return b.RemoveVerificationMethod("key1")
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicated verification method",
+			build: func(b *DIDDocumentBuilder) *DIDDocumentBuilder {
+				return b.AddVerificationMethod("key2", testPubKey())
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicated authentication",
+			build: func(b *DIDDocumentBuilder) *DIDDocumentBuilder {
+				return b.AddAuthentication("key1")
+			},
+			expectErr: true,
+		},
+		{
+			name: "authentication of unknown verification method",
+			build: func(b *DIDDocumentBuilder) *DIDDocumentBuilder {
+				return b.AddAuthentication("key3")
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicated service",
+			build: func(b *DIDDocumentBuilder) *DIDDocumentBuilder {
+				return b.AddService("service1", "LinkedDomains", "https://example.com").
+					AddService("service1", "LinkedDomains", "https://example.org")
+			},
+			expectErr: true,
+		},
+		{
+			name: "rotate the key which is not the authentication",
+			build: func(b *DIDDocumentBuilder) *DIDDocumentBuilder {
+				return b.RotateKey("key2", "key3", secp256k1.GenPrivKey())
+			},
+			expectErr: true,
+		},
+		{
+			name: "the first error is kept",
+			build: func(b *DIDDocumentBuilder) *DIDDocumentBuilder {
+				return b.RemoveService("service1").
+					AddVerificationMethod("key3", testPubKey())
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := newDIDDocumentBuilder(nil, did, doc, 3)
+			built, err := tc.build(b).Document()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			tc.check(t, built)
+			require.Equal(t, uint64(3), b.Sequence())
+
+			// the source document is not changed by the builder
+			require.Len(t, doc.VerificationMethods, 2)
+			require.Equal(t, []string{key1ID}, relationshipIDs(doc.Authentications))
+			require.Equal(t, []string{key1ID, key2ID}, relationshipIDs(doc.AssertionMethods))
+			require.Equal(t, []string{key1ID}, relationshipIDs(doc.KeyAgreements))
+			require.Empty(t, doc.Services)
+		})
+	}
+}
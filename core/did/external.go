@@ -18,11 +18,11 @@ func NewDidExternal(xplac provider.XplaClient) (e DidExternal) {
 
 // Create new DID.
 func (e DidExternal) CreateDID(createDIDMsg types.CreateDIDMsg) provider.XplaClient {
-	msg, err := MakeCreateDIDMsg(createDIDMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeCreateDIDMsg(createDIDMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DidModule).
+	e.Xplac = e.Xplac.WithModule(DidModule).
 		WithMsgType(DidCreateDidMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -30,11 +30,11 @@ func (e DidExternal) CreateDID(createDIDMsg types.CreateDIDMsg) provider.XplaCli
 
 // Update existed DID.
 func (e DidExternal) UpdateDID(updateDIDMsg types.UpdateDIDMsg) provider.XplaClient {
-	msg, err := MakeUpdateDIDMsg(updateDIDMsg, e.Xplac.GetLcdURL(), e.Xplac.GetGrpcUrl(), e.Xplac.GetGrpcClient(), e.Xplac.GetPrivateKey(), e.Xplac.GetContext())
+	msg, err := MakeUpdateDIDMsg(updateDIDMsg, e.Xplac.GetLcdURL(), e.Xplac.GetGrpcUrl(), e.Xplac.GetGrpcClient(), e.Xplac.GetSigner(), e.Xplac.GetContext())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DidModule).
+	e.Xplac = e.Xplac.WithModule(DidModule).
 		WithMsgType(DidUpdateDidMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -42,11 +42,11 @@ func (e DidExternal) UpdateDID(updateDIDMsg types.UpdateDIDMsg) provider.XplaCli
 
 // Deactivate existed DID.
 func (e DidExternal) DeactivateDID(deactivateDIDMsg types.DeactivateDIDMsg) provider.XplaClient {
-	msg, err := MakeDeactivateDIDMsg(deactivateDIDMsg, e.Xplac.GetLcdURL(), e.Xplac.GetGrpcUrl(), e.Xplac.GetGrpcClient(), e.Xplac.GetPrivateKey(), e.Xplac.GetContext())
+	msg, err := MakeDeactivateDIDMsg(deactivateDIDMsg, e.Xplac.GetLcdURL(), e.Xplac.GetGrpcUrl(), e.Xplac.GetGrpcClient(), e.Xplac.GetSigner(), e.Xplac.GetContext())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DidModule).
+	e.Xplac = e.Xplac.WithModule(DidModule).
 		WithMsgType(DidDeactivateDidMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -54,11 +54,11 @@ func (e DidExternal) DeactivateDID(deactivateDIDMsg types.DeactivateDIDMsg) prov
 
 // Replace DID moniker
 func (e DidExternal) ReplaceDIDMoniker(replaceDIDMonikerMsg types.ReplaceDIDMonikerMsg) provider.XplaClient {
-	msg, err := MakeReplaceDIDMonikerMsg(replaceDIDMonikerMsg, e.Xplac.GetLcdURL(), e.Xplac.GetGrpcUrl(), e.Xplac.GetGrpcClient(), e.Xplac.GetPrivateKey(), e.Xplac.GetContext())
+	msg, err := MakeReplaceDIDMonikerMsg(replaceDIDMonikerMsg, e.Xplac.GetLcdURL(), e.Xplac.GetGrpcUrl(), e.Xplac.GetGrpcClient(), e.Xplac.GetSigner(), e.Xplac.GetContext())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DidModule).
+	e.Xplac = e.Xplac.WithModule(DidModule).
 		WithMsgType(DidReplaceDidMonikerMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -72,7 +72,7 @@ func (e DidExternal) GetDID(getDIDMsg types.GetDIDMsg) provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DidModule).
+	e.Xplac = e.Xplac.WithModule(DidModule).
 		WithMsgType(DidGetDidMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -84,7 +84,7 @@ func (e DidExternal) MonikerByDID(monikerByDIDMsg types.MonikerByDIDMsg) provide
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DidModule).
+	e.Xplac = e.Xplac.WithModule(DidModule).
 		WithMsgType(DidMonikerByDidMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -96,7 +96,7 @@ func (e DidExternal) DIDByMoniker(didByMonikerMsg types.DIDByMonikerMsg) provide
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DidModule).
+	e.Xplac = e.Xplac.WithModule(DidModule).
 		WithMsgType(DidDidByMonikerMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -104,11 +104,11 @@ func (e DidExternal) DIDByMoniker(didByMonikerMsg types.DIDByMonikerMsg) provide
 
 // Query all DIDs are activated.
 func (e DidExternal) AllDIDs() provider.XplaClient {
-	msg, err := MakeAllDIDsMsg()
+	msg, err := MakeAllDIDsMsg(e.Xplac.GetPagination())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DidModule).
+	e.Xplac = e.Xplac.WithModule(DidModule).
 		WithMsgType(DidAllDidsMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -44,6 +44,6 @@ func (c *coreModule) NewTxRouter(builder cmclient.TxBuilder, msgType string, msg
 	return builder, nil
 }
 
-func (c *coreModule) NewQueryRouter(q core.QueryClient) (string, error) {
+func (c *coreModule) NewQueryRouter(q core.QueryClient) (*core.QueryResponse, error) {
 	return QueryDID(q)
 }
@@ -3,32 +3,31 @@ package did
 import (
 	"context"
 
-	"github.com/Moonyongjung/xpriv.go/core"
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/gogo/protobuf/grpc"
 
 	didtypes "github.com/Moonyongjung/xpla-private-chain/x/did/types"
 )
 
 // (Tx) make msg - create DID
-func MakeCreateDIDMsg(createDIDMsg types.CreateDIDMsg, privKey key.PrivateKey) (didtypes.MsgCreateDID, error) {
-	return parseCreateDIDArgs(createDIDMsg, privKey)
+func MakeCreateDIDMsg(createDIDMsg types.CreateDIDMsg, signer types.Signer) (didtypes.MsgCreateDID, error) {
+	return parseCreateDIDArgs(createDIDMsg, signer)
 }
 
 // (Tx) make msg - update DID
-func MakeUpdateDIDMsg(updateDIDMsg types.UpdateDIDMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, privKey key.PrivateKey, ctx context.Context) (didtypes.MsgUpdateDID, error) {
-	return parseUpdateDIDArgs(updateDIDMsg, lcdUrl, grpcUrl, grpcConn, privKey, ctx)
+func MakeUpdateDIDMsg(updateDIDMsg types.UpdateDIDMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, signer types.Signer, ctx context.Context) (didtypes.MsgUpdateDID, error) {
+	return parseUpdateDIDArgs(updateDIDMsg, lcdUrl, grpcUrl, grpcConn, signer, ctx)
 }
 
 // (Tx) make msg - deactivate DID
-func MakeDeactivateDIDMsg(deactivateDIDMsg types.DeactivateDIDMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, privKey key.PrivateKey, ctx context.Context) (didtypes.MsgDeactivateDID, error) {
-	return parseDeactivateDIDArgs(deactivateDIDMsg, lcdUrl, grpcUrl, grpcConn, privKey, ctx)
+func MakeDeactivateDIDMsg(deactivateDIDMsg types.DeactivateDIDMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, signer types.Signer, ctx context.Context) (didtypes.MsgDeactivateDID, error) {
+	return parseDeactivateDIDArgs(deactivateDIDMsg, lcdUrl, grpcUrl, grpcConn, signer, ctx)
 }
 
 // (Tx) make msg - replace DID moniker
-func MakeReplaceDIDMonikerMsg(replaceDIDMonikerMsg types.ReplaceDIDMonikerMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, privKey key.PrivateKey, ctx context.Context) (didtypes.MsgReplaceDIDMoniker, error) {
-	return parseReplaceDIDMonikerArgs(replaceDIDMonikerMsg, lcdUrl, grpcUrl, grpcConn, privKey, ctx)
+func MakeReplaceDIDMonikerMsg(replaceDIDMonikerMsg types.ReplaceDIDMonikerMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, signer types.Signer, ctx context.Context) (didtypes.MsgReplaceDIDMoniker, error) {
+	return parseReplaceDIDMonikerArgs(replaceDIDMonikerMsg, lcdUrl, grpcUrl, grpcConn, signer, ctx)
 }
 
 // (Query) - get DID
@@ -53,8 +52,8 @@ func MakeDIDByMonikerMsg(didByMonikerMsg types.DIDByMonikerMsg) (didtypes.QueryD
 }
 
 // (Query) - all dids
-func MakeAllDIDsMsg() (didtypes.QueryAllDIDsRequest, error) {
+func MakeAllDIDsMsg(pageReq *query.PageRequest) (didtypes.QueryAllDIDsRequest, error) {
 	return didtypes.QueryAllDIDsRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageReq,
 	}, nil
 }
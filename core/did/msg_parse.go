@@ -19,33 +19,76 @@ import (
 )
 
 // Parsing - create DID
-func parseCreateDIDArgs(createDIDMsg types.CreateDIDMsg, privKey key.PrivateKey) (didtypes.MsgCreateDID, error) {
-	if createDIDMsg.SaveDIDKeyPath == "" {
+func parseCreateDIDArgs(createDIDMsg types.CreateDIDMsg, signer types.Signer) (didtypes.MsgCreateDID, error) {
+	if createDIDMsg.DIDKeyStore == nil && createDIDMsg.SaveDIDKeyPath == "" {
 		return didtypes.MsgCreateDID{}, util.LogErr(errors.ErrNotFound, "indicate directory for saving DID key")
 	}
 
-	didPrivKey, err := didcrypto.GenSecp256k1PrivKey(createDIDMsg.DIDMnemonic, createDIDMsg.DIDPassphrase)
+	var didPrivKey secp256k1.PrivKey
+	var err error
+	if createDIDMsg.DIDPrivKey != nil {
+		didPrivKey, err = xcrypto.PrivKeyFromBytes(createDIDMsg.DIDPrivKey)
+	} else {
+		didPrivKey, err = didcrypto.GenSecp256k1PrivKey(createDIDMsg.DIDMnemonic, createDIDMsg.DIDPassphrase)
+	}
 	if err != nil {
 		return didtypes.MsgCreateDID{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	fromAddress, err := util.GetAddrByPrivKey(privKey)
+	fromAddress, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return didtypes.MsgCreateDID{}, util.LogErr(errors.ErrParse, err)
+		return didtypes.MsgCreateDID{}, err
 	}
 
 	pubKey := xcrypto.PubKeyBytes(xcrypto.DerivePubKey(didPrivKey))
 	did := didtypes.NewDID(pubKey)
+
+	// The DID key "key1" is always the first verification method and the authentication for signing the DID document.
+	parts := newDIDDocumentParts(did)
 	verificationMethodID := didtypes.NewVerificationMethodID(did, "key1")
-	verificationMethod := didtypes.NewVerificationMethod(verificationMethodID, didtypes.ES256K_2019, did, pubKey)
-	verificationMethods := []*didtypes.VerificationMethod{
-		&verificationMethod,
+	verificationMethod := parts.addVerificationMethod("key1", didtypes.ES256K_2019, pubKey)
+	didPrivKeys := map[string]secp256k1.PrivKey{
+		verificationMethod.Id: didPrivKey,
+	}
+	if err := parts.addRelationships(verificationMethod, append([]string{types.DIDRelationshipAuthentication}, createDIDMsg.Relationships...)); err != nil {
+		return didtypes.MsgCreateDID{}, err
+	}
+
+	for _, vm := range createDIDMsg.VerificationMethods {
+		vmPubKey := vm.PubKey
+		var vmPrivKey secp256k1.PrivKey
+		if vm.PrivKey != nil {
+			vmPrivKey, err = xcrypto.PrivKeyFromBytes(vm.PrivKey)
+			if err != nil {
+				return didtypes.MsgCreateDID{}, util.LogErr(errors.ErrParse, err)
+			}
+			vmPubKey = xcrypto.PubKeyBytes(xcrypto.DerivePubKey(vmPrivKey))
+		}
+		if vm.KeyID == "" || vmPubKey == nil {
+			return didtypes.MsgCreateDID{}, util.LogErr(errors.ErrInsufficientParams, "need key ID and key of the verification method")
+		}
+
+		keyType := didtypes.ES256K_2019
+		if vm.Type != "" {
+			keyType = didtypes.KeyType(vm.Type)
+		}
+
+		added := parts.addVerificationMethod(vm.KeyID, keyType, vmPubKey)
+		if _, ok := didPrivKeys[added.Id]; ok {
+			return didtypes.MsgCreateDID{}, util.LogErr(errors.ErrInvalidRequest, "duplicated verification method:", vm.KeyID)
+		}
+		didPrivKeys[added.Id] = vmPrivKey
+
+		if err := parts.addRelationships(added, vm.Relationships); err != nil {
+			return didtypes.MsgCreateDID{}, err
+		}
 	}
-	relationship := didtypes.NewVerificationRelationship(verificationMethods[0].Id)
-	authentications := []didtypes.VerificationRelationship{
-		relationship,
+
+	for _, service := range createDIDMsg.Services {
+		parts.services = append(parts.services, newDIDService(did, service.ID, service.Type, service.ServiceEndpoint))
 	}
-	doc := didtypes.NewDIDDocument(did, didtypes.WithVerificationMethods(verificationMethods), didtypes.WithAuthentications(authentications))
+
+	doc := parts.document()
 
 	sig, err := didtypes.Sign(&doc, didtypes.InitialSequence, didPrivKey)
 	if err != nil {
@@ -57,61 +100,150 @@ func parseCreateDIDArgs(createDIDMsg types.CreateDIDMsg, privKey key.PrivateKey)
 		return didtypes.MsgCreateDID{}, util.LogErr(errors.ErrInvalidRequest, err)
 	}
 
-	ks, err := didcrypto.NewKeyStore(createDIDMsg.SaveDIDKeyPath)
+	ks, err := key.DIDKeyStoreOf(createDIDMsg.DIDKeyStore, createDIDMsg.SaveDIDKeyPath)
 	if err != nil {
-		return didtypes.MsgCreateDID{}, util.LogErr(errors.ErrParse, err)
+		return didtypes.MsgCreateDID{}, err
 	}
 
-	_, err = ks.Save(verificationMethodID, didPrivKey[:], createDIDMsg.DIDPassphrase)
-	if err != nil {
-		return didtypes.MsgCreateDID{}, util.LogErr(errors.ErrParse, err)
+	for id, didPrivKey := range didPrivKeys {
+		if didPrivKey == nil {
+			continue
+		}
+		if err := key.ImportDIDKey(ks, id, didPrivKey, createDIDMsg.DIDPassphrase); err != nil {
+			return didtypes.MsgCreateDID{}, err
+		}
 	}
 
 	return msg, nil
 }
 
+// Parts of the new DID document.
+type didDocumentParts struct {
+	did                 string
+	verificationMethods []*didtypes.VerificationMethod
+	authentications     []didtypes.VerificationRelationship
+	assertionMethods    []didtypes.VerificationRelationship
+	keyAgreements       []didtypes.VerificationRelationship
+	services            []*didtypes.Service
+}
+
+func newDIDDocumentParts(did string) *didDocumentParts {
+	return &didDocumentParts{did: did}
+}
+
+func (p *didDocumentParts) addVerificationMethod(keyID string, keyType didtypes.KeyType, pubKey []byte) *didtypes.VerificationMethod {
+	verificationMethodID := didtypes.NewVerificationMethodID(p.did, keyID)
+	verificationMethod := didtypes.NewVerificationMethod(verificationMethodID, keyType, p.did, pubKey)
+	p.verificationMethods = append(p.verificationMethods, &verificationMethod)
+
+	return &verificationMethod
+}
+
+// The duplicated relationship is added once, e.g. the authentication of "key1" which is always added.
+func (p *didDocumentParts) addRelationships(verificationMethod *didtypes.VerificationMethod, relationships []string) error {
+	added := make(map[string]bool)
+	for _, r := range relationships {
+		if added[r] {
+			continue
+		}
+		added[r] = true
+
+		relationship := didtypes.NewVerificationRelationship(verificationMethod.Id)
+		switch r {
+		case types.DIDRelationshipAuthentication:
+			p.authentications = append(p.authentications, relationship)
+		case types.DIDRelationshipAssertionMethod:
+			p.assertionMethods = append(p.assertionMethods, relationship)
+		case types.DIDRelationshipKeyAgreement:
+			p.keyAgreements = append(p.keyAgreements, relationship)
+		default:
+			return util.LogErr(errors.ErrInvalidRequest, "invalid verification relationship:", r)
+		}
+	}
+	return nil
+}
+
+func (p *didDocumentParts) document() didtypes.DIDDocument {
+	opts := []didtypes.DIDDocumentOption{
+		didtypes.WithVerificationMethods(p.verificationMethods),
+		didtypes.WithAuthentications(p.authentications),
+	}
+	if len(p.assertionMethods) > 0 {
+		opts = append(opts, didtypes.WithAssertionMethods(p.assertionMethods))
+	}
+	if len(p.keyAgreements) > 0 {
+		opts = append(opts, didtypes.WithKeyAgreements(p.keyAgreements))
+	}
+	if len(p.services) > 0 {
+		opts = append(opts, didtypes.WithServices(p.services))
+	}
+
+	return didtypes.NewDIDDocument(p.did, opts...)
+}
+
+func newDIDService(did, serviceID, serviceType, serviceEndpoint string) *didtypes.Service {
+	return &didtypes.Service{
+		Id:              did + "#" + serviceID,
+		Type:            serviceType,
+		ServiceEndpoint: serviceEndpoint,
+	}
+}
+
 // Parsing - update DID
-func parseUpdateDIDArgs(updateDIDMsg types.UpdateDIDMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, privKey key.PrivateKey, ctx context.Context) (didtypes.MsgUpdateDID, error) {
+func parseUpdateDIDArgs(updateDIDMsg types.UpdateDIDMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, signer types.Signer, ctx context.Context) (didtypes.MsgUpdateDID, error) {
 	did, err := didtypes.ParseDID(updateDIDMsg.DID)
 	if err != nil {
 		return didtypes.MsgUpdateDID{}, util.LogErr(errors.ErrInvalidMsgType, err)
 	}
 
-	verificationMethodID, err := didtypes.ParseVerificationMethodID(did+"#"+updateDIDMsg.KeyID, did)
+	doc, err := readDIDDocFrom(updateDIDMsg.DIDDocumentPath)
 	if err != nil {
-		return didtypes.MsgUpdateDID{}, util.LogErr(errors.ErrInvalidMsgType, err)
+		return didtypes.MsgUpdateDID{}, util.LogErr(errors.ErrInvalidRequest, err)
 	}
 
-	doc, err := readDIDDocFrom(updateDIDMsg.DIDDocumentPath)
+	didDocumentWithSeq, err := util.GetDIDDocByQueryClient(did, lcdUrl, grpcUrl, grpcConn, ctx)
 	if err != nil {
 		return didtypes.MsgUpdateDID{}, util.LogErr(errors.ErrInvalidRequest, err)
 	}
 
-	didPrivKey, err := getPrivKeyFromKeyStore(updateDIDMsg.DIDKeyPath, updateDIDMsg.DIDPassphrase, verificationMethodID)
+	docSigner := DIDDocumentSigner{
+		KeyID:         updateDIDMsg.KeyID,
+		DIDPassphrase: updateDIDMsg.DIDPassphrase,
+		DIDKeyPath:    updateDIDMsg.DIDKeyPath,
+		DIDKeyStore:   updateDIDMsg.DIDKeyStore,
+	}
+
+	return signUpdateDID(did, doc, didDocumentWithSeq.Sequence, docSigner, signer)
+}
+
+// Sign the DID document with the DID key of the document signer, and make the update DID msg.
+// The sender of the msg is the address of the signer of the transaction.
+func signUpdateDID(did string, doc didtypes.DIDDocument, sequence uint64, docSigner DIDDocumentSigner, signer types.Signer) (didtypes.MsgUpdateDID, error) {
+	verificationMethodID, err := didtypes.ParseVerificationMethodID(did+"#"+docSigner.KeyID, did)
 	if err != nil {
-		return didtypes.MsgUpdateDID{}, util.LogErr(errors.ErrInvalidRequest, err)
+		return didtypes.MsgUpdateDID{}, util.LogErr(errors.ErrInvalidMsgType, err)
 	}
 
-	didDocumentWithSeq, err := util.GetDIDDocByQueryClient(did, lcdUrl, grpcUrl, grpcConn, ctx)
+	didPrivKey, err := getPrivKeyFromKeyStore(docSigner.DIDKeyStore, docSigner.DIDKeyPath, docSigner.DIDPassphrase, verificationMethodID)
 	if err != nil {
 		return didtypes.MsgUpdateDID{}, util.LogErr(errors.ErrInvalidRequest, err)
 	}
 
-	sign, err := didtypes.Sign(&doc, didDocumentWithSeq.Sequence, didPrivKey)
+	sign, err := didtypes.Sign(&doc, sequence, didPrivKey)
 	if err != nil {
 		return didtypes.MsgUpdateDID{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	fromAddress, err := util.GetAddrByPrivKey(privKey)
+	fromAddress, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return didtypes.MsgUpdateDID{}, util.LogErr(errors.ErrParse, err)
+		return didtypes.MsgUpdateDID{}, err
 	}
 
 	return didtypes.NewMsgUpdateDID(did, doc, verificationMethodID, sign, fromAddress.String()), nil
 }
 
 // Parsing - deactivate DID
-func parseDeactivateDIDArgs(deactivateDIDMsg types.DeactivateDIDMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, privKey key.PrivateKey, ctx context.Context) (didtypes.MsgDeactivateDID, error) {
+func parseDeactivateDIDArgs(deactivateDIDMsg types.DeactivateDIDMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, signer types.Signer, ctx context.Context) (didtypes.MsgDeactivateDID, error) {
 	did, err := didtypes.ParseDID(deactivateDIDMsg.DID)
 	if err != nil {
 		return didtypes.MsgDeactivateDID{}, util.LogErr(errors.ErrParse, err)
@@ -122,7 +254,7 @@ func parseDeactivateDIDArgs(deactivateDIDMsg types.DeactivateDIDMsg, lcdUrl, grp
 		return didtypes.MsgDeactivateDID{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	didPrivKey, err := getPrivKeyFromKeyStore(deactivateDIDMsg.DIDKeyPath, deactivateDIDMsg.DIDPassphrase, verificationMethodID)
+	didPrivKey, err := getPrivKeyFromKeyStore(deactivateDIDMsg.DIDKeyStore, deactivateDIDMsg.DIDKeyPath, deactivateDIDMsg.DIDPassphrase, verificationMethodID)
 	if err != nil {
 		return didtypes.MsgDeactivateDID{}, util.LogErr(errors.ErrInvalidRequest, err)
 	}
@@ -141,16 +273,16 @@ func parseDeactivateDIDArgs(deactivateDIDMsg types.DeactivateDIDMsg, lcdUrl, grp
 		return didtypes.MsgDeactivateDID{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	fromAddress, err := util.GetAddrByPrivKey(privKey)
+	fromAddress, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return didtypes.MsgDeactivateDID{}, util.LogErr(errors.ErrParse, err)
+		return didtypes.MsgDeactivateDID{}, err
 	}
 
 	return didtypes.NewMsgDeactivateDID(did, verificationMethodID, sign, fromAddress.String()), nil
 }
 
 // Parsing - replace DID moniker
-func parseReplaceDIDMonikerArgs(replaceDIDMonikerMsg types.ReplaceDIDMonikerMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, privKey key.PrivateKey, ctx context.Context) (didtypes.MsgReplaceDIDMoniker, error) {
+func parseReplaceDIDMonikerArgs(replaceDIDMonikerMsg types.ReplaceDIDMonikerMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, signer types.Signer, ctx context.Context) (didtypes.MsgReplaceDIDMoniker, error) {
 	did, err := didtypes.ParseDID(replaceDIDMonikerMsg.DID)
 	if err != nil {
 		return didtypes.MsgReplaceDIDMoniker{}, util.LogErr(errors.ErrParse, err)
@@ -161,7 +293,7 @@ func parseReplaceDIDMonikerArgs(replaceDIDMonikerMsg types.ReplaceDIDMonikerMsg,
 		return didtypes.MsgReplaceDIDMoniker{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	didPrivateKey, err := getPrivKeyFromKeyStore(replaceDIDMonikerMsg.DIDKeyPath, replaceDIDMonikerMsg.DIDPassphrase, verificationMethodID)
+	didPrivateKey, err := getPrivKeyFromKeyStore(replaceDIDMonikerMsg.DIDKeyStore, replaceDIDMonikerMsg.DIDKeyPath, replaceDIDMonikerMsg.DIDPassphrase, verificationMethodID)
 	if err != nil {
 		return didtypes.MsgReplaceDIDMoniker{}, util.LogErr(errors.ErrParse, err)
 	}
@@ -180,9 +312,9 @@ func parseReplaceDIDMonikerArgs(replaceDIDMonikerMsg types.ReplaceDIDMonikerMsg,
 		return didtypes.MsgReplaceDIDMoniker{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	fromAddress, err := util.GetAddrByPrivKey(privKey)
+	fromAddress, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return didtypes.MsgReplaceDIDMoniker{}, util.LogErr(errors.ErrParse, err)
+		return didtypes.MsgReplaceDIDMoniker{}, err
 	}
 
 	return didtypes.NewMsgReplaceDIDMoniker(did, verificationMethodID, replaceDIDMonikerMsg.NewMoniker, sign, fromAddress.String()), nil
@@ -209,16 +341,6 @@ func readDIDDocFrom(path string) (didtypes.DIDDocument, error) {
 	return doc, nil
 }
 
-func getPrivKeyFromKeyStore(didKeyPath, didPassphrase string, verificationMethodID string) (secp256k1.PrivKey, error) {
-	ks, err := didcrypto.NewKeyStore(didKeyPath)
-	if err != nil {
-		return nil, util.LogErr(errors.ErrInvalidRequest, err)
-	}
-
-	didPrivKeyBytes, err := ks.LoadByAddress(string(verificationMethodID), didPassphrase)
-	if err != nil {
-		return nil, util.LogErr(errors.ErrInvalidRequest, err)
-	}
-
-	return xcrypto.PrivKeyFromBytes(didPrivKeyBytes)
+func getPrivKeyFromKeyStore(keyStore types.DIDKeyStore, didKeyPath, didPassphrase string, verificationMethodID string) (secp256k1.PrivKey, error) {
+	return key.LoadDIDPrivKey(keyStore, didKeyPath, didPassphrase, verificationMethodID)
 }
@@ -0,0 +1,171 @@
+package did
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/Moonyongjung/xpriv.go/key"
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	xcrypto "github.com/Moonyongjung/xpla-private-chain/crypto"
+	didtypes "github.com/Moonyongjung/xpla-private-chain/x/did/types"
+)
+
+func newTestSigner(t *testing.T) types.Signer {
+	mnemonic, err := key.NewMnemonic()
+	require.NoError(t, err)
+	privKey, err := key.NewPrivKey(mnemonic)
+	require.NoError(t, err)
+
+	return key.NewPrivKeySigner(privKey)
+}
+
+func TestParseCreateDIDArgs(t *testing.T) {
+	mnemonic, err := key.NewMnemonic()
+	require.NoError(t, err)
+
+	didPrivKey := secp256k1.GenPrivKey()
+	didPubKey := xcrypto.PubKeyBytes(xcrypto.DerivePubKey(didPrivKey))
+	key2PrivKey := secp256k1.GenPrivKey()
+	key3PubKey := testPubKey()
+
+	testCases := []struct {
+		name  string
+		msg   types.CreateDIDMsg
+		check func(t *testing.T, msg didtypes.MsgCreateDID, savedKeys []string)
+		// The DID is derived from the DID private key of the msg.
+		expectDID string
+		expectErr bool
+	}{
+		{
+			name: "DID key only",
+			msg:  types.CreateDIDMsg{DIDMnemonic: mnemonic},
+			check: func(t *testing.T, msg didtypes.MsgCreateDID, savedKeys []string) {
+				key1ID := string(didtypes.NewVerificationMethodID(msg.Did, "key1"))
+				require.Len(t, msg.Document.VerificationMethods, 1)
+				require.Equal(t, []string{key1ID}, relationshipIDs(msg.Document.Authentications))
+				require.Empty(t, msg.Document.AssertionMethods)
+				require.Empty(t, msg.Document.KeyAgreements)
+				require.Equal(t, []string{key1ID}, savedKeys)
+			},
+		},
+		{
+			name:      "DID private key",
+			msg:       types.CreateDIDMsg{DIDPrivKey: didPrivKey.Bytes()},
+			expectDID: didtypes.NewDID(didPubKey),
+			check: func(t *testing.T, msg didtypes.MsgCreateDID, savedKeys []string) {
+				require.Equal(t, []string{string(didtypes.NewVerificationMethodID(msg.Did, "key1"))}, savedKeys)
+			},
+		},
+		{
+			name: "relationships of the DID key",
+			msg: types.CreateDIDMsg{
+				DIDPrivKey: didPrivKey.Bytes(),
+				Relationships: []string{
+					types.DIDRelationshipAssertionMethod,
+					types.DIDRelationshipKeyAgreement,
+					types.DIDRelationshipAuthentication,
+				},
+			},
+			expectDID: didtypes.NewDID(didPubKey),
+			check: func(t *testing.T, msg didtypes.MsgCreateDID, savedKeys []string) {
+				key1ID := string(didtypes.NewVerificationMethodID(msg.Did, "key1"))
+				// the authentication is not duplicated
+				require.Equal(t, []string{key1ID}, relationshipIDs(msg.Document.Authentications))
+				require.Equal(t, []string{key1ID}, relationshipIDs(msg.Document.AssertionMethods))
+				require.Equal(t, []string{key1ID}, relationshipIDs(msg.Document.KeyAgreements))
+			},
+		},
+		{
+			name: "multiple verification methods and services",
+			msg: types.CreateDIDMsg{
+				DIDPrivKey: didPrivKey.Bytes(),
+				VerificationMethods: []types.DIDVerificationMethod{
+					{
+						KeyID:         "key2",
+						PrivKey:       key2PrivKey.Bytes(),
+						Relationships: []string{types.DIDRelationshipAuthentication, types.DIDRelationshipAssertionMethod},
+					},
+					{
+						KeyID:         "key3",
+						PubKey:        key3PubKey,
+						Relationships: []string{types.DIDRelationshipKeyAgreement},
+					},
+				},
+				Services: []types.DIDService{
+					{ID: "service1", Type: "LinkedDomains", ServiceEndpoint: "https://example.com"},
+				},
+			},
+			expectDID: didtypes.NewDID(didPubKey),
+			check: func(t *testing.T, msg didtypes.MsgCreateDID, savedKeys []string) {
+				key1ID := string(didtypes.NewVerificationMethodID(msg.Did, "key1"))
+				key2ID := string(didtypes.NewVerificationMethodID(msg.Did, "key2"))
+				key3ID := string(didtypes.NewVerificationMethodID(msg.Did, "key3"))
+
+				require.Len(t, msg.Document.VerificationMethods, 3)
+				require.Equal(t, key1ID, msg.Document.VerificationMethods[0].Id)
+				require.Equal(t, []string{key1ID, key2ID}, relationshipIDs(msg.Document.Authentications))
+				require.Equal(t, []string{key2ID}, relationshipIDs(msg.Document.AssertionMethods))
+				require.Equal(t, []string{key3ID}, relationshipIDs(msg.Document.KeyAgreements))
+
+				require.Len(t, msg.Document.Services, 1)
+				require.Equal(t, msg.Did+"#service1", msg.Document.Services[0].Id)
+
+				// the key which has only the public key is not saved
+				require.Equal(t, []string{key1ID, key2ID}, savedKeys)
+			},
+		},
+		{
+			name: "invalid relationship",
+			msg: types.CreateDIDMsg{
+				DIDMnemonic:   mnemonic,
+				Relationships: []string{"capabilityInvocation"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicated verification method",
+			msg: types.CreateDIDMsg{
+				DIDMnemonic:         mnemonic,
+				VerificationMethods: []types.DIDVerificationMethod{{KeyID: "key1", PubKey: key3PubKey}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "verification method without key",
+			msg: types.CreateDIDMsg{
+				DIDMnemonic:         mnemonic,
+				VerificationMethods: []types.DIDVerificationMethod{{KeyID: "key2"}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ks := key.NewMemDIDKeyStore()
+			tc.msg.DIDKeyStore = ks
+
+			msg, err := parseCreateDIDArgs(tc.msg, newTestSigner(t))
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if tc.expectDID != "" {
+				require.Equal(t, tc.expectDID, msg.Did)
+			}
+
+			savedKeys, err := ks.List()
+			require.NoError(t, err)
+			sort.Strings(savedKeys)
+			tc.check(t, msg, savedKeys)
+		})
+	}
+
+	// the DID key store or the directory is needed
+	_, err = parseCreateDIDArgs(types.CreateDIDMsg{DIDMnemonic: mnemonic}, newTestSigner(t))
+	require.Error(t, err)
+}
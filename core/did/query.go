@@ -9,12 +9,8 @@ import (
 	"github.com/gogo/protobuf/proto"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for DID module.
-func QueryDID(i core.QueryClient) (string, error) {
+func QueryDID(i core.QueryClient) (*core.QueryResponse, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcDID(i)
 	} else {
@@ -23,7 +19,10 @@ func QueryDID(i core.QueryClient) (string, error) {
 
 }
 
-func queryByGrpcDID(i core.QueryClient) (string, error) {
+func queryByGrpcDID(i core.QueryClient) (*core.QueryResponse, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := didtypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -35,7 +34,7 @@ func queryByGrpcDID(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		// Get moniker by DID
@@ -46,7 +45,7 @@ func queryByGrpcDID(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		// Get DID by moniker
@@ -57,7 +56,7 @@ func queryByGrpcDID(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		// Get all DIDs
@@ -68,19 +67,14 @@ func queryByGrpcDID(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", err
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	return string(out), nil
+	return core.ProtoQueryResponse(i, res), nil
 }
 
 const (
@@ -90,7 +84,7 @@ const (
 	didAllDIDsLabel      = "all_dids"
 )
 
-func queryByLcdDID(i core.QueryClient) (string, error) {
+func queryByLcdDID(i core.QueryClient) (*core.QueryResponse, error) {
 	url := "/xpla/did/v1beta1/"
 
 	switch {
@@ -114,13 +108,13 @@ func queryByLcdDID(i core.QueryClient) (string, error) {
 		url = url + util.MakeQueryLabels(didAllDIDsLabel)
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
+	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+core.LcdPaginationURL(url, i.Ixplac.GetMsg()), nil, i.Ixplac.GetContext())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return string(out), nil
+	return core.RawQueryResponse(i, out), nil
 }
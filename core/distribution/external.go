@@ -20,11 +20,11 @@ func NewDistributionExternal(xplac provider.XplaClient) (e DistributionExternal)
 
 // Funds the community pool with the specified amount.
 func (e DistributionExternal) FundCommunityPool(fundCommunityPoolMsg types.FundCommunityPoolMsg) provider.XplaClient {
-	msg, err := MakeFundCommunityPoolMsg(fundCommunityPoolMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeFundCommunityPoolMsg(fundCommunityPoolMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DistributionModule).
+	e.Xplac = e.Xplac.WithModule(DistributionModule).
 		WithMsgType(DistributionFundCommunityPoolMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -32,11 +32,11 @@ func (e DistributionExternal) FundCommunityPool(fundCommunityPoolMsg types.FundC
 
 // Submit a community pool spend proposal.
 func (e DistributionExternal) CommunityPoolSpend(communityPoolSpendMsg types.CommunityPoolSpendMsg) provider.XplaClient {
-	msg, err := MakeProposalCommunityPoolSpendMsg(communityPoolSpendMsg, e.Xplac.GetPrivateKey(), e.Xplac.GetEncoding())
+	msg, err := MakeProposalCommunityPoolSpendMsg(communityPoolSpendMsg, e.Xplac.GetSigner(), e.Xplac.GetEncoding())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DistributionModule).
+	e.Xplac = e.Xplac.WithModule(DistributionModule).
 		WithMsgType(DistributionProposalCommunityPoolSpendMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -44,11 +44,11 @@ func (e DistributionExternal) CommunityPoolSpend(communityPoolSpendMsg types.Com
 
 // Withdraw rewards from a given delegation address, and optionally withdraw validator commission if the delegation address given is a validator operator.
 func (e DistributionExternal) WithdrawRewards(withdrawRewardsMsg types.WithdrawRewardsMsg) provider.XplaClient {
-	msg, err := MakeWithdrawRewardsMsg(withdrawRewardsMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeWithdrawRewardsMsg(withdrawRewardsMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DistributionModule).
+	e.Xplac = e.Xplac.WithModule(DistributionModule).
 		WithMsgType(DistributionWithdrawRewardsMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -56,11 +56,11 @@ func (e DistributionExternal) WithdrawRewards(withdrawRewardsMsg types.WithdrawR
 
 // Withdraw all delegations rewards for a delegator.
 func (e DistributionExternal) WithdrawAllRewards() provider.XplaClient {
-	msg, err := MakeWithdrawAllRewardsMsg(e.Xplac.GetPrivateKey(), e.Xplac.GetGrpcClient(), e.Xplac.GetContext())
+	msg, err := MakeWithdrawAllRewardsMsg(e.Xplac.GetSigner(), e.Xplac.GetGrpcClient(), e.Xplac.GetContext())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DistributionModule).
+	e.Xplac = e.Xplac.WithModule(DistributionModule).
 		WithMsgType(DistributionWithdrawAllRewardsMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -68,11 +68,11 @@ func (e DistributionExternal) WithdrawAllRewards() provider.XplaClient {
 
 // Change the default withdraw address for rewards associated with an address.
 func (e DistributionExternal) SetWithdrawAddr(setWithdrawAddrMsg types.SetWithdrawAddrMsg) provider.XplaClient {
-	msg, err := MakeSetWithdrawAddrMsg(setWithdrawAddrMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeSetWithdrawAddrMsg(setWithdrawAddrMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DistributionModule).
+	e.Xplac = e.Xplac.WithModule(DistributionModule).
 		WithMsgType(DistributionSetWithdrawAddrMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -86,7 +86,7 @@ func (e DistributionExternal) DistributionParams() provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DistributionModule).
+	e.Xplac = e.Xplac.WithModule(DistributionModule).
 		WithMsgType(DistributionQueryDistributionParamsMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -98,7 +98,7 @@ func (e DistributionExternal) ValidatorOutstandingRewards(validatorOutstandingRe
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DistributionModule).
+	e.Xplac = e.Xplac.WithModule(DistributionModule).
 		WithMsgType(DistributionValidatorOutstandingRewardsMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -110,7 +110,7 @@ func (e DistributionExternal) DistCommission(queryDistCommissionMsg types.QueryD
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DistributionModule).
+	e.Xplac = e.Xplac.WithModule(DistributionModule).
 		WithMsgType(DistributionQueryDistCommissionMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -118,11 +118,11 @@ func (e DistributionExternal) DistCommission(queryDistCommissionMsg types.QueryD
 
 // Query distribution validator slashes.
 func (e DistributionExternal) DistSlashes(queryDistSlashesMsg types.QueryDistSlashesMsg) provider.XplaClient {
-	msg, err := MakeQueryDistSlashesMsg(queryDistSlashesMsg)
+	msg, err := MakeQueryDistSlashesMsg(queryDistSlashesMsg, e.Xplac.GetPagination())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DistributionModule).
+	e.Xplac = e.Xplac.WithModule(DistributionModule).
 		WithMsgType(DistributionQuerySlashesMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -139,7 +139,7 @@ func (e DistributionExternal) DistRewards(queryDistRewardsMsg types.QueryDistRew
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(DistributionModule).
+		e.Xplac = e.Xplac.WithModule(DistributionModule).
 			WithMsgType(DistributionQueryRewardsMsgType).
 			WithMsg(msg)
 	} else {
@@ -147,7 +147,7 @@ func (e DistributionExternal) DistRewards(queryDistRewardsMsg types.QueryDistRew
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(DistributionModule).
+		e.Xplac = e.Xplac.WithModule(DistributionModule).
 			WithMsgType(DistributionQueryTotalRewardsMsgType).
 			WithMsg(msg)
 	}
@@ -160,7 +160,7 @@ func (e DistributionExternal) CommunityPool() provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(DistributionModule).
+	e.Xplac = e.Xplac.WithModule(DistributionModule).
 		WithMsgType(DistributionQueryCommunityPoolMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -14,14 +14,14 @@ func (s *IntegrationTestSuite) TestDistributionTx() {
 	r := rand.New(src)
 	accounts := testutil.RandomAccounts(r, 2)
 
-	s.xplac.WithPrivateKey(accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(accounts[0].PrivKey)
 	// fund community pool
 	fundCommunityPoolMsg := types.FundCommunityPoolMsg{
 		Amount: "1000",
 	}
-	s.xplac.FundCommunityPool(fundCommunityPoolMsg)
+	s.xplac = s.xplac.FundCommunityPool(fundCommunityPoolMsg)
 
-	makeFundCommunityPoolMsg, err := mdist.MakeFundCommunityPoolMsg(fundCommunityPoolMsg, s.xplac.GetPrivateKey())
+	makeFundCommunityPoolMsg, err := mdist.MakeFundCommunityPoolMsg(fundCommunityPoolMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeFundCommunityPoolMsg, s.xplac.GetMsg())
@@ -43,9 +43,9 @@ func (s *IntegrationTestSuite) TestDistributionTx() {
 		Amount:      "1000",
 		Deposit:     "1000",
 	}
-	s.xplac.CommunityPoolSpend(communityPoolSpendMsg)
+	s.xplac = s.xplac.CommunityPoolSpend(communityPoolSpendMsg)
 
-	makeProposalCommunityPoolSpendMsg, err := mdist.MakeProposalCommunityPoolSpendMsg(communityPoolSpendMsg, s.xplac.GetPrivateKey(), s.xplac.GetEncoding())
+	makeProposalCommunityPoolSpendMsg, err := mdist.MakeProposalCommunityPoolSpendMsg(communityPoolSpendMsg, s.xplac.GetSigner(), s.xplac.GetEncoding())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeProposalCommunityPoolSpendMsg, s.xplac.GetMsg())
@@ -65,9 +65,9 @@ func (s *IntegrationTestSuite) TestDistributionTx() {
 		ValidatorAddr: sdk.ValAddress(accounts[0].Address).String(),
 		Commission:    true,
 	}
-	s.xplac.WithdrawRewards(withdrawRewardsMsg)
+	s.xplac = s.xplac.WithdrawRewards(withdrawRewardsMsg)
 
-	makeWithdrawRewardsMsg, err := mdist.MakeWithdrawRewardsMsg(withdrawRewardsMsg, s.xplac.GetPrivateKey())
+	makeWithdrawRewardsMsg, err := mdist.MakeWithdrawRewardsMsg(withdrawRewardsMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeWithdrawRewardsMsg, s.xplac.GetMsg())
@@ -85,9 +85,9 @@ func (s *IntegrationTestSuite) TestDistributionTx() {
 	setWithdrawAddrMsg := types.SetWithdrawAddrMsg{
 		WithdrawAddr: accounts[0].Address.String(),
 	}
-	s.xplac.SetWithdrawAddr(setWithdrawAddrMsg)
+	s.xplac = s.xplac.SetWithdrawAddr(setWithdrawAddrMsg)
 
-	makeSetWithdrawAddrMsg, err := mdist.MakeSetWithdrawAddrMsg(setWithdrawAddrMsg, s.xplac.GetPrivateKey())
+	makeSetWithdrawAddrMsg, err := mdist.MakeSetWithdrawAddrMsg(setWithdrawAddrMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeSetWithdrawAddrMsg, s.xplac.GetMsg())
@@ -109,7 +109,7 @@ func (s *IntegrationTestSuite) TestDistribution() {
 
 	val := s.network.Validators[0].ValAddress.String()
 	// query dist params
-	s.xplac.DistributionParams()
+	s.xplac = s.xplac.DistributionParams()
 
 	makeQueryDistributionParamsMsg, err := mdist.MakeQueryDistributionParamsMsg()
 	s.Require().NoError(err)
@@ -122,7 +122,7 @@ func (s *IntegrationTestSuite) TestDistribution() {
 	validatorOutstandingRewardsMsg := types.ValidatorOutstandingRewardsMsg{
 		ValidatorAddr: val,
 	}
-	s.xplac.ValidatorOutstandingRewards(validatorOutstandingRewardsMsg)
+	s.xplac = s.xplac.ValidatorOutstandingRewards(validatorOutstandingRewardsMsg)
 
 	makeValidatorOutstandingRewardsMsg, err := mdist.MakeValidatorOutstandingRewardsMsg(validatorOutstandingRewardsMsg)
 	s.Require().NoError(err)
@@ -135,7 +135,7 @@ func (s *IntegrationTestSuite) TestDistribution() {
 	queryDistCommissionMsg := types.QueryDistCommissionMsg{
 		ValidatorAddr: val,
 	}
-	s.xplac.DistCommission(queryDistCommissionMsg)
+	s.xplac = s.xplac.DistCommission(queryDistCommissionMsg)
 
 	makeQueryDistCommissionMsg, err := mdist.MakeQueryDistCommissionMsg(queryDistCommissionMsg)
 	s.Require().NoError(err)
@@ -148,9 +148,9 @@ func (s *IntegrationTestSuite) TestDistribution() {
 	queryDistSlashesMsg := types.QueryDistSlashesMsg{
 		ValidatorAddr: val,
 	}
-	s.xplac.DistSlashes(queryDistSlashesMsg)
+	s.xplac = s.xplac.DistSlashes(queryDistSlashesMsg)
 
-	makeQueryDistSlashesMsg, err := mdist.MakeQueryDistSlashesMsg(queryDistSlashesMsg)
+	makeQueryDistSlashesMsg, err := mdist.MakeQueryDistSlashesMsg(queryDistSlashesMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryDistSlashesMsg, s.xplac.GetMsg())
@@ -162,7 +162,7 @@ func (s *IntegrationTestSuite) TestDistribution() {
 		DelegatorAddr: accounts[0].Address.String(),
 		ValidatorAddr: val,
 	}
-	s.xplac.DistRewards(queryDistRewardsMsg)
+	s.xplac = s.xplac.DistRewards(queryDistRewardsMsg)
 
 	makeQueryDistRewwradsMsg, err := mdist.MakeQueryDistRewardsMsg(queryDistRewardsMsg)
 	s.Require().NoError(err)
@@ -175,7 +175,7 @@ func (s *IntegrationTestSuite) TestDistribution() {
 	queryDistRewardsMsg = types.QueryDistRewardsMsg{
 		DelegatorAddr: accounts[0].Address.String(),
 	}
-	s.xplac.DistRewards(queryDistRewardsMsg)
+	s.xplac = s.xplac.DistRewards(queryDistRewardsMsg)
 
 	makeQueryDistTotalRewardsMsg, err := mdist.MakeQueryDistTotalRewardsMsg(queryDistRewardsMsg)
 	s.Require().NoError(err)
@@ -185,7 +185,7 @@ func (s *IntegrationTestSuite) TestDistribution() {
 	s.Require().Equal(mdist.DistributionQueryTotalRewardsMsgType, s.xplac.GetMsgType())
 
 	// community pool
-	s.xplac.CommunityPool()
+	s.xplac = s.xplac.CommunityPool()
 
 	makeQueryCommunityPoolMsg, err := mdist.MakeQueryCommunityPoolMsg()
 	s.Require().NoError(err)
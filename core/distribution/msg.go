@@ -1,40 +1,40 @@
 package distribution
 
 import (
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"golang.org/x/net/context"
 
 	"github.com/Moonyongjung/xpla-private-chain/app/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/gogo/protobuf/grpc"
 )
 
 // (Tx) make msg - fund community pool
-func MakeFundCommunityPoolMsg(fundCommunityPoolMsg types.FundCommunityPoolMsg, privKey key.PrivateKey) (disttypes.MsgFundCommunityPool, error) {
-	return parseFundCommunityPoolArgs(fundCommunityPoolMsg, privKey)
+func MakeFundCommunityPoolMsg(fundCommunityPoolMsg types.FundCommunityPoolMsg, signer types.Signer) (disttypes.MsgFundCommunityPool, error) {
+	return parseFundCommunityPoolArgs(fundCommunityPoolMsg, signer)
 }
 
 // (Tx) make msg - proposal community pool
-func MakeProposalCommunityPoolSpendMsg(communityPoolSpendMsg types.CommunityPoolSpendMsg, privKey key.PrivateKey, encodingConfig params.EncodingConfig) (govtypes.MsgSubmitProposal, error) {
-	return parseProposalCommunityPoolSpendArgs(communityPoolSpendMsg, privKey, encodingConfig)
+func MakeProposalCommunityPoolSpendMsg(communityPoolSpendMsg types.CommunityPoolSpendMsg, signer types.Signer, encodingConfig params.EncodingConfig) (govtypes.MsgSubmitProposal, error) {
+	return parseProposalCommunityPoolSpendArgs(communityPoolSpendMsg, signer, encodingConfig)
 }
 
 // (Tx) make msg - withdraw rewards
-func MakeWithdrawRewardsMsg(withdrawRewardsMsg types.WithdrawRewardsMsg, privKey key.PrivateKey) ([]sdk.Msg, error) {
-	return parseWithdrawRewardsArgs(withdrawRewardsMsg, privKey)
+func MakeWithdrawRewardsMsg(withdrawRewardsMsg types.WithdrawRewardsMsg, signer types.Signer) ([]sdk.Msg, error) {
+	return parseWithdrawRewardsArgs(withdrawRewardsMsg, signer)
 }
 
 // (Tx) make msg - withdraw all rewards
-func MakeWithdrawAllRewardsMsg(privKey key.PrivateKey, grpcConn grpc.ClientConn, ctx context.Context) ([]sdk.Msg, error) {
-	return parseWithdrawAllRewardsArgs(privKey, grpcConn, ctx)
+func MakeWithdrawAllRewardsMsg(signer types.Signer, grpcConn grpc.ClientConn, ctx context.Context) ([]sdk.Msg, error) {
+	return parseWithdrawAllRewardsArgs(signer, grpcConn, ctx)
 }
 
 // (Tx) make msg - withdraw address
-func MakeSetWithdrawAddrMsg(setWithdrawAddrMsg types.SetWithdrawAddrMsg, privKey key.PrivateKey) (disttypes.MsgSetWithdrawAddress, error) {
-	return parseSetWithdrawAddrArgs(setWithdrawAddrMsg, privKey)
+func MakeSetWithdrawAddrMsg(setWithdrawAddrMsg types.SetWithdrawAddrMsg, signer types.Signer) (disttypes.MsgSetWithdrawAddress, error) {
+	return parseSetWithdrawAddrArgs(setWithdrawAddrMsg, signer)
 }
 
 // (Query) make msg - distribution params
@@ -53,8 +53,8 @@ func MakeQueryDistCommissionMsg(queryDistCommissionMsg types.QueryDistCommission
 }
 
 // (Query) make msg - distribution slashes
-func MakeQueryDistSlashesMsg(queryDistSlashesMsg types.QueryDistSlashesMsg) (disttypes.QueryValidatorSlashesRequest, error) {
-	return parseDistSlashesArgs(queryDistSlashesMsg)
+func MakeQueryDistSlashesMsg(queryDistSlashesMsg types.QueryDistSlashesMsg, pageReq *query.PageRequest) (disttypes.QueryValidatorSlashesRequest, error) {
+	return parseDistSlashesArgs(queryDistSlashesMsg, pageReq)
 }
 
 // (Query) make msg - distribution rewards
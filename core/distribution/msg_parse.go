@@ -3,14 +3,13 @@ package distribution
 import (
 	"context"
 
-	"github.com/Moonyongjung/xpriv.go/core"
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
 
 	"github.com/Moonyongjung/xpla-private-chain/app/params"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	distcli "github.com/cosmos/cosmos-sdk/x/distribution/client/cli"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -18,10 +17,10 @@ import (
 )
 
 // Parsing - fund community pool
-func parseFundCommunityPoolArgs(fundCommunityPoolMsg types.FundCommunityPoolMsg, privKey key.PrivateKey) (disttypes.MsgFundCommunityPool, error) {
-	depositorAddr, err := util.GetAddrByPrivKey(privKey)
+func parseFundCommunityPoolArgs(fundCommunityPoolMsg types.FundCommunityPoolMsg, signer types.Signer) (disttypes.MsgFundCommunityPool, error) {
+	depositorAddr, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return disttypes.MsgFundCommunityPool{}, util.LogErr(errors.ErrParse, err)
+		return disttypes.MsgFundCommunityPool{}, err
 	}
 
 	amount, err := sdk.ParseCoinsNormalized(util.DenomAdd(fundCommunityPoolMsg.Amount))
@@ -34,7 +33,7 @@ func parseFundCommunityPoolArgs(fundCommunityPoolMsg types.FundCommunityPoolMsg,
 }
 
 // Parsing - proposal community pool
-func parseProposalCommunityPoolSpendArgs(communityPoolSpendMsg types.CommunityPoolSpendMsg, privKey key.PrivateKey, encodingConfig params.EncodingConfig) (govtypes.MsgSubmitProposal, error) {
+func parseProposalCommunityPoolSpendArgs(communityPoolSpendMsg types.CommunityPoolSpendMsg, signer types.Signer, encodingConfig params.EncodingConfig) (govtypes.MsgSubmitProposal, error) {
 	var proposal disttypes.CommunityPoolSpendProposalWithDeposit
 	var err error
 
@@ -61,9 +60,9 @@ func parseProposalCommunityPoolSpendArgs(communityPoolSpendMsg types.CommunityPo
 		return govtypes.MsgSubmitProposal{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	from, err := util.GetAddrByPrivKey(privKey)
+	from, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return govtypes.MsgSubmitProposal{}, util.LogErr(errors.ErrParse, err)
+		return govtypes.MsgSubmitProposal{}, err
 	}
 	recpAddr, err := sdk.AccAddressFromBech32(proposal.Recipient)
 	if err != nil {
@@ -81,10 +80,10 @@ func parseProposalCommunityPoolSpendArgs(communityPoolSpendMsg types.CommunityPo
 }
 
 // Parsing - withdraw rewards
-func parseWithdrawRewardsArgs(withdrawRewardsMsg types.WithdrawRewardsMsg, privKey key.PrivateKey) ([]sdk.Msg, error) {
-	delAddr, err := util.GetAddrByPrivKey(privKey)
+func parseWithdrawRewardsArgs(withdrawRewardsMsg types.WithdrawRewardsMsg, signer types.Signer) ([]sdk.Msg, error) {
+	delAddr, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return nil, util.LogErr(errors.ErrParse, err)
+		return nil, err
 	}
 
 	valAddr, err := sdk.ValAddressFromBech32(withdrawRewardsMsg.ValidatorAddr)
@@ -107,10 +106,10 @@ func parseWithdrawRewardsArgs(withdrawRewardsMsg types.WithdrawRewardsMsg, privK
 }
 
 // Parsing - withdraw all rewards
-func parseWithdrawAllRewardsArgs(privKey key.PrivateKey, grpcConn grpc.ClientConn, ctx context.Context) ([]sdk.Msg, error) {
-	delAddr, err := util.GetAddrByPrivKey(privKey)
+func parseWithdrawAllRewardsArgs(signer types.Signer, grpcConn grpc.ClientConn, ctx context.Context) ([]sdk.Msg, error) {
+	delAddr, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return nil, util.LogErr(errors.ErrParse, err)
+		return nil, err
 	}
 	queryClient := disttypes.NewQueryClient(grpcConn)
 	delValsRes, err := queryClient.DelegatorValidators(
@@ -142,10 +141,10 @@ func parseWithdrawAllRewardsArgs(privKey key.PrivateKey, grpcConn grpc.ClientCon
 }
 
 // Parsing - set withdraw addr
-func parseSetWithdrawAddrArgs(setWithdrawAddrMsg types.SetWithdrawAddrMsg, privKey key.PrivateKey) (disttypes.MsgSetWithdrawAddress, error) {
-	delAddr, err := util.GetAddrByPrivKey(privKey)
+func parseSetWithdrawAddrArgs(setWithdrawAddrMsg types.SetWithdrawAddrMsg, signer types.Signer) (disttypes.MsgSetWithdrawAddress, error) {
+	delAddr, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return disttypes.MsgSetWithdrawAddress{}, util.LogErr(errors.ErrParse, err)
+		return disttypes.MsgSetWithdrawAddress{}, err
 	}
 	withdrawAddr, err := sdk.AccAddressFromBech32(setWithdrawAddrMsg.WithdrawAddr)
 	if err != nil {
@@ -182,7 +181,7 @@ func parseQueryDistCommissionArgs(queryDistCommissionMsg types.QueryDistCommissi
 }
 
 // Parsing - distribution slashes
-func parseDistSlashesArgs(queryDistSlashesMsg types.QueryDistSlashesMsg) (disttypes.QueryValidatorSlashesRequest, error) {
+func parseDistSlashesArgs(queryDistSlashesMsg types.QueryDistSlashesMsg, pageReq *query.PageRequest) (disttypes.QueryValidatorSlashesRequest, error) {
 	valAddr, err := sdk.ValAddressFromBech32(queryDistSlashesMsg.ValidatorAddr)
 	if err != nil {
 		return disttypes.QueryValidatorSlashesRequest{}, util.LogErr(errors.ErrParse, err)
@@ -196,8 +195,6 @@ func parseDistSlashesArgs(queryDistSlashesMsg types.QueryDistSlashesMsg) (distty
 		return disttypes.QueryValidatorSlashesRequest{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	pageReq := core.PageRequest
-
 	return disttypes.QueryValidatorSlashesRequest{
 		ValidatorAddress: valAddr.String(),
 		StartingHeight:   startHeightNumber,
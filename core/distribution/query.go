@@ -11,12 +11,8 @@ import (
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for distribution module.
-func QueryDistribution(i core.QueryClient) (string, error) {
+func QueryDistribution(i core.QueryClient) (*core.QueryResponse, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcDist(i)
 	} else {
@@ -24,7 +20,10 @@ func QueryDistribution(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcDist(i core.QueryClient) (string, error) {
+func queryByGrpcDist(i core.QueryClient) (*core.QueryResponse, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := disttypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -36,7 +35,7 @@ func queryByGrpcDist(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Distribution validator outstanding rewards
@@ -47,7 +46,7 @@ func queryByGrpcDist(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Distribution commission
@@ -58,7 +57,7 @@ func queryByGrpcDist(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Distribution slashes
@@ -69,7 +68,7 @@ func queryByGrpcDist(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Distribution rewards
@@ -80,7 +79,7 @@ func queryByGrpcDist(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Distribution total rewards
@@ -91,7 +90,7 @@ func queryByGrpcDist(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Distribution community pool
@@ -102,19 +101,14 @@ func queryByGrpcDist(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", err
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	return string(out), nil
+	return core.ProtoQueryResponse(i, res), nil
 }
 
 const (
@@ -128,7 +122,7 @@ const (
 	distCommunityPoolLabel      = "community_pool"
 )
 
-func queryByLcdDist(i core.QueryClient) (string, error) {
+func queryByLcdDist(i core.QueryClient) (*core.QueryResponse, error) {
 	url := util.MakeQueryLcdUrl(distv1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
@@ -171,13 +165,13 @@ func queryByLcdDist(i core.QueryClient) (string, error) {
 		url = url + distCommunityPoolLabel
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+url, i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
+	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+core.LcdPaginationURL(url, i.Ixplac.GetMsg()), i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return string(out), nil
+	return core.RawQueryResponse(i, out), nil
 }
@@ -75,9 +75,9 @@ func (s *IntegrationTestSuite) TearDownSuite() {
 func (s *IntegrationTestSuite) TestDistributionParams() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.DistributionParams().Query()
@@ -99,9 +99,9 @@ func (s *IntegrationTestSuite) TestValidatorOutstandingRewards() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		msg := types.ValidatorOutstandingRewardsMsg{
@@ -125,9 +125,9 @@ func (s *IntegrationTestSuite) TestDistCommission() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		queryDistCommissionMsg := types.QueryDistCommissionMsg{
@@ -151,9 +151,9 @@ func (s *IntegrationTestSuite) TestDistSlashes() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		queryDistSlashesMsg := types.QueryDistSlashesMsg{
@@ -179,9 +179,9 @@ func (s *IntegrationTestSuite) TestDistRewards() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		queryDistRewardsMsg := types.QueryDistRewardsMsg{
@@ -215,9 +215,9 @@ func (s *IntegrationTestSuite) TestDistRewards() {
 func (s *IntegrationTestSuite) TestCommunityPool() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.CommunityPool().Query()
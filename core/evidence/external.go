@@ -21,11 +21,11 @@ func NewEvidenceExternal(xplac provider.XplaClient) (e EvidenceExternal) {
 // Query for evidence by hash or for all (paginated) submitted evidence.
 func (e EvidenceExternal) QueryEvidence(queryEvidenceMsg ...types.QueryEvidenceMsg) provider.XplaClient {
 	if len(queryEvidenceMsg) == 0 {
-		msg, err := MakeQueryAllEvidenceMsg()
+		msg, err := MakeQueryAllEvidenceMsg(e.Xplac.GetPagination())
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(EvidenceModule).
+		e.Xplac = e.Xplac.WithModule(EvidenceModule).
 			WithMsgType(EvidenceQueryAllMsgType).
 			WithMsg(msg)
 	} else if len(queryEvidenceMsg) == 1 {
@@ -33,11 +33,11 @@ func (e EvidenceExternal) QueryEvidence(queryEvidenceMsg ...types.QueryEvidenceM
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(EvidenceModule).
+		e.Xplac = e.Xplac.WithModule(EvidenceModule).
 			WithMsgType(EvidenceQueryMsgType).
 			WithMsg(msg)
 	} else {
-		provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
+		e.Xplac = provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
 	}
 	return e.Xplac
 }
@@ -9,9 +9,9 @@ func (s *IntegrationTestSuite) TestEvidence() {
 	testTxHash := "B6BBBB649F19E8970EF274C0083FE945FD38AD8C524D68BB3FE3A20D72DF03C4"
 
 	// all evidence
-	s.xplac.QueryEvidence()
+	s.xplac = s.xplac.QueryEvidence()
 
-	makeQueryAllEvidenceMsg, err := mevidence.MakeQueryAllEvidenceMsg()
+	makeQueryAllEvidenceMsg, err := mevidence.MakeQueryAllEvidenceMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryAllEvidenceMsg, s.xplac.GetMsg())
@@ -22,7 +22,7 @@ func (s *IntegrationTestSuite) TestEvidence() {
 	queryEvidenceMsg := types.QueryEvidenceMsg{
 		Hash: testTxHash,
 	}
-	s.xplac.QueryEvidence(queryEvidenceMsg)
+	s.xplac = s.xplac.QueryEvidence(queryEvidenceMsg)
 
 	makeQueryEvidenceMsg, err := mevidence.MakeQueryEvidenceMsg(queryEvidenceMsg)
 	s.Require().NoError(err)
@@ -22,6 +22,6 @@ func (c *coreModule) NewTxRouter(_ cmclient.TxBuilder, _ string, _ interface{})
 	return nil, util.LogErr(errors.ErrInvalidRequest, c.Name(), "module has not tx")
 }
 
-func (c *coreModule) NewQueryRouter(q core.QueryClient) (string, error) {
+func (c *coreModule) NewQueryRouter(q core.QueryClient) (*core.QueryResponse, error) {
 	return QueryEvidence(q)
 }
@@ -1,8 +1,8 @@
 package evidence
 
 import (
-	"github.com/Moonyongjung/xpriv.go/core"
 	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
@@ -12,8 +12,8 @@ func MakeQueryEvidenceMsg(queryEvidenceMsg types.QueryEvidenceMsg) (evidencetype
 }
 
 // (Query) make msg - all evidences
-func MakeQueryAllEvidenceMsg() (evidencetypes.QueryAllEvidenceRequest, error) {
+func MakeQueryAllEvidenceMsg(pageReq *query.PageRequest) (evidencetypes.QueryAllEvidenceRequest, error) {
 	return evidencetypes.QueryAllEvidenceRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageReq,
 	}, nil
 }
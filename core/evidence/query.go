@@ -11,12 +11,8 @@ import (
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for evidence module.
-func QueryEvidence(i core.QueryClient) (string, error) {
+func QueryEvidence(i core.QueryClient) (*core.QueryResponse, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcEvidence(i)
 	} else {
@@ -24,7 +20,10 @@ func QueryEvidence(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcEvidence(i core.QueryClient) (string, error) {
+func queryByGrpcEvidence(i core.QueryClient) (*core.QueryResponse, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := evidencetypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -36,7 +35,7 @@ func queryByGrpcEvidence(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Query evidence
@@ -47,26 +46,21 @@ func queryByGrpcEvidence(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", err
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	return string(out), nil
+	return core.ProtoQueryResponse(i, res), nil
 }
 
 const (
 	evidenceEvidenceLabel = "evidence"
 )
 
-func queryByLcdEvidence(i core.QueryClient) (string, error) {
+func queryByLcdEvidence(i core.QueryClient) (*core.QueryResponse, error) {
 	url := util.MakeQueryLcdUrl(evidencev1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
@@ -81,14 +75,14 @@ func queryByLcdEvidence(i core.QueryClient) (string, error) {
 		url = url + util.MakeQueryLabels(evidenceEvidenceLabel, convertMsg.EvidenceHash.String())
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+url, i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
+	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+core.LcdPaginationURL(url, i.Ixplac.GetMsg()), i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return string(out), nil
+	return core.RawQueryResponse(i, out), nil
 
 }
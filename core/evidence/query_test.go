@@ -52,9 +52,9 @@ func (s *IntegrationTestSuite) TearDownSuite() {
 func (s IntegrationTestSuite) TestAllEvidence() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.QueryEvidence().Query()
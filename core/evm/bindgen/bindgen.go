@@ -0,0 +1,269 @@
+package bindgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Config of the binding generator.
+type Config struct {
+	// Package name of the generated file.
+	Package string
+	// Type name of the contract binding, e.g. "Store".
+	Type string
+	// ABI JSON of the contract.
+	ABI string
+	// Hex bytecode of the contract. The deploy function is generated only if it is set.
+	Bytecode string
+}
+
+// Data of the binding template.
+type tmplData struct {
+	Package     string
+	Type        string
+	ABI         string
+	Bytecode    string
+	Constructor *tmplMethod
+	Calls       []*tmplMethod
+	Transacts   []*tmplMethod
+	Events      []*tmplEvent
+}
+
+type tmplMethod struct {
+	Name      string
+	GoName    string
+	Signature string
+	Inputs    []tmplArg
+	Outputs   []tmplArg
+}
+
+type tmplEvent struct {
+	Name      string
+	GoName    string
+	Signature string
+	Anonymous bool
+	Fields    []tmplArg
+}
+
+type tmplArg struct {
+	Name   string
+	GoType string
+}
+
+// Generate the Go source of the typed contract binding.
+// Methods of the binding make evm messages of the xpla client, and decode return values and events into structs.
+func Generate(config Config) ([]byte, error) {
+	if config.Package == "" || config.Type == "" {
+		return nil, util.LogErr(errors.ErrInsufficientParams, "package and type name of the binding are required")
+	}
+	if !token.IsIdentifier(config.Type) {
+		return nil, util.LogErr(errors.ErrInvalidRequest, "invalid type name:", config.Type)
+	}
+
+	normalizedABI, err := normalizeABI(config.ABI)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(normalizedABI))
+	if err != nil {
+		return nil, util.LogErr(errors.ErrParse, "invalid ABI:", err)
+	}
+
+	data := &tmplData{
+		Package:  config.Package,
+		Type:     config.Type,
+		ABI:      normalizedABI,
+		Bytecode: strings.TrimSpace(config.Bytecode),
+	}
+
+	if data.Bytecode != "" {
+		data.Constructor = newTmplMethod(parsed.Constructor)
+	}
+
+	for _, name := range sortedKeys(parsed.Methods) {
+		method := newTmplMethod(parsed.Methods[name])
+		if parsed.Methods[name].IsConstant() {
+			data.Calls = append(data.Calls, method)
+		} else {
+			data.Transacts = append(data.Transacts, method)
+		}
+	}
+
+	for _, name := range sortedKeys(parsed.Events) {
+		event := parsed.Events[name]
+		e := &tmplEvent{
+			Name:      event.Name,
+			GoName:    abi.ToCamelCase(event.Name),
+			Signature: event.Sig,
+			Anonymous: event.Anonymous,
+		}
+		for _, input := range event.Inputs {
+			goType := goTypeOf(input.Type)
+			// Indexed dynamic values are kept as the hash of them in the topic.
+			if input.Indexed && isHashedTopic(input.Type) {
+				goType = "common.Hash"
+			}
+			e.Fields = append(e.Fields, tmplArg{Name: abi.ToCamelCase(input.Name), GoType: goType})
+		}
+		data.Events = append(data.Events, e)
+	}
+
+	var buf bytes.Buffer
+	if err := bindingTemplate.Execute(&buf, data); err != nil {
+		return nil, util.LogErr(errors.ErrParse, err)
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, util.LogErr(errors.ErrParse, "invalid generated source:", err)
+	}
+
+	return source, nil
+}
+
+// Generate the binding from the ABI JSON file and the bytecode file.
+// The bytecode file is the compiled file of Remix IDE which has "object", or the hex string.
+func GenerateFromFiles(pkg, typeName, abiPath, bytecodePath string) ([]byte, error) {
+	abiJson, err := os.ReadFile(abiPath)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrParse, err)
+	}
+
+	var bytecode string
+	if bytecodePath != "" {
+		bytecode, err = readBytecode(bytecodePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return Generate(Config{
+		Package:  pkg,
+		Type:     typeName,
+		ABI:      string(abiJson),
+		Bytecode: bytecode,
+	})
+}
+
+func readBytecode(path string) (string, error) {
+	f, err := os.ReadFile(path)
+	if err != nil {
+		return "", util.LogErr(errors.ErrParse, err)
+	}
+
+	var compiled struct {
+		Object string `json:"object"`
+	}
+	if err := json.Unmarshal(f, &compiled); err == nil && compiled.Object != "" {
+		return compiled.Object, nil
+	}
+
+	bytecode := strings.TrimSpace(string(f))
+	if len(common.FromHex(bytecode)) == 0 {
+		return "", util.LogErr(errors.ErrParse, "invalid bytecode file:", path)
+	}
+	return bytecode, nil
+}
+
+// Compact the ABI JSON, and name unnamed inputs of events in order to decode them into struct fields.
+func normalizeABI(abiJson string) (string, error) {
+	var entries []map[string]interface{}
+	if err := json.Unmarshal([]byte(abiJson), &entries); err != nil {
+		return "", util.LogErr(errors.ErrParse, "invalid ABI:", err)
+	}
+
+	for _, entry := range entries {
+		if entry["type"] != "event" {
+			continue
+		}
+		inputs, _ := entry["inputs"].([]interface{})
+		for i, input := range inputs {
+			if arg, ok := input.(map[string]interface{}); ok && arg["name"] == "" {
+				arg["name"] = fmt.Sprintf("arg%d", i)
+			}
+		}
+	}
+
+	normalized, err := json.Marshal(entries)
+	if err != nil {
+		return "", util.LogErr(errors.ErrFailedToMarshal, err)
+	}
+	return string(normalized), nil
+}
+
+func newTmplMethod(method abi.Method) *tmplMethod {
+	m := &tmplMethod{
+		Name:      method.Name,
+		GoName:    abi.ToCamelCase(method.Name),
+		Signature: method.Sig,
+	}
+
+	used := make(map[string]bool)
+	for i, input := range method.Inputs {
+		m.Inputs = append(m.Inputs, tmplArg{Name: paramName(input.Name, i, used), GoType: goTypeOf(input.Type)})
+	}
+	for i, output := range method.Outputs {
+		name := abi.ToCamelCase(output.Name)
+		if name == "" {
+			name = fmt.Sprintf("Out%d", i)
+		}
+		m.Outputs = append(m.Outputs, tmplArg{Name: name, GoType: goTypeOf(output.Type)})
+	}
+
+	return m
+}
+
+// Names which are used in generated methods.
+var reservedNames = map[string]bool{"c": true, "out": true, "err": true, "args": true}
+
+func paramName(name string, index int, used map[string]bool) string {
+	// The name is empty after it is converted if it only has underscores, e.g. "_".
+	name = abi.ToCamelCase(name)
+	if name == "" {
+		name = fmt.Sprintf("arg%d", index)
+	}
+	name = strings.ToLower(name[:1]) + name[1:]
+	for token.IsKeyword(name) || reservedNames[name] || used[name] {
+		name += "_"
+	}
+	used[name] = true
+	return name
+}
+
+// The Go type which is used by the abi package to pack and unpack the solidity type.
+func goTypeOf(t abi.Type) string {
+	return t.GetType().String()
+}
+
+func isHashedTopic(t abi.Type) bool {
+	switch t.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		return true
+	}
+	return false
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var bindingTemplate = template.Must(template.New("binding").Parse(bindingTmpl))
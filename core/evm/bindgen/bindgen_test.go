@@ -0,0 +1,121 @@
+package bindgen_test
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Moonyongjung/xpriv.go/core/evm/bindgen"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAbiPath      = "../../../util/testutil/test_files/abi.json"
+	testBytecodePath = "../../../util/testutil/test_files/bytecode.json"
+	testEventABI     = `[
+		{"type":"function","name":"info","stateMutability":"view","inputs":[],"outputs":[{"name":"name","type":"string"},{"name":"","type":"uint256"}]},
+		{"type":"function","name":"set","stateMutability":"nonpayable","inputs":[{"name":"_","type":"uint256"},{"name":"__","type":"string"}],"outputs":[]},
+		{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"","type":"string","indexed":true},{"name":"value","type":"uint256","indexed":false}]}
+	]`
+)
+
+// Type check the generated source by vetting it in the module of the temporary directory.
+// The module has the requirements of this module and requires this module by the local path,
+// so the source is checked against the current APIs of the xpla client.
+func requireTypeChecked(t *testing.T, fileName string, source []byte) {
+	root, err := filepath.Abs("../../..")
+	require.NoError(t, err)
+	goMod, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	require.NoError(t, err)
+	goSum, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	require.NoError(t, err)
+
+	lines := strings.Split(string(goMod), "\n")
+	for i, line := range lines {
+		if strings.HasPrefix(line, "module ") {
+			lines[i] = "module generated"
+		}
+		// Local replacements are relative to this module.
+		if before, path, ok := strings.Cut(line, "=> "); ok && (strings.HasPrefix(path, "./") || strings.HasPrefix(path, "../")) {
+			lines[i] = before + "=> " + filepath.Join(root, path)
+		}
+	}
+	lines = append(lines,
+		"require github.com/Moonyongjung/xpriv.go v0.0.0",
+		"replace github.com/Moonyongjung/xpriv.go => "+root,
+	)
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte(strings.Join(lines, "\n")), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "go.sum"), goSum, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, fileName), source, 0600))
+
+	cmd := exec.Command("go", "vet", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod")
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+func TestGenerateFromFiles(t *testing.T) {
+	source, err := bindgen.GenerateFromFiles("store", "Store", testAbiPath, testBytecodePath)
+	require.NoError(t, err)
+	requireTypeChecked(t, "store.go", source)
+
+	code := string(source)
+	require.Contains(t, code, "package store")
+	require.Contains(t, code, "const StoreBin")
+	require.Contains(t, code, "func NewStore(xplac provider.XplaClient, address string) (*Store, error)")
+	require.Contains(t, code, "func DeployStore(xplac provider.XplaClient, txOptions types.EvmTxOptions) provider.XplaClient")
+	require.Contains(t, code, "func (c *Store) Retrieve() (*big.Int, error)")
+	require.Contains(t, code, "func (c *Store) Store(num *big.Int) provider.XplaClient")
+
+	// without bytecode
+	source, err = bindgen.GenerateFromFiles("store", "Store", testAbiPath, "")
+	require.NoError(t, err)
+	require.NotContains(t, string(source), "DeployStore")
+	requireTypeChecked(t, "store.go", source)
+
+	// invalid files
+	_, err = bindgen.GenerateFromFiles("store", "Store", "invalid.json", "")
+	require.Error(t, err)
+	_, err = bindgen.GenerateFromFiles("store", "Store", testAbiPath, testAbiPath)
+	require.Error(t, err)
+}
+
+func TestGenerateEvents(t *testing.T) {
+	source, err := bindgen.Generate(bindgen.Config{
+		Package: "token",
+		Type:    "Token",
+		ABI:     testEventABI,
+	})
+	require.NoError(t, err)
+	requireTypeChecked(t, "token.go", source)
+
+	code := string(source)
+	require.Contains(t, code, "type TokenInfoOutput struct")
+	require.Contains(t, code, "func (c *Token) Info() (*TokenInfoOutput, error)")
+	// params which only have underscores are named by their index
+	require.Contains(t, code, "func (c *Token) Set(arg0 *big.Int, arg1 string) provider.XplaClient")
+	require.Contains(t, code, "type TokenTransfer struct")
+	require.Contains(t, code, "func (c *Token) ParseTransfer(log ethtypes.Log) (*TokenTransfer, error)")
+	// the indexed string is the hash in the topic
+	require.Regexp(t, `Arg1\s+common\.Hash`, code)
+}
+
+func TestGenerateInvalidConfig(t *testing.T) {
+	abiJson, err := os.ReadFile(testAbiPath)
+	require.NoError(t, err)
+
+	_, err = bindgen.Generate(bindgen.Config{Type: "Store", ABI: string(abiJson)})
+	require.Error(t, err)
+
+	_, err = bindgen.Generate(bindgen.Config{Package: "store", Type: "1Store", ABI: string(abiJson)})
+	require.Error(t, err)
+
+	_, err = bindgen.Generate(bindgen.Config{Package: "store", Type: "Store", ABI: "invalid"})
+	require.Error(t, err)
+}
@@ -0,0 +1,186 @@
+package bindgen
+
+// Template of the typed contract binding.
+const bindingTmpl = `// Code generated by xpriv-bindgen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"math/big"
+	"strings"
+
+	mevm "github.com/Moonyongjung/xpriv.go/core/evm"
+	"github.com/Moonyongjung/xpriv.go/provider"
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = common.Big1
+	_ = ethtypes.BloomLookup
+	_ = mevm.EvmModule
+)
+
+// ABI of the {{.Type}} contract.
+const {{.Type}}ABI = {{printf "%q" .ABI}}
+{{if .Bytecode}}
+// Bytecode of the {{.Type}} contract.
+const {{.Type}}Bin = {{printf "%q" .Bytecode}}
+{{end}}
+// Binding of the {{.Type}} contract.
+// Transaction methods return the xpla client which has the evm message, so the transaction is created by
+// CreateAndSignTx of it. Call methods query the contract and decode return values.
+type {{.Type}} struct {
+	address   string
+	xplac     provider.XplaClient
+	txOptions types.EvmTxOptions
+	abi       abi.ABI
+}
+
+// Make the binding of the {{.Type}} contract which is deployed at the address.
+func New{{.Type}}(xplac provider.XplaClient, address string) (*{{.Type}}, error) {
+	parsed, err := abi.JSON(strings.NewReader({{.Type}}ABI))
+	if err != nil {
+		return nil, util.LogErr(errors.ErrParse, err)
+	}
+
+	return &{{.Type}}{address: address, xplac: xplac, abi: parsed}, nil
+}
+
+// Get the copy of the binding which uses the xpla client.
+func (c *{{.Type}}) WithXplaClient(xplac provider.XplaClient) *{{.Type}} {
+	b := *c
+	b.xplac = xplac
+	return &b
+}
+
+// Get the copy of the binding which applies the evm tx options to transactions.
+func (c *{{.Type}}) WithTxOptions(txOptions types.EvmTxOptions) *{{.Type}} {
+	b := *c
+	b.txOptions = txOptions
+	return &b
+}
+
+// The sender is the signer of the xpla client.
+func (c *{{.Type}}) from() string {
+	if signer := c.xplac.GetSigner(); signer != nil {
+		return common.BytesToAddress(signer.Address()).Hex()
+	}
+	return common.Address{}.Hex()
+}
+
+func (c *{{.Type}}) call(method string, args ...interface{}) ([]interface{}, error) {
+	var res types.CallSolContractResponse
+	err := c.xplac.CallSolidityContract(types.CallSolContractMsg{
+		ContractAddress:      c.address,
+		ContractFuncCallName: method,
+		Args:                 args,
+		ABI:                  {{.Type}}ABI,
+		FromByteAddress:      c.from(),
+	}).QueryTyped(&res)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := c.abi.Unpack(method, res.Data)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrParse, err)
+	}
+
+	return out, nil
+}
+
+func (c *{{.Type}}) transact(method string, args ...interface{}) provider.XplaClient {
+	return c.xplac.InvokeSolidityContract(types.InvokeSolContractMsg{
+		ContractAddress:      c.address,
+		ContractFuncCallName: method,
+		Args:                 args,
+		ABI:                  {{.Type}}ABI,
+		FromByteAddress:      c.from(),
+		TxOptions:            c.txOptions,
+	})
+}
+{{with .Constructor}}
+// Make the evm message which deploys the {{$.Type}} contract.
+func Deploy{{$.Type}}(xplac provider.XplaClient, txOptions types.EvmTxOptions{{range .Inputs}}, {{.Name}} {{.GoType}}{{end}}) provider.XplaClient {
+	return xplac.DeploySolidityContract(types.DeploySolContractMsg{
+		ABI:       {{$.Type}}ABI,
+		Bytecode:  {{$.Type}}Bin,
+		Args:      []interface{}{ {{- range $i, $a := .Inputs}}{{if $i}}, {{end}}{{$a.Name}}{{end -}} },
+		TxOptions: txOptions,
+	})
+}
+{{end}}
+{{- range .Calls}}
+{{- if gt (len .Outputs) 1}}
+// Return values of {{.Signature}}.
+type {{$.Type}}{{.GoName}}Output struct {
+{{- range .Outputs}}
+	{{.Name}} {{.GoType}}
+{{- end}}
+}
+{{end}}
+// Call {{.Signature}}.
+func (c *{{$.Type}}) {{.GoName}}({{range $i, $a := .Inputs}}{{if $i}}, {{end}}{{$a.Name}} {{$a.GoType}}{{end}}) {{if eq (len .Outputs) 0}}error{{else if eq (len .Outputs) 1}}({{(index .Outputs 0).GoType}}, error){{else}}(*{{$.Type}}{{.GoName}}Output, error){{end}} {
+	{{if .Outputs}}out{{else}}_{{end}}, err := c.call("{{.Name}}"{{range .Inputs}}, {{.Name}}{{end}})
+{{- if eq (len .Outputs) 0}}
+	return err
+{{- else if eq (len .Outputs) 1}}
+	if err != nil {
+		return *new({{(index .Outputs 0).GoType}}), err
+	}
+
+	return *abi.ConvertType(out[0], new({{(index .Outputs 0).GoType}})).(*{{(index .Outputs 0).GoType}}), nil
+{{- else}}
+	if err != nil {
+		return nil, err
+	}
+
+	return &{{$.Type}}{{.GoName}}Output{
+{{- range $i, $o := .Outputs}}
+		{{$o.Name}}: *abi.ConvertType(out[{{$i}}], new({{$o.GoType}})).(*{{$o.GoType}}),
+{{- end}}
+	}, nil
+{{- end}}
+}
+{{end}}
+{{- range .Transacts}}
+// Make the evm message which invokes {{.Signature}}.
+func (c *{{$.Type}}) {{.GoName}}({{range $i, $a := .Inputs}}{{if $i}}, {{end}}{{$a.Name}} {{$a.GoType}}{{end}}) provider.XplaClient {
+	return c.transact("{{.Name}}"{{range .Inputs}}, {{.Name}}{{end}})
+}
+{{end}}
+{{- range .Events}}
+// Event {{.Signature}}.
+type {{$.Type}}{{.GoName}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.GoType}}
+{{- end}}
+	Raw ethtypes.Log
+}
+
+// Decode the {{.Name}} event of the log.
+func (c *{{$.Type}}) Parse{{.GoName}}(log ethtypes.Log) (*{{$.Type}}{{.GoName}}, error) {
+	event := c.abi.Events["{{.Name}}"]
+{{- if not .Anonymous}}
+	if len(log.Topics) == 0 || log.Topics[0] != event.ID {
+		return nil, util.LogErr(errors.ErrInvalidRequest, "the log is not the {{.Name}} event")
+	}
+{{- end}}
+
+	out := new({{$.Type}}{{.GoName}})
+	if err := mevm.UnpackEventLog(event, out, log); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+
+	return out, nil
+}
+{{end}}`
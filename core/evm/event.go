@@ -0,0 +1,122 @@
+package evm
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// Unpack the log of the event into the struct whose fields are camel case names of event inputs.
+// Non-indexed inputs are unpacked from the data, and indexed inputs are parsed from topics.
+func UnpackEventLog(event abi.Event, out interface{}, log ethtypes.Log) error {
+	values, err := event.Inputs.Unpack(log.Data)
+	if err != nil {
+		return util.LogErr(errors.ErrParse, err)
+	}
+	if err := event.Inputs.Copy(out, values); err != nil {
+		return util.LogErr(errors.ErrParse, err)
+	}
+
+	topics := log.Topics
+	if !event.Anonymous {
+		if len(topics) == 0 {
+			return util.LogErr(errors.ErrInvalidRequest, "no topic of the event", event.Name)
+		}
+		topics = topics[1:]
+	}
+
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, topics); err != nil {
+		return util.LogErr(errors.ErrParse, err)
+	}
+
+	return nil
+}
+
+// Decode the log by the event of the contract ABI which is matched with the first topic of the log.
+// Non-indexed inputs are unpacked from the data, and indexed inputs are parsed from topics.
+// Anonymous events are not decoded because they have no topic of the event ID.
+func DecodeEventLog(contractAbi abi.ABI, log ethtypes.Log) (types.EvmEventLog, error) {
+	if len(log.Topics) == 0 {
+		return types.EvmEventLog{}, util.LogErr(errors.ErrInvalidRequest, "no topic of the log")
+	}
+
+	event, err := contractAbi.EventByID(log.Topics[0])
+	if err != nil {
+		return types.EvmEventLog{}, util.LogErr(errors.ErrNotFound, err)
+	}
+
+	var indexed, nonIndexed abi.Arguments
+	for i, input := range event.Inputs {
+		if input.Name == "" {
+			input.Name = fmt.Sprintf("arg%d", i)
+		}
+		if input.Indexed {
+			indexed = append(indexed, input)
+		} else {
+			nonIndexed = append(nonIndexed, input)
+		}
+	}
+
+	fields := make(map[string]interface{})
+	if err := nonIndexed.UnpackIntoMap(fields, log.Data); err != nil {
+		return types.EvmEventLog{}, util.LogErr(errors.ErrParse, err)
+	}
+	if err := abi.ParseTopicsIntoMap(fields, indexed, log.Topics[1:]); err != nil {
+		return types.EvmEventLog{}, util.LogErr(errors.ErrParse, err)
+	}
+
+	return types.EvmEventLog{
+		Name:      event.Name,
+		Signature: event.Sig,
+		Fields:    fields,
+		Log:       log,
+	}, nil
+}
+
+// Decode logs, e.g. results of EthGetLogs, EthGetFilterLogs and EthGetFilterChanges, by the ABI JSON of the contract.
+// Logs whose first topic is not the event ID of the ABI, e.g. logs of other contracts and anonymous events,
+// are not decoded and returned as unknown logs.
+func DecodeEventLogs(abiJson string, logs []ethtypes.Log) ([]types.EvmEventLog, []ethtypes.Log, error) {
+	contractAbi, err := abi.JSON(strings.NewReader(abiJson))
+	if err != nil {
+		return nil, nil, util.LogErr(errors.ErrParse, err)
+	}
+
+	var events []types.EvmEventLog
+	var unknownLogs []ethtypes.Log
+	for _, log := range logs {
+		if !hasEventID(contractAbi, log) {
+			unknownLogs = append(unknownLogs, log)
+			continue
+		}
+
+		event, err := DecodeEventLog(contractAbi, log)
+		if err != nil {
+			return nil, nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, unknownLogs, nil
+}
+
+// Check that the first topic of the log is the event ID of the contract ABI.
+func hasEventID(contractAbi abi.ABI, log ethtypes.Log) bool {
+	if len(log.Topics) == 0 {
+		return false
+	}
+	_, err := contractAbi.EventByID(log.Topics[0])
+	return err == nil
+}
@@ -0,0 +1,77 @@
+package evm_test
+
+import (
+	"math/big"
+	"strings"
+
+	mevm "github.com/Moonyongjung/xpriv.go/core/evm"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const testEventABI = `[
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[
+		{"name":"from","type":"address","indexed":true},
+		{"name":"","type":"string","indexed":true},
+		{"name":"value","type":"uint256","indexed":false}
+	]}
+]`
+
+func (s *IntegrationTestSuite) TestDecodeEventLog() {
+	contractAbi, err := abi.JSON(strings.NewReader(testEventABI))
+	s.Require().NoError(err)
+
+	event := contractAbi.Events["Transfer"]
+	from := common.HexToAddress(testSolContractAddress)
+	memoHash := crypto.Keccak256Hash([]byte("memo"))
+
+	data, err := event.Inputs.NonIndexed().Pack(big.NewInt(1000))
+	s.Require().NoError(err)
+
+	log := ethtypes.Log{
+		Address: from,
+		Topics:  []common.Hash{event.ID, common.BytesToHash(from.Bytes()), memoHash},
+		Data:    data,
+	}
+
+	// decode into fields by names
+	decoded, err := mevm.DecodeEventLog(contractAbi, log)
+	s.Require().NoError(err)
+	s.Require().Equal("Transfer", decoded.Name)
+	s.Require().Equal("Transfer(address,string,uint256)", decoded.Signature)
+	s.Require().Equal(from, decoded.Fields["from"])
+	s.Require().Equal(memoHash, decoded.Fields["arg1"])
+	s.Require().Equal(big.NewInt(1000), decoded.Fields["value"])
+	s.Require().Equal(log, decoded.Log)
+
+	// logs of unknown events are returned without decoding
+	unknownLog := ethtypes.Log{Topics: []common.Hash{memoHash}}
+	events, unknownLogs, err := mevm.DecodeEventLogs(testEventABI, []ethtypes.Log{log, unknownLog, {}, log})
+	s.Require().NoError(err)
+	s.Require().Len(events, 2)
+	s.Require().Equal([]ethtypes.Log{unknownLog, {}}, unknownLogs)
+
+	// decode into the struct
+	var transfer struct {
+		From  common.Address
+		Arg1  common.Hash
+		Value *big.Int
+	}
+	namedAbi, err := abi.JSON(strings.NewReader(strings.Replace(testEventABI, `"name":""`, `"name":"arg1"`, 1)))
+	s.Require().NoError(err)
+	s.Require().NoError(mevm.UnpackEventLog(namedAbi.Events["Transfer"], &transfer, log))
+	s.Require().Equal(from, transfer.From)
+	s.Require().Equal(memoHash, transfer.Arg1)
+	s.Require().Equal(big.NewInt(1000), transfer.Value)
+
+	// unknown event and invalid logs
+	_, err = mevm.DecodeEventLog(contractAbi, ethtypes.Log{Topics: []common.Hash{memoHash}})
+	s.Require().Error(err)
+	_, err = mevm.DecodeEventLog(contractAbi, ethtypes.Log{})
+	s.Require().Error(err)
+	_, _, err = mevm.DecodeEventLogs("invalid", []ethtypes.Log{log})
+	s.Require().Error(err)
+}
@@ -20,11 +20,11 @@ func NewEvmExternal(xplac provider.XplaClient) (e EvmExternal) {
 
 // Send coind by using evm client.
 func (e EvmExternal) EvmSendCoin(sendCoinMsg types.SendCoinMsg) provider.XplaClient {
-	msg, err := MakeSendCoinMsg(sendCoinMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeSendCoinMsg(sendCoinMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmSendCoinMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -36,7 +36,7 @@ func (e EvmExternal) DeploySolidityContract(deploySolContractMsg types.DeploySol
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmDeploySolContractMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -48,7 +48,7 @@ func (e EvmExternal) InvokeSolidityContract(invokeSolContractMsg types.InvokeSol
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmInvokeSolContractMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -62,7 +62,7 @@ func (e EvmExternal) CallSolidityContract(callSolContractMsg types.CallSolContra
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmCallSolContractMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -74,7 +74,7 @@ func (e EvmExternal) GetTransactionByHash(getTransactionByHashMsg types.GetTrans
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmGetTransactionByHashMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -86,7 +86,7 @@ func (e EvmExternal) GetBlockByHashOrHeight(getBlockByHashHeightMsg types.GetBlo
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmGetBlockByHashHeightMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -98,7 +98,7 @@ func (e EvmExternal) AccountInfo(accountInfoMsg types.AccountInfoMsg) provider.X
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmQueryAccountInfoMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -106,7 +106,7 @@ func (e EvmExternal) AccountInfo(accountInfoMsg types.AccountInfoMsg) provider.X
 
 // Query suggested gas price.
 func (e EvmExternal) SuggestGasPrice() provider.XplaClient {
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmSuggestGasPriceMsgType).
 		WithMsg(nil)
 	return e.Xplac
@@ -114,7 +114,7 @@ func (e EvmExternal) SuggestGasPrice() provider.XplaClient {
 
 // Query chain ID of ethereum type.
 func (e EvmExternal) EthChainID() provider.XplaClient {
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmQueryChainIdMsgType).
 		WithMsg(nil)
 	return e.Xplac
@@ -122,7 +122,7 @@ func (e EvmExternal) EthChainID() provider.XplaClient {
 
 // Query latest block height(as number)
 func (e EvmExternal) EthBlockNumber() provider.XplaClient {
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmQueryCurrentBlockNumberMsgType).
 		WithMsg(nil)
 	return e.Xplac
@@ -130,7 +130,7 @@ func (e EvmExternal) EthBlockNumber() provider.XplaClient {
 
 // Query web3 client version.
 func (e EvmExternal) Web3ClientVersion() provider.XplaClient {
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmWeb3ClientVersionMsgType).
 		WithMsg(nil)
 	return e.Xplac
@@ -142,7 +142,7 @@ func (e EvmExternal) Web3Sha3(web3Sha3Msg types.Web3Sha3Msg) provider.XplaClient
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmWeb3Sha3MsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -150,7 +150,7 @@ func (e EvmExternal) Web3Sha3(web3Sha3Msg types.Web3Sha3Msg) provider.XplaClient
 
 // Query current network ID.
 func (e EvmExternal) NetVersion() provider.XplaClient {
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmNetVersionMsgType).
 		WithMsg(nil)
 	return e.Xplac
@@ -158,7 +158,7 @@ func (e EvmExternal) NetVersion() provider.XplaClient {
 
 // Query the number of peers currently connected to the client.
 func (e EvmExternal) NetPeerCount() provider.XplaClient {
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmNetPeerCountMsgType).
 		WithMsg(nil)
 	return e.Xplac
@@ -166,7 +166,7 @@ func (e EvmExternal) NetPeerCount() provider.XplaClient {
 
 // actively listening for network connections.
 func (e EvmExternal) NetListening() provider.XplaClient {
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmNetListeningMsgType).
 		WithMsg(nil)
 	return e.Xplac
@@ -174,7 +174,7 @@ func (e EvmExternal) NetListening() provider.XplaClient {
 
 // ethereum protocol version.
 func (e EvmExternal) EthProtocolVersion() provider.XplaClient {
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmEthProtocolVersionMsgType).
 		WithMsg(nil)
 	return e.Xplac
@@ -182,7 +182,7 @@ func (e EvmExternal) EthProtocolVersion() provider.XplaClient {
 
 // Query the sync status object depending on the details of tendermint's sync protocol.
 func (e EvmExternal) EthSyncing() provider.XplaClient {
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmEthSyncingMsgType).
 		WithMsg(nil)
 	return e.Xplac
@@ -190,7 +190,7 @@ func (e EvmExternal) EthSyncing() provider.XplaClient {
 
 // Query all eth accounts.
 func (e EvmExternal) EthAccounts() provider.XplaClient {
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmEthAccountsMsgType).
 		WithMsg(nil)
 	return e.Xplac
@@ -210,7 +210,7 @@ func (e EvmExternal) EthGetBlockTransactionCount(ethGetBlockTransactionCountMsg
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmEthGetBlockTransactionCountMsgType).
 		WithMsg(msg)
 
@@ -223,7 +223,7 @@ func (e EvmExternal) EstimateGas(invokeSolContractMsg types.InvokeSolContractMsg
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmEthEstimateGasMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -235,7 +235,7 @@ func (e EvmExternal) EthGetTransactionByBlockHashAndIndex(getTransactionByBlockH
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmGetTransactionByBlockHashAndIndexMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -247,7 +247,7 @@ func (e EvmExternal) EthGetTransactionReceipt(getTransactionReceiptMsg types.Get
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmGetTransactionReceiptMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -259,7 +259,7 @@ func (e EvmExternal) EthNewFilter(ethNewFilterMsg types.EthNewFilterMsg) provide
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmEthNewFilterMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -267,7 +267,7 @@ func (e EvmExternal) EthNewFilter(ethNewFilterMsg types.EthNewFilterMsg) provide
 
 // Query filter ID by eth new block filter.
 func (e EvmExternal) EthNewBlockFilter() provider.XplaClient {
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmEthNewBlockFilterMsgType).
 		WithMsg(nil)
 	return e.Xplac
@@ -275,7 +275,7 @@ func (e EvmExternal) EthNewBlockFilter() provider.XplaClient {
 
 // Query filter ID by eth new pending transaction filter.
 func (e EvmExternal) EthNewPendingTransactionFilter() provider.XplaClient {
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmEthNewPendingTransactionFilterMsgType).
 		WithMsg(nil)
 	return e.Xplac
@@ -287,7 +287,7 @@ func (e EvmExternal) EthUninstallFilter(ethUninstallFilterMsg types.EthUninstall
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmEthUninstallFilterMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -299,7 +299,7 @@ func (e EvmExternal) EthGetFilterChanges(ethGetFilterChangesMsg types.EthGetFilt
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmEthGetFilterChangesMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -311,7 +311,7 @@ func (e EvmExternal) EthGetFilterLogs(ethGetFilterLogsMsg types.EthGetFilterLogs
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmEthGetFilterLogsMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -323,7 +323,7 @@ func (e EvmExternal) EthGetLogs(ethGetLogsMsg types.EthGetLogsMsg) provider.Xpla
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmEthGetLogsMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -331,7 +331,7 @@ func (e EvmExternal) EthGetLogs(ethGetLogsMsg types.EthGetLogsMsg) provider.Xpla
 
 // Query coinbase.
 func (e EvmExternal) EthCoinbase() provider.XplaClient {
-	e.Xplac.WithModule(EvmModule).
+	e.Xplac = e.Xplac.WithModule(EvmModule).
 		WithMsgType(EvmEthCoinbaseMsgType).
 		WithMsg(nil)
 	return e.Xplac
@@ -13,16 +13,16 @@ var (
 )
 
 func (s *IntegrationTestSuite) TestEvmTx() {
-	s.xplac.WithPrivateKey(s.accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(s.accounts[0].PrivKey)
 	// send evm coin
 	sendCoinMsg := types.SendCoinMsg{
 		FromAddress: s.accounts[0].PubKey.Address().String(),
 		ToAddress:   s.accounts[1].PubKey.Address().String(),
 		Amount:      "1000",
 	}
-	s.xplac.EvmSendCoin(sendCoinMsg)
+	s.xplac = s.xplac.EvmSendCoin(sendCoinMsg)
 
-	makeSendCoinMsg, err := mevm.MakeSendCoinMsg(sendCoinMsg, s.xplac.GetPrivateKey())
+	makeSendCoinMsg, err := mevm.MakeSendCoinMsg(sendCoinMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeSendCoinMsg, s.xplac.GetMsg())
@@ -35,7 +35,7 @@ func (s *IntegrationTestSuite) TestEvmTx() {
 		BytecodeJsonFilePath: testBytecodePath,
 		Args:                 nil,
 	}
-	s.xplac.DeploySolidityContract(deploySolContractMsg)
+	s.xplac = s.xplac.DeploySolidityContract(deploySolContractMsg)
 
 	makeDeploySolContractMsg, err := mevm.MakeDeploySolContractMsg(deploySolContractMsg)
 	s.Require().NoError(err)
@@ -51,7 +51,7 @@ func (s *IntegrationTestSuite) TestEvmTx() {
 		BytecodeJsonFilePath: testBytecodePath,
 		Args:                 nil,
 	}
-	s.xplac.InvokeSolidityContract(invokeSolContractMsg)
+	s.xplac = s.xplac.InvokeSolidityContract(invokeSolContractMsg)
 
 	makeInvokeSolContractMsg, err := mevm.MakeInvokeSolContractMsg(invokeSolContractMsg)
 	s.Require().NoError(err)
@@ -59,6 +59,33 @@ func (s *IntegrationTestSuite) TestEvmTx() {
 	s.Require().Equal(makeInvokeSolContractMsg, s.xplac.GetMsg())
 	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
 	s.Require().Equal(mevm.EvmInvokeSolContractMsgType, s.xplac.GetMsgType())
+
+	// evm tx options
+	sendCoinMsg.TxOptions = types.EvmTxOptions{
+		TxType:               types.EvmDynamicFeeTxType,
+		MaxFeePerGas:         "2000000000",
+		MaxPriorityFeePerGas: "1000000000",
+		AccessList: []types.EvmAccessTuple{
+			{Address: testSolContractAddress, StorageKeys: []string{"0x01"}},
+		},
+	}
+	_, err = mevm.MakeSendCoinMsg(sendCoinMsg, s.xplac.GetSigner())
+	s.Require().NoError(err)
+
+	sendCoinMsg.TxOptions.TxType = types.EvmLegacyTxType
+	_, err = mevm.MakeSendCoinMsg(sendCoinMsg, s.xplac.GetSigner())
+	s.Require().Error(err)
+
+	invokeSolContractMsg.TxOptions = types.EvmTxOptions{
+		TxType:     types.EvmAccessListTxType,
+		AccessList: []types.EvmAccessTuple{{Address: "invalid"}},
+	}
+	_, err = mevm.MakeInvokeSolContractMsg(invokeSolContractMsg)
+	s.Require().Error(err)
+
+	deploySolContractMsg.TxOptions = types.EvmTxOptions{TxType: "unknown"}
+	_, err = mevm.MakeDeploySolContractMsg(deploySolContractMsg)
+	s.Require().Error(err)
 }
 
 func (s *IntegrationTestSuite) TestEvm() {
@@ -70,7 +97,7 @@ func (s *IntegrationTestSuite) TestEvm() {
 		BytecodeJsonFilePath: testBytecodePath,
 		FromByteAddress:      s.accounts[0].PubKey.Address().String(),
 	}
-	s.xplac.CallSolidityContract(callSolContractMsg)
+	s.xplac = s.xplac.CallSolidityContract(callSolContractMsg)
 
 	makeCallSolContractMsg, err := mevm.MakeCallSolContractMsg(callSolContractMsg)
 	s.Require().NoError(err)
@@ -83,7 +110,7 @@ func (s *IntegrationTestSuite) TestEvm() {
 	getTransactionByHashMsg := types.GetTransactionByHashMsg{
 		TxHash: testTxHash,
 	}
-	s.xplac.GetTransactionByHash(getTransactionByHashMsg)
+	s.xplac = s.xplac.GetTransactionByHash(getTransactionByHashMsg)
 
 	makeGetTransactionByHashMsg, err := mevm.MakeGetTransactionByHashMsg(getTransactionByHashMsg)
 	s.Require().NoError(err)
@@ -96,7 +123,7 @@ func (s *IntegrationTestSuite) TestEvm() {
 	getBlockByHashHeightMsg := types.GetBlockByHashHeightMsg{
 		BlockHeight: "1",
 	}
-	s.xplac.GetBlockByHashOrHeight(getBlockByHashHeightMsg)
+	s.xplac = s.xplac.GetBlockByHashOrHeight(getBlockByHashHeightMsg)
 
 	makeGetBlockByHashHeightMsg, err := mevm.MakeGetBlockByHashHeightMsg(getBlockByHashHeightMsg)
 	s.Require().NoError(err)
@@ -109,7 +136,7 @@ func (s *IntegrationTestSuite) TestEvm() {
 	accountInfoMsg := types.AccountInfoMsg{
 		Account: s.accounts[0].PubKey.Address().String(),
 	}
-	s.xplac.AccountInfo(accountInfoMsg)
+	s.xplac = s.xplac.AccountInfo(accountInfoMsg)
 
 	makeQueryAccountInfoMsg, err := mevm.MakeQueryAccountInfoMsg(accountInfoMsg)
 	s.Require().NoError(err)
@@ -119,28 +146,28 @@ func (s *IntegrationTestSuite) TestEvm() {
 	s.Require().Equal(mevm.EvmQueryAccountInfoMsgType, s.xplac.GetMsgType())
 
 	// suggest gas price
-	s.xplac.SuggestGasPrice()
+	s.xplac = s.xplac.SuggestGasPrice()
 
 	s.Require().Equal(nil, s.xplac.GetMsg())
 	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
 	s.Require().Equal(mevm.EvmSuggestGasPriceMsgType, s.xplac.GetMsgType())
 
 	// eth chain ID
-	s.xplac.EthChainID()
+	s.xplac = s.xplac.EthChainID()
 
 	s.Require().Equal(nil, s.xplac.GetMsg())
 	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
 	s.Require().Equal(mevm.EvmQueryChainIdMsgType, s.xplac.GetMsgType())
 
 	// eth block number
-	s.xplac.EthBlockNumber()
+	s.xplac = s.xplac.EthBlockNumber()
 
 	s.Require().Equal(nil, s.xplac.GetMsg())
 	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
 	s.Require().Equal(mevm.EvmQueryCurrentBlockNumberMsgType, s.xplac.GetMsgType())
 
 	// web3 client version
-	s.xplac.Web3ClientVersion()
+	s.xplac = s.xplac.Web3ClientVersion()
 
 	s.Require().Equal(nil, s.xplac.GetMsg())
 	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
@@ -150,7 +177,7 @@ func (s *IntegrationTestSuite) TestEvm() {
 	web3Sha3Msg := types.Web3Sha3Msg{
 		InputParam: "ABC",
 	}
-	s.xplac.Web3Sha3(web3Sha3Msg)
+	s.xplac = s.xplac.Web3Sha3(web3Sha3Msg)
 
 	makeWeb3Sha3Msg, err := mevm.MakeWeb3Sha3Msg(web3Sha3Msg)
 	s.Require().NoError(err)
@@ -160,42 +187,42 @@ func (s *IntegrationTestSuite) TestEvm() {
 	s.Require().Equal(mevm.EvmWeb3Sha3MsgType, s.xplac.GetMsgType())
 
 	// net version
-	s.xplac.NetVersion()
+	s.xplac = s.xplac.NetVersion()
 
 	s.Require().Equal(nil, s.xplac.GetMsg())
 	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
 	s.Require().Equal(mevm.EvmNetVersionMsgType, s.xplac.GetMsgType())
 
 	// net peer count
-	s.xplac.NetPeerCount()
+	s.xplac = s.xplac.NetPeerCount()
 
 	s.Require().Equal(nil, s.xplac.GetMsg())
 	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
 	s.Require().Equal(mevm.EvmNetPeerCountMsgType, s.xplac.GetMsgType())
 
 	// net listening
-	s.xplac.NetListening()
+	s.xplac = s.xplac.NetListening()
 
 	s.Require().Equal(nil, s.xplac.GetMsg())
 	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
 	s.Require().Equal(mevm.EvmNetListeningMsgType, s.xplac.GetMsgType())
 
 	// eth protocol version
-	s.xplac.EthProtocolVersion()
+	s.xplac = s.xplac.EthProtocolVersion()
 
 	s.Require().Equal(nil, s.xplac.GetMsg())
 	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
 	s.Require().Equal(mevm.EvmEthProtocolVersionMsgType, s.xplac.GetMsgType())
 
 	// eth syncing
-	s.xplac.EthSyncing()
+	s.xplac = s.xplac.EthSyncing()
 
 	s.Require().Equal(nil, s.xplac.GetMsg())
 	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
 	s.Require().Equal(mevm.EvmEthSyncingMsgType, s.xplac.GetMsgType())
 
 	// eth accounts
-	s.xplac.EthAccounts()
+	s.xplac = s.xplac.EthAccounts()
 
 	s.Require().Equal(nil, s.xplac.GetMsg())
 	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
@@ -205,7 +232,7 @@ func (s *IntegrationTestSuite) TestEvm() {
 	ethGetBlockTransactionCountMsg := types.EthGetBlockTransactionCountMsg{
 		BlockHeight: "1",
 	}
-	s.xplac.EthGetBlockTransactionCount(ethGetBlockTransactionCountMsg)
+	s.xplac = s.xplac.EthGetBlockTransactionCount(ethGetBlockTransactionCountMsg)
 
 	makeEthGetBlockTransactionCountMsg, err := mevm.MakeEthGetBlockTransactionCountMsg(ethGetBlockTransactionCountMsg)
 	s.Require().NoError(err)
@@ -221,7 +248,7 @@ func (s *IntegrationTestSuite) TestEvm() {
 		BytecodeJsonFilePath: testBytecodePath,
 		FromByteAddress:      s.accounts[0].PubKey.Address().String(),
 	}
-	s.xplac.EstimateGas(invokeSolContractMsg)
+	s.xplac = s.xplac.EstimateGas(invokeSolContractMsg)
 
 	makeEstimateGasSolMsg, err := mevm.MakeEstimateGasSolMsg(invokeSolContractMsg)
 	s.Require().NoError(err)
@@ -235,7 +262,7 @@ func (s *IntegrationTestSuite) TestEvm() {
 		BlockHash: "1",
 		Index:     "0",
 	}
-	s.xplac.EthGetTransactionByBlockHashAndIndex(getTransactionByBlockHashAndIndexMsg)
+	s.xplac = s.xplac.EthGetTransactionByBlockHashAndIndex(getTransactionByBlockHashAndIndexMsg)
 
 	makeGetTransactionByBlockHashAndIndexMsg, err := mevm.MakeGetTransactionByBlockHashAndIndexMsg(getTransactionByBlockHashAndIndexMsg)
 	s.Require().NoError(err)
@@ -248,7 +275,7 @@ func (s *IntegrationTestSuite) TestEvm() {
 	getTransactionReceiptMsg := types.GetTransactionReceiptMsg{
 		TransactionHash: testTxHash,
 	}
-	s.xplac.EthGetTransactionReceipt(getTransactionReceiptMsg)
+	s.xplac = s.xplac.EthGetTransactionReceipt(getTransactionReceiptMsg)
 
 	makeGetTransactionReceiptMsg, err := mevm.MakeGetTransactionReceiptMsg(getTransactionReceiptMsg)
 	s.Require().NoError(err)
@@ -264,7 +291,7 @@ func (s *IntegrationTestSuite) TestEvm() {
 		ToBlock:   "latest",
 		FromBlock: "earliest",
 	}
-	s.xplac.EthNewFilter(ethNewFilterMsg)
+	s.xplac = s.xplac.EthNewFilter(ethNewFilterMsg)
 
 	makeEthNewFilterMsg, err := mevm.MakeEthNewFilterMsg(ethNewFilterMsg)
 	s.Require().NoError(err)
@@ -274,14 +301,14 @@ func (s *IntegrationTestSuite) TestEvm() {
 	s.Require().Equal(mevm.EvmEthNewFilterMsgType, s.xplac.GetMsgType())
 
 	// new block filter
-	s.xplac.EthNewBlockFilter()
+	s.xplac = s.xplac.EthNewBlockFilter()
 
 	s.Require().Equal(nil, s.xplac.GetMsg())
 	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
 	s.Require().Equal(mevm.EvmEthNewBlockFilterMsgType, s.xplac.GetMsgType())
 
 	// new pending transaction filter
-	s.xplac.EthNewPendingTransactionFilter()
+	s.xplac = s.xplac.EthNewPendingTransactionFilter()
 
 	s.Require().Equal(nil, s.xplac.GetMsg())
 	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
@@ -291,7 +318,7 @@ func (s *IntegrationTestSuite) TestEvm() {
 	ethUninstallFilterMsg := types.EthUninstallFilterMsg{
 		FilterId: "0x168b9d421ecbffa1ac706926c2203454",
 	}
-	s.xplac.EthUninstallFilter(ethUninstallFilterMsg)
+	s.xplac = s.xplac.EthUninstallFilter(ethUninstallFilterMsg)
 
 	makeEthUninstallFilterMsg, err := mevm.MakeEthUninstallFilterMsg(ethUninstallFilterMsg)
 	s.Require().NoError(err)
@@ -304,7 +331,7 @@ func (s *IntegrationTestSuite) TestEvm() {
 	ethGetFilterChangesMsg := types.EthGetFilterChangesMsg{
 		FilterId: "0x168b9d421ecbffa1ac706926c2203454",
 	}
-	s.xplac.EthGetFilterChanges(ethGetFilterChangesMsg)
+	s.xplac = s.xplac.EthGetFilterChanges(ethGetFilterChangesMsg)
 
 	makeEthGetFilterChangesMsg, err := mevm.MakeEthGetFilterChangesMsg(ethGetFilterChangesMsg)
 	s.Require().NoError(err)
@@ -317,7 +344,7 @@ func (s *IntegrationTestSuite) TestEvm() {
 	ethGetFilterLogsMsg := types.EthGetFilterLogsMsg{
 		FilterId: "0x168b9d421ecbffa1ac706926c2203454",
 	}
-	s.xplac.EthGetFilterLogs(ethGetFilterLogsMsg)
+	s.xplac = s.xplac.EthGetFilterLogs(ethGetFilterLogsMsg)
 
 	makeEthGetFilterLogsMsg, err := mevm.MakeEthGetFilterLogsMsg(ethGetFilterLogsMsg)
 	s.Require().NoError(err)
@@ -333,7 +360,7 @@ func (s *IntegrationTestSuite) TestEvm() {
 		ToBlock:   "latest",
 		FromBlock: "latest",
 	}
-	s.xplac.EthGetLogs(ethGetLogsMsg)
+	s.xplac = s.xplac.EthGetLogs(ethGetLogsMsg)
 
 	makeEthGetLogsMsg, err := mevm.MakeEthGetLogsMsg(ethGetLogsMsg)
 	s.Require().NoError(err)
@@ -343,7 +370,7 @@ func (s *IntegrationTestSuite) TestEvm() {
 	s.Require().Equal(mevm.EvmEthGetLogsMsgType, s.xplac.GetMsgType())
 
 	// coinbase
-	s.xplac.EthCoinbase()
+	s.xplac = s.xplac.EthCoinbase()
 
 	s.Require().Equal(nil, s.xplac.GetMsg())
 	s.Require().Equal(mevm.EvmModule, s.xplac.GetModule())
@@ -1,13 +1,12 @@
 package evm
 
 import (
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 )
 
 // (Tx) make msg - send coin
-func MakeSendCoinMsg(sendCoinMsg types.SendCoinMsg, privKey key.PrivateKey) (types.SendCoinMsg, error) {
-	return parseSendCoinArgs(sendCoinMsg, privKey)
+func MakeSendCoinMsg(sendCoinMsg types.SendCoinMsg, signer types.Signer) (types.SendCoinMsg, error) {
+	return parseSendCoinArgs(sendCoinMsg, signer)
 }
 
 // (Tx) make msg - deploy solidity contract
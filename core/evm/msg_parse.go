@@ -1,21 +1,34 @@
 package evm
 
 import (
-	"github.com/Moonyongjung/xpriv.go/key"
+	"math"
+	"strconv"
+	"strings"
+
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
 // Parsing - send coin
-func parseSendCoinArgs(sendCoinMsg types.SendCoinMsg, privKey key.PrivateKey) (types.SendCoinMsg, error) {
+func parseSendCoinArgs(sendCoinMsg types.SendCoinMsg, signer types.Signer) (types.SendCoinMsg, error) {
+	signerAddr, err := util.GetAddrBySigner(signer)
+	if err != nil {
+		return types.SendCoinMsg{}, err
+	}
+
 	from := util.FromStringToByte20Address(sendCoinMsg.FromAddress)
-	if from != util.FromStringToByte20Address(privKey.PubKey().Address().String()) {
-		return types.SendCoinMsg{}, util.LogErr(errors.ErrInvalidRequest, "Account address generated by private key is not equal")
+	if from != common.BytesToAddress(signerAddr) {
+		return types.SendCoinMsg{}, util.LogErr(errors.ErrInvalidRequest, "Account address of the signer is not equal")
+	}
+
+	if err := validateEvmTxOptions(sendCoinMsg.TxOptions); err != nil {
+		return types.SendCoinMsg{}, err
 	}
 
 	sendCoinMsg.Amount = util.DenomRemove(sendCoinMsg.Amount)
@@ -41,15 +54,20 @@ func parseDeploySolContractArgs(deploySolContractMsg types.DeploySolContractMsg)
 		}
 	}
 
-	if deploySolContractMsg.Args == nil || len(deploySolContractMsg.Args) == 0 {
-		Args = nil
-	} else {
-		Args = deploySolContractMsg.Args
+	if err := validateEvmTxOptions(deploySolContractMsg.TxOptions); err != nil {
+		return ContractInfo{}, err
+	}
+
+	var args []interface{}
+	if len(deploySolContractMsg.Args) != 0 {
+		args = deploySolContractMsg.Args
 	}
 
 	return ContractInfo{
-		Abi:      abi,
-		Bytecode: bytecode,
+		Abi:       abi,
+		Bytecode:  bytecode,
+		Args:      args,
+		TxOptions: deploySolContractMsg.TxOptions,
 	}, nil
 }
 
@@ -69,10 +87,12 @@ func parseInvokeSolContractArgs(invokeSolContractMsg types.InvokeSolContractMsg)
 			return types.InvokeSolContractMsg{}, util.LogErr(errors.ErrParse, err)
 		}
 	}
-	if invokeSolContractMsg.Args == nil || len(invokeSolContractMsg.Args) == 0 {
-		Args = nil
-	} else {
-		Args = invokeSolContractMsg.Args
+	if len(invokeSolContractMsg.Args) == 0 {
+		invokeSolContractMsg.Args = nil
+	}
+
+	if err := validateEvmTxOptions(invokeSolContractMsg.TxOptions); err != nil {
+		return types.InvokeSolContractMsg{}, err
 	}
 
 	invokeSolContractMsg.ContractAddress = util.FromStringToTypeHexString(invokeSolContractMsg.ContractAddress)
@@ -80,6 +100,38 @@ func parseInvokeSolContractArgs(invokeSolContractMsg types.InvokeSolContractMsg)
 	return invokeSolContractMsg, nil
 }
 
+// Validate options of the evm transaction.
+func validateEvmTxOptions(txOptions types.EvmTxOptions) error {
+	switch txOptions.TxType {
+	case "", types.EvmLegacyTxType:
+		if txOptions.MaxFeePerGas != "" || txOptions.MaxPriorityFeePerGas != "" || len(txOptions.AccessList) != 0 {
+			return util.LogErr(errors.ErrInvalidRequest, "fee caps and access list are not used by the legacy evm tx")
+		}
+		return nil
+
+	case types.EvmAccessListTxType:
+		if txOptions.MaxFeePerGas != "" || txOptions.MaxPriorityFeePerGas != "" {
+			return util.LogErr(errors.ErrInvalidRequest, "fee caps are not used by the access list evm tx")
+		}
+
+	case types.EvmDynamicFeeTxType:
+		for _, fee := range []string{txOptions.MaxFeePerGas, txOptions.MaxPriorityFeePerGas} {
+			if fee == "" {
+				continue
+			}
+			if _, err := util.FromStringToBigInt(util.DenomRemove(fee)); err != nil {
+				return err
+			}
+		}
+
+	default:
+		return util.LogErr(errors.ErrInvalidRequest, "invalid evm tx type, must be "+types.EvmLegacyTxType+", "+types.EvmAccessListTxType+" or "+types.EvmDynamicFeeTxType)
+	}
+
+	_, err := util.FromEvmAccessTuplesToAccessList(txOptions.AccessList)
+	return err
+}
+
 // Parsing - call solidity contract
 func parseCallSolContractArgs(callSolContractMsg types.CallSolContractMsg) (CallSolContractParseMsg, error) {
 	var err error
@@ -99,12 +151,11 @@ func parseCallSolContractArgs(callSolContractMsg types.CallSolContractMsg) (Call
 		}
 	}
 
-	if callSolContractMsg.Args == nil || len(callSolContractMsg.Args) == 0 {
-		Args = nil
-	} else {
-		Args = callSolContractMsg.Args
+	var args []interface{}
+	if len(callSolContractMsg.Args) != 0 {
+		args = callSolContractMsg.Args
 	}
-	callByteData, err := util.GetAbiPack(callSolContractMsg.ContractFuncCallName, abi, bytecode, Args...)
+	callByteData, err := util.GetAbiPack(callSolContractMsg.ContractFuncCallName, abi, bytecode, args...)
 	if err != nil {
 		return CallSolContractParseMsg{}, util.LogErr(errors.ErrParse, err)
 	}
@@ -204,38 +255,18 @@ func parseEstimateGasSolArgs(invokeSolContractMsg types.InvokeSolContractMsg) (C
 
 // Parsing - get transaction receipt
 func parseEthNewFilterArgs(ethNewFilterMsg types.EthNewFilterMsg) (EthNewFilterParseMsg, error) {
-	var fromBlock rpc.BlockNumber
-	var toBlock rpc.BlockNumber
 	var addresses []common.Address
 	var topicsHash []common.Hash
 	var topics []interface{}
 
-	if ethNewFilterMsg.FromBlock == "latest" ||
-		ethNewFilterMsg.FromBlock == "" {
-		fromBlock = rpc.LatestBlockNumber
-
-	} else if ethNewFilterMsg.FromBlock == "earliest" {
-		fromBlock = rpc.EarliestBlockNumber
-
-	} else if ethNewFilterMsg.FromBlock == "pending" {
-		fromBlock = rpc.PendingBlockNumber
-
-	} else {
-		return EthNewFilterParseMsg{}, util.LogErr(errors.ErrInvalidMsgType, "invalid from/to block type, (latest/earliest/pending)")
+	fromBlock, err := parseFilterBlockNumber(ethNewFilterMsg.FromBlock)
+	if err != nil {
+		return EthNewFilterParseMsg{}, err
 	}
 
-	if ethNewFilterMsg.ToBlock == "latest" ||
-		ethNewFilterMsg.ToBlock == "" {
-		toBlock = rpc.LatestBlockNumber
-
-	} else if ethNewFilterMsg.ToBlock == "earliest" {
-		toBlock = rpc.EarliestBlockNumber
-
-	} else if ethNewFilterMsg.ToBlock == "pending" {
-		toBlock = rpc.PendingBlockNumber
-
-	} else {
-		return EthNewFilterParseMsg{}, util.LogErr(errors.ErrInvalidMsgType, "invalid from/to block type, (latest/earliest/pending)")
+	toBlock, err := parseFilterBlockNumber(ethNewFilterMsg.ToBlock)
+	if err != nil {
+		return EthNewFilterParseMsg{}, err
 	}
 
 	if len(ethNewFilterMsg.Address) != 0 {
@@ -270,8 +301,6 @@ func parseEthNewFilterArgs(ethNewFilterMsg types.EthNewFilterMsg) (EthNewFilterP
 // Parsing - get logs
 func parseEthGetLogsArgs(ethGetLogsMsg types.EthGetLogsMsg) (EthNewFilterParseMsg, error) {
 	var blockHash common.Hash
-	var fromBlock rpc.BlockNumber
-	var toBlock rpc.BlockNumber
 	var addresses []common.Address
 	var topicsHash []common.Hash
 	var topics []interface{}
@@ -281,32 +310,14 @@ func parseEthGetLogsArgs(ethGetLogsMsg types.EthGetLogsMsg) (EthNewFilterParseMs
 		return EthNewFilterParseMsg{}, util.LogErr(errors.ErrInvalidRequest, "cannot specify both BlockHash and FromBlock/ToBlock, choose one or the other")
 	}
 
-	if ethGetLogsMsg.FromBlock == "latest" ||
-		ethGetLogsMsg.FromBlock == "" {
-		fromBlock = rpc.LatestBlockNumber
-
-	} else if ethGetLogsMsg.FromBlock == "earliest" {
-		fromBlock = rpc.EarliestBlockNumber
-
-	} else if ethGetLogsMsg.FromBlock == "pending" {
-		fromBlock = rpc.PendingBlockNumber
-
-	} else {
-		return EthNewFilterParseMsg{}, util.LogErr(errors.ErrInvalidMsgType, "invalid from/to block type, (latest/earliest/pending)")
+	fromBlock, err := parseFilterBlockNumber(ethGetLogsMsg.FromBlock)
+	if err != nil {
+		return EthNewFilterParseMsg{}, err
 	}
 
-	if ethGetLogsMsg.ToBlock == "latest" ||
-		ethGetLogsMsg.ToBlock == "" {
-		toBlock = rpc.LatestBlockNumber
-
-	} else if ethGetLogsMsg.ToBlock == "earliest" {
-		toBlock = rpc.EarliestBlockNumber
-
-	} else if ethGetLogsMsg.ToBlock == "pending" {
-		toBlock = rpc.PendingBlockNumber
-
-	} else {
-		return EthNewFilterParseMsg{}, util.LogErr(errors.ErrInvalidMsgType, "invalid from/to block type, (latest/earliest/pending)")
+	toBlock, err := parseFilterBlockNumber(ethGetLogsMsg.ToBlock)
+	if err != nil {
+		return EthNewFilterParseMsg{}, err
 	}
 
 	if len(ethGetLogsMsg.Address) != 0 {
@@ -352,3 +363,28 @@ func parseEthGetLogsArgs(ethGetLogsMsg types.EthGetLogsMsg) (EthNewFilterParseMs
 
 	return varInput, nil
 }
+
+// Parse the block of the filter, which is the block tag (latest/earliest/pending) or the block number.
+// The block number is decimal or hex which has the prefix "0x".
+func parseFilterBlockNumber(block string) (rpc.BlockNumber, error) {
+	switch block {
+	case "latest", "":
+		return rpc.LatestBlockNumber, nil
+	case "earliest":
+		return rpc.EarliestBlockNumber, nil
+	case "pending":
+		return rpc.PendingBlockNumber, nil
+	}
+
+	var number uint64
+	var err error
+	if strings.HasPrefix(block, "0x") {
+		number, err = hexutil.DecodeUint64(block)
+	} else {
+		number, err = strconv.ParseUint(block, 10, 64)
+	}
+	if err != nil || number > math.MaxInt64 {
+		return 0, util.LogErr(errors.ErrInvalidMsgType, "invalid from/to block type, (latest/earliest/pending or block number)")
+	}
+	return rpc.BlockNumber(number), nil
+}
@@ -1,7 +1,7 @@
 package evm
 
 import (
-	"math/big"
+	"github.com/Moonyongjung/xpriv.go/types"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
@@ -58,18 +58,8 @@ type EthNewFilterParseMsg struct {
 }
 
 type ContractInfo struct {
-	Abi      string
-	Bytecode string
-}
-
-type DeploySolTx struct {
-	ChainId  *big.Int
-	Nonce    *big.Int
-	Value    *big.Int
-	GasLimit uint64
-	GasPrice *big.Int
-	ABI      string
-	Bytecode string
+	Abi       string
+	Bytecode  string
+	Args      []interface{}
+	TxOptions types.EvmTxOptions
 }
-
-var Args []interface{}
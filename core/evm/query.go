@@ -1,6 +1,7 @@
 package evm
 
 import (
+	"encoding/json"
 	"math/big"
 
 	"github.com/Moonyongjung/xpriv.go/core"
@@ -12,10 +13,10 @@ import (
 )
 
 // Query client for evm module.
-func QueryEvm(i core.QueryClient) (string, error) {
+func QueryEvm(i core.QueryClient) (*core.QueryResponse, error) {
 	evmClient, err := util.NewEvmClient(i.Ixplac.GetEvmRpc(), i.Ixplac.GetContext())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	gasAdj := i.Ixplac.GetGasAdjustment()
@@ -27,11 +28,11 @@ func QueryEvm(i core.QueryClient) (string, error) {
 	if i.Ixplac.GetGasLimit() == "" {
 		gasLimitU64, err := util.FromStringToUint64(util.DefaultEvmGasLimit)
 		if err != nil {
-			return "", util.LogErr(errors.ErrParse, err)
+			return nil, util.LogErr(errors.ErrParse, err)
 		}
 		gasLimitAdjustment, err := util.GasLimitAdjustment(gasLimitU64, gasAdj)
 		if err != nil {
-			return "", util.LogErr(errors.ErrParse, err)
+			return nil, util.LogErr(errors.ErrParse, err)
 		}
 		gasLimit = gasLimitAdjustment
 	}
@@ -43,7 +44,7 @@ func QueryEvm(i core.QueryClient) (string, error) {
 
 	gasPriceBigInt, err := util.FromStringToBigInt(gasPrice)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	switch {
@@ -53,27 +54,28 @@ func QueryEvm(i core.QueryClient) (string, error) {
 
 		gasLimitU64, err := util.FromStringToUint64(gasLimit)
 		if err != nil {
-			return "", util.LogErr(errors.ErrParse, err)
+			return nil, util.LogErr(errors.ErrParse, err)
 		}
 		convertMsg.CallMsg.Gas = gasLimitU64
 		convertMsg.CallMsg.GasPrice = gasPriceBigInt
 
 		res, err := evmClient.Client.CallContract(evmClient.Ctx, convertMsg.CallMsg, nil)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, evmRpcErr(err, convertMsg.ABI)
 		}
 
 		result, err := util.GetAbiUnpack(convertMsg.CallName, convertMsg.ABI, convertMsg.Bytecode, res)
 		if err != nil {
-			return "", util.LogErr(errors.ErrParse, err)
+			return nil, util.LogErr(errors.ErrParse, err)
 		}
 
 		var callSolContractResponse types.CallSolContractResponse
+		callSolContractResponse.Data = res
 		for _, res := range result {
 			callSolContractResponse.ContractResponse = append(callSolContractResponse.ContractResponse, util.ToString(res, ""))
 		}
 
-		return jsonReturn(callSolContractResponse)
+		return jsonReturn(i, callSolContractResponse)
 
 	// Evm transaction by hash
 	case i.Ixplac.GetMsgType() == EvmGetTransactionByHashMsgType:
@@ -81,13 +83,13 @@ func QueryEvm(i core.QueryClient) (string, error) {
 		commonTxHash := util.FromStringHexToHash(convertMsg.TxHash)
 		tx, isPending, err := evmClient.Client.TransactionByHash(evmClient.Ctx, commonTxHash)
 		if isPending {
-			return "", util.LogErr(errors.ErrNotFound, "tx is pending..")
+			return nil, util.LogErr(errors.ErrNotFound, "tx is pending..")
 		}
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
-		return jsonReturn(tx)
+		return jsonReturn(i, tx)
 
 	// Evm block by hash or height
 	case i.Ixplac.GetMsgType() == EvmGetBlockByHashHeightMsgType:
@@ -99,17 +101,17 @@ func QueryEvm(i core.QueryClient) (string, error) {
 			commonBlockHash := util.FromStringHexToHash(convertMsg.BlockHash)
 			block, err = evmClient.Client.BlockByHash(evmClient.Ctx, commonBlockHash)
 			if err != nil {
-				return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+				return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 			}
 		} else {
 			blockNumber, err := util.FromStringToBigInt(convertMsg.BlockHeight)
 			if err != nil {
-				return "", err
+				return nil, err
 			}
 
 			block, err = evmClient.Client.BlockByNumber(evmClient.Ctx, blockNumber)
 			if err != nil {
-				return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+				return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 			}
 		}
 
@@ -120,7 +122,7 @@ func QueryEvm(i core.QueryClient) (string, error) {
 		blockResponse.Transactions = txs
 		blockResponse.Uncles = uncles
 
-		return jsonReturn(blockResponse)
+		return jsonReturn(i, blockResponse)
 
 	// Evm account information
 	case i.Ixplac.GetMsgType() == EvmQueryAccountInfoMsgType:
@@ -129,44 +131,44 @@ func QueryEvm(i core.QueryClient) (string, error) {
 
 		balance, err := evmClient.Client.BalanceAt(evmClient.Ctx, account, nil)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 		currentNonce, err := evmClient.Client.NonceAt(evmClient.Ctx, account, nil)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 		storage, err := evmClient.Client.StorageAt(evmClient.Ctx, account, util.FromStringHexToHash("0"), nil)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 		code, err := evmClient.Client.CodeAt(evmClient.Ctx, account, nil)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 		pendingBalance, err := evmClient.Client.PendingBalanceAt(evmClient.Ctx, account)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 		pendingNonce, err := evmClient.Client.PendingNonceAt(evmClient.Ctx, account)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 		pendingStorage, err := evmClient.Client.PendingStorageAt(evmClient.Ctx, account, util.FromStringHexToHash("0"))
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 		pendingCode, err := evmClient.Client.PendingCodeAt(evmClient.Ctx, account)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 		pendingTransactionCount, err := evmClient.Client.PendingTransactionCount(evmClient.Ctx)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		bech32Addr, err := util.FromByte20AddressToCosmosAddr(account)
 		if err != nil {
-			return "", util.LogErr(errors.ErrParse, err)
+			return nil, util.LogErr(errors.ErrParse, err)
 		}
 
 		var accountInfoResponse types.AccountInfoResponse
@@ -183,62 +185,62 @@ func QueryEvm(i core.QueryClient) (string, error) {
 		accountInfoResponse.PendingCode = string(pendingCode)
 		accountInfoResponse.PendingTransactionCount = pendingTransactionCount
 
-		return jsonReturn(accountInfoResponse)
+		return jsonReturn(i, accountInfoResponse)
 
 	// Evm suggest gas price
 	case i.Ixplac.GetMsgType() == EvmSuggestGasPriceMsgType:
 		gasPrice, err := evmClient.Client.SuggestGasPrice(evmClient.Ctx)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		gasTipCap, err := evmClient.Client.SuggestGasTipCap(evmClient.Ctx)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		var suggestGasPriceResponse types.SuggestGasPriceResponse
 		suggestGasPriceResponse.GasPrice = gasPrice
 		suggestGasPriceResponse.GasTipCap = gasTipCap
 
-		return jsonReturn(suggestGasPriceResponse)
+		return jsonReturn(i, suggestGasPriceResponse)
 
 	// Evm chain ID
 	case i.Ixplac.GetMsgType() == EvmQueryChainIdMsgType:
 		chainId, err := evmClient.Client.ChainID(evmClient.Ctx)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		var ethChainIdResponse types.EthChainIdResponse
 		ethChainIdResponse.ChainID = chainId
 
-		return jsonReturn(ethChainIdResponse)
+		return jsonReturn(i, ethChainIdResponse)
 
 	// Evm latest block height
 	case i.Ixplac.GetMsgType() == EvmQueryCurrentBlockNumberMsgType:
 		blockNumber, err := evmClient.Client.BlockNumber(evmClient.Ctx)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		var ethBlockNumberResponse types.EthBlockNumberResponse
 		ethBlockNumberResponse.BlockNumber = blockNumber
 
-		return jsonReturn(ethBlockNumberResponse)
+		return jsonReturn(i, ethBlockNumberResponse)
 
 	// Web3 client version
 	case i.Ixplac.GetMsgType() == EvmWeb3ClientVersionMsgType:
 		var result string
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "web3_clientVersion")
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		var web3ClientVersionResponse types.Web3ClientVersionResponse
 		web3ClientVersionResponse.Web3ClientVersion = result
 
-		return jsonReturn(web3ClientVersionResponse)
+		return jsonReturn(i, web3ClientVersionResponse)
 
 	// Web3 sha
 	case i.Ixplac.GetMsgType() == EvmWeb3Sha3MsgType:
@@ -247,52 +249,52 @@ func QueryEvm(i core.QueryClient) (string, error) {
 		var result string
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "web3_sha3", convertMsg.InputParam)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		var web3Sha3Response types.Web3Sha3Response
 		web3Sha3Response.Web3Sha3 = result
 
-		return jsonReturn(web3Sha3Response)
+		return jsonReturn(i, web3Sha3Response)
 
 	// network ID
 	case i.Ixplac.GetMsgType() == EvmNetVersionMsgType:
 		var result string
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "net_version")
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		var netVersionResponse types.NetVersionResponse
 		netVersionResponse.NetVersion = result
 
-		return jsonReturn(netVersionResponse)
+		return jsonReturn(i, netVersionResponse)
 
 	// the number of peers
 	case i.Ixplac.GetMsgType() == EvmNetPeerCountMsgType:
 		var result int
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "net_peerCount")
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		var netPeerCountResponse types.NetPeerCountResponse
 		netPeerCountResponse.NetPeerCount = result
 
-		return jsonReturn(netPeerCountResponse)
+		return jsonReturn(i, netPeerCountResponse)
 
 	// actively listening for network connections
 	case i.Ixplac.GetMsgType() == EvmNetListeningMsgType:
 		var result bool
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "net_listening")
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		var netListeningResponse types.NetListeningResponse
 		netListeningResponse.NetListening = result
 
-		return jsonReturn(netListeningResponse)
+		return jsonReturn(i, netListeningResponse)
 
 	// eth protocol version
 	case i.Ixplac.GetMsgType() == EvmEthProtocolVersionMsgType:
@@ -301,7 +303,7 @@ func QueryEvm(i core.QueryClient) (string, error) {
 		var result string
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "eth_protocolVersion")
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		if result != "" {
@@ -312,33 +314,33 @@ func QueryEvm(i core.QueryClient) (string, error) {
 		ethProtocolVersionResponse.EthProtocolVersionHex = result
 		ethProtocolVersionResponse.EthProtocolVersion = resultBigInt
 
-		return jsonReturn(ethProtocolVersionResponse)
+		return jsonReturn(i, ethProtocolVersionResponse)
 
 	// eth syncing status
 	case i.Ixplac.GetMsgType() == EvmEthSyncingMsgType:
 		var result bool
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "eth_syncing")
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		var ethSyncingResponse types.EthSyncingResponse
 		ethSyncingResponse.EthSyncing = result
 
-		return jsonReturn(ethSyncingResponse)
+		return jsonReturn(i, ethSyncingResponse)
 
 	// eth all accounts
 	case i.Ixplac.GetMsgType() == EvmEthAccountsMsgType:
 		var result []string
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "eth_accounts")
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		var ethAccountsResponse types.EthAccountsResponse
 		ethAccountsResponse.EthAccounts = result
 
-		return jsonReturn(ethAccountsResponse)
+		return jsonReturn(i, ethAccountsResponse)
 
 	// the number of transaction a given block
 	case i.Ixplac.GetMsgType() == EvmEthGetBlockTransactionCountMsgType:
@@ -349,14 +351,14 @@ func QueryEvm(i core.QueryClient) (string, error) {
 		if convertMsg.BlockHash != "" {
 			err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "eth_getBlockTransactionCountByHash", convertMsg.BlockHash)
 			if err != nil {
-				return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+				return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 			}
 		}
 
 		if convertMsg.BlockHeight != "" {
 			err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "eth_getBlockTransactionCountByNumber", convertMsg.BlockHeight)
 			if err != nil {
-				return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+				return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 			}
 		}
 
@@ -370,7 +372,7 @@ func QueryEvm(i core.QueryClient) (string, error) {
 		ethGetBlockTransactionCountResponse.EthGetBlockTransactionCountHex = result
 		ethGetBlockTransactionCountResponse.EthGetBlockTransactionCount = resultBigInt
 
-		return jsonReturn(ethGetBlockTransactionCountResponse)
+		return jsonReturn(i, ethGetBlockTransactionCountResponse)
 
 	// Evm call contract
 	case i.Ixplac.GetMsgType() == EvmEthEstimateGasMsgType:
@@ -380,13 +382,13 @@ func QueryEvm(i core.QueryClient) (string, error) {
 
 		res, err := evmClient.Client.EstimateGas(evmClient.Ctx, convertMsg.CallMsg)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, evmRpcErr(err, convertMsg.ABI)
 		}
 
 		var estimateGasResponse types.EstimateGasResponse
 		estimateGasResponse.EstimateGas = res
 
-		return jsonReturn(estimateGasResponse)
+		return jsonReturn(i, estimateGasResponse)
 
 	// get transaction by block hash and index
 	case i.Ixplac.GetMsgType() == EvmGetTransactionByBlockHashAndIndexMsgType:
@@ -396,16 +398,16 @@ func QueryEvm(i core.QueryClient) (string, error) {
 
 		indexU64, err := util.FromStringToUint64(convertMsg.Index)
 		if err != nil {
-			return "", util.LogErr(errors.ErrParse, err)
+			return nil, util.LogErr(errors.ErrParse, err)
 		}
 		index := indexU64
 
 		res, err := evmClient.Client.TransactionInBlock(evmClient.Ctx, blockHash, uint(index))
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
-		return jsonReturn(res)
+		return jsonReturn(i, res)
 
 	// get transaction receipt
 	case i.Ixplac.GetMsgType() == EvmGetTransactionReceiptMsgType:
@@ -415,10 +417,10 @@ func QueryEvm(i core.QueryClient) (string, error) {
 
 		res, err := evmClient.Client.TransactionReceipt(evmClient.Ctx, transactionHash)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
-		return jsonReturn(res)
+		return jsonReturn(i, res)
 
 	// get filter ID by eth new filter
 	case i.Ixplac.GetMsgType() == EvmEthNewFilterMsgType:
@@ -427,13 +429,13 @@ func QueryEvm(i core.QueryClient) (string, error) {
 		var result interface{}
 		err = evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "eth_newFilter", convertMsg)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		var ethNewFilterResponse types.EthNewFilterResponse
 		ethNewFilterResponse.NewFilter = result
 
-		return jsonReturn(ethNewFilterResponse)
+		return jsonReturn(i, ethNewFilterResponse)
 
 	// get transaction receipt
 	case i.Ixplac.GetMsgType() == EvmEthNewBlockFilterMsgType:
@@ -441,14 +443,14 @@ func QueryEvm(i core.QueryClient) (string, error) {
 		var result interface{}
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "eth_newBlockFilter")
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		ethNewBlockFilterResponse := types.EthNewBlockFilterResponse{
 			NewBlockFilter: result,
 		}
 
-		return jsonReturn(ethNewBlockFilterResponse)
+		return jsonReturn(i, ethNewBlockFilterResponse)
 
 	// get transaction receipt
 	case i.Ixplac.GetMsgType() == EvmEthNewPendingTransactionFilterMsgType:
@@ -456,14 +458,14 @@ func QueryEvm(i core.QueryClient) (string, error) {
 		var result interface{}
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "eth_newPendingTransactionFilter")
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		ethNewPendingTransactionFilterResponse := types.EthNewPendingTransactionFilterResponse{
 			NewPendingTransactionFilter: result,
 		}
 
-		return jsonReturn(ethNewPendingTransactionFilterResponse)
+		return jsonReturn(i, ethNewPendingTransactionFilterResponse)
 
 	// uninstall filter
 	case i.Ixplac.GetMsgType() == EvmEthUninstallFilterMsgType:
@@ -472,62 +474,78 @@ func QueryEvm(i core.QueryClient) (string, error) {
 		var result bool
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "eth_uninstallFilter", convertMsg.FilterId)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		ethUninstallFilterResponse := types.EthUninstallFilterResponse{
 			UninstallFilter: result,
 		}
 
-		return jsonReturn(ethUninstallFilterResponse)
+		return jsonReturn(i, ethUninstallFilterResponse)
 
 	// get filter changes
 	case i.Ixplac.GetMsgType() == EvmEthGetFilterChangesMsgType:
 		convertMsg := i.Ixplac.GetMsg().(types.EthGetFilterChangesMsg)
 
-		var result []string
+		var result []json.RawMessage
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "eth_getFilterChanges", convertMsg.FilterId)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
-		ethGetFilterChangesResponse := types.EthGetFilterChangesResponse{
-			GetFilterChanges: result,
+		// Changes of the log filter are logs, and others are hashes.
+		var ethGetFilterChangesResponse types.EthGetFilterChangesResponse
+		for _, change := range result {
+			var hash string
+			if err := json.Unmarshal(change, &hash); err == nil {
+				ethGetFilterChangesResponse.GetFilterChanges = append(ethGetFilterChangesResponse.GetFilterChanges, hash)
+				continue
+			}
+
+			var log ethtypes.Log
+			if err := json.Unmarshal(change, &log); err != nil {
+				return nil, util.LogErr(errors.ErrFailedToUnmarshal, err)
+			}
+			ethGetFilterChangesResponse.Logs = append(ethGetFilterChangesResponse.Logs, log)
 		}
 
-		return jsonReturn(ethGetFilterChangesResponse)
+		return jsonReturn(i, ethGetFilterChangesResponse)
 
 	// get filter logs
 	case i.Ixplac.GetMsgType() == EvmEthGetFilterLogsMsgType:
 		convertMsg := i.Ixplac.GetMsg().(types.EthGetFilterLogsMsg)
 
-		var result []string
+		var result []ethtypes.Log
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "eth_getFilterLogs", convertMsg.FilterId)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		ethGetFilterLogsResponse := types.EthGetFilterLogsResponse{
-			GetFilterLogs: result,
+			Logs: result,
 		}
 
-		return jsonReturn(ethGetFilterLogsResponse)
+		return jsonReturn(i, ethGetFilterLogsResponse)
 
 	// get logs
 	case i.Ixplac.GetMsgType() == EvmEthGetLogsMsgType:
 		convertMsg := i.Ixplac.GetMsg().(EthNewFilterParseMsg)
 
-		var result interface{}
+		var result json.RawMessage
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "eth_getLogs", convertMsg)
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
-		ethGetLogsResponse := types.EthGetLogsResponse{
-			GetLogs: result,
+		var ethGetLogsResponse types.EthGetLogsResponse
+		if err := json.Unmarshal(result, &ethGetLogsResponse.GetLogs); err != nil {
+			return nil, util.LogErr(errors.ErrFailedToUnmarshal, err)
+		}
+		if err := json.Unmarshal(result, &ethGetLogsResponse.Logs); err != nil {
+			return nil, util.LogErr(errors.ErrFailedToUnmarshal, err)
 		}
 
-		return jsonReturn(ethGetLogsResponse)
+		return jsonReturn(i, ethGetLogsResponse)
 
 	// get coinbase
 	case i.Ixplac.GetMsgType() == EvmEthCoinbaseMsgType:
@@ -535,25 +553,20 @@ func QueryEvm(i core.QueryClient) (string, error) {
 		var result string
 		err := evmClient.RpcClient.CallContext(evmClient.Ctx, &result, "eth_coinbase")
 		if err != nil {
-			return "", util.LogErr(errors.ErrEvmRpcRequest, err)
+			return nil, util.LogErr(errors.ErrEvmRpcRequest, err)
 		}
 
 		ethCoinbaseResponse := types.EthCoinbaseResponse{
 			Coinbase: result,
 		}
 
-		return jsonReturn(ethCoinbaseResponse)
+		return jsonReturn(i, ethCoinbaseResponse)
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 }
 
-func jsonReturn(value interface{}) (string, error) {
-	json, err := util.JsonMarshalDataIndent(value)
-	if err != nil {
-		return "", util.LogErr(errors.ErrFailedToMarshal, err)
-	}
-
-	return string(json), nil
+func jsonReturn(i core.QueryClient, value interface{}) (*core.QueryResponse, error) {
+	return core.JsonIndentQueryResponse(i, value), nil
 }
@@ -0,0 +1,218 @@
+package evm
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Kinds of the revert reason.
+const (
+	RevertKindError   = "Error"
+	RevertKindPanic   = "Panic"
+	RevertKindCustom  = "Custom"
+	RevertKindUnknown = "Unknown"
+)
+
+var (
+	errorSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+	panicSelector = crypto.Keccak256([]byte("Panic(uint256)"))[:4]
+
+	stringType, _  = abi.NewType("string", "", nil)
+	uint256Type, _ = abi.NewType("uint256", "", nil)
+)
+
+// Descriptions of panic codes of solidity.
+var panicReasons = map[uint64]string{
+	0x00: "generic panic",
+	0x01: "assert(false)",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "enum overflow",
+	0x22: "invalid encoded storage byte array accessed",
+	0x31: "out-of-bounds array access; popping on an empty array",
+	0x32: "out-of-bounds access of an array or bytesN",
+	0x41: "out of memory",
+	0x51: "uninitialized function",
+}
+
+// Error of the reverted evm call or transaction.
+// The reason is decoded from the revert data by Error(string), Panic(uint256) or custom errors of the contract ABI.
+type RevertError struct {
+	// Hash of the failed transaction. It is empty if the error is of the call.
+	TxHash string
+	// Revert data which is returned by the contract.
+	Data hexutil.Bytes
+	// One of RevertKindError, RevertKindPanic, RevertKindCustom and RevertKindUnknown.
+	Kind string
+	// Reason string of Error(string), or the description of the panic code.
+	Reason string
+	// Code of Panic(uint256).
+	PanicCode *big.Int
+	// Name, signature and arguments of the custom error which is declared in the ABI.
+	// The name of the unnamed argument is "arg<index>".
+	ErrorName      string
+	ErrorSignature string
+	Args           map[string]interface{}
+	// Message of the RPC error.
+	Message string
+}
+
+func (e *RevertError) Error() string {
+	var reason string
+	switch e.Kind {
+	case RevertKindError:
+		reason = e.Reason
+	case RevertKindPanic:
+		reason = fmt.Sprintf("panic 0x%x (%s)", e.PanicCode, e.Reason)
+	case RevertKindCustom:
+		if e.ErrorSignature != "" {
+			reason = fmt.Sprintf("%s %v", e.ErrorSignature, e.Args)
+		} else {
+			reason = "custom error " + hexutil.Encode(e.Data[:4])
+		}
+	default:
+		reason = e.Message
+	}
+
+	if e.TxHash != "" {
+		return util.LogErr(errors.ErrTxFailed, "evm transaction", e.TxHash, "is reverted:", reason).Error()
+	}
+	return util.LogErr(errors.ErrEvmRpcRequest, "execution reverted:", reason).Error()
+}
+
+// Decode the revert data of the contract.
+// Custom errors are decoded if the ABI JSON of the contract is not empty.
+func DecodeRevertData(data []byte, abiJson string) *RevertError {
+	revertErr := &RevertError{Data: data, Kind: RevertKindUnknown}
+	if len(data) < 4 {
+		return revertErr
+	}
+
+	switch {
+	case bytes.Equal(data[:4], errorSelector):
+		values, err := abi.Arguments{{Type: stringType}}.Unpack(data[4:])
+		if err == nil {
+			revertErr.Kind = RevertKindError
+			revertErr.Reason = values[0].(string)
+		}
+
+	case bytes.Equal(data[:4], panicSelector):
+		values, err := abi.Arguments{{Type: uint256Type}}.Unpack(data[4:])
+		if err == nil {
+			code := values[0].(*big.Int)
+			revertErr.Kind = RevertKindPanic
+			revertErr.PanicCode = code
+			revertErr.Reason = "unknown panic code"
+			if reason, ok := panicReasons[code.Uint64()]; ok && code.IsUint64() {
+				revertErr.Reason = reason
+			}
+		}
+
+	default:
+		revertErr.Kind = RevertKindCustom
+		if abiJson == "" {
+			return revertErr
+		}
+		contractAbi, err := abi.JSON(strings.NewReader(abiJson))
+		if err != nil {
+			return revertErr
+		}
+		for _, abiErr := range contractAbi.Errors {
+			if !bytes.Equal(data[:4], abiErr.ID[:4]) {
+				continue
+			}
+
+			inputs := make(abi.Arguments, len(abiErr.Inputs))
+			for i, input := range abiErr.Inputs {
+				if input.Name == "" {
+					input.Name = fmt.Sprintf("arg%d", i)
+				}
+				inputs[i] = input
+			}
+
+			args := make(map[string]interface{})
+			if err := inputs.UnpackIntoMap(args, data[4:]); err == nil {
+				revertErr.ErrorName = abiErr.Name
+				revertErr.ErrorSignature = abiErr.Sig
+				revertErr.Args = args
+			}
+			break
+		}
+	}
+
+	return revertErr
+}
+
+// Get the revert error from the error of the evm RPC, e.g. eth_call and eth_estimateGas.
+// It returns nil if the RPC error has no revert data.
+func RevertErrorOf(err error, abiJson string) *RevertError {
+	dataErr, ok := err.(rpc.DataError)
+	if !ok {
+		return nil
+	}
+
+	hexData, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return nil
+	}
+	data, decodeErr := hexutil.Decode(hexData)
+	if decodeErr != nil {
+		return nil
+	}
+
+	revertErr := DecodeRevertData(data, abiJson)
+	revertErr.Message = err.Error()
+	return revertErr
+}
+
+// Wrap the error of the evm RPC as the revert error if it has revert data.
+func evmRpcErr(err error, abiJson string) error {
+	if revertErr := RevertErrorOf(err, abiJson); revertErr != nil {
+		return revertErr
+	}
+	return util.LogErr(errors.ErrEvmRpcRequest, err)
+}
+
+// Re-run the failed transaction by eth_call at the block of the receipt in order to recover the revert reason.
+// The revert error has the message of the call if the reason is not recovered, e.g. the transaction runs out of gas.
+func ReplayFailedTx(evmClient *util.EvmClient, tx *ethtypes.Transaction, receipt *ethtypes.Receipt, abiJson string) *RevertError {
+	revertErr := &RevertError{TxHash: tx.Hash().Hex(), Kind: RevertKindUnknown}
+
+	from, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		revertErr.Message = err.Error()
+		return revertErr
+	}
+
+	_, err = evmClient.Client.CallContract(evmClient.Ctx, ethereum.CallMsg{
+		From:       from,
+		To:         tx.To(),
+		Gas:        tx.Gas(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	}, receipt.BlockNumber)
+	if err == nil {
+		revertErr.Message = "the reason is not recovered, the transaction is not reverted by eth_call"
+		return revertErr
+	}
+
+	if callErr := RevertErrorOf(err, abiJson); callErr != nil {
+		callErr.TxHash = revertErr.TxHash
+		return callErr
+	}
+	revertErr.Message = err.Error()
+	return revertErr
+}
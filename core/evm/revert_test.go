@@ -0,0 +1,84 @@
+package evm_test
+
+import (
+	"math/big"
+	"strings"
+
+	mevm "github.com/Moonyongjung/xpriv.go/core/evm"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const testErrorABI = `[
+	{"type":"error","name":"InsufficientBalance","inputs":[
+		{"name":"available","type":"uint256"},
+		{"name":"","type":"address"}
+	]}
+]`
+
+// RPC error which has the revert data, as the error of eth_call.
+type testDataError struct {
+	data string
+}
+
+func (e testDataError) Error() string          { return "execution reverted" }
+func (e testDataError) ErrorCode() int         { return 3 }
+func (e testDataError) ErrorData() interface{} { return e.data }
+
+func packRevertData(s *IntegrationTestSuite, sig string, args abi.Arguments, values ...interface{}) []byte {
+	packed, err := args.Pack(values...)
+	s.Require().NoError(err)
+	return append(crypto.Keccak256([]byte(sig))[:4], packed...)
+}
+
+func (s *IntegrationTestSuite) TestDecodeRevertData() {
+	stringType, _ := abi.NewType("string", "", nil)
+	uint256Type, _ := abi.NewType("uint256", "", nil)
+	addressType, _ := abi.NewType("address", "", nil)
+
+	// Error(string)
+	data := packRevertData(s, "Error(string)", abi.Arguments{{Type: stringType}}, "not enough balance")
+	revertErr := mevm.DecodeRevertData(data, "")
+	s.Require().Equal(mevm.RevertKindError, revertErr.Kind)
+	s.Require().Equal("not enough balance", revertErr.Reason)
+	s.Require().Contains(revertErr.Error(), "not enough balance")
+
+	// Panic(uint256)
+	data = packRevertData(s, "Panic(uint256)", abi.Arguments{{Type: uint256Type}}, big.NewInt(0x11))
+	revertErr = mevm.DecodeRevertData(data, "")
+	s.Require().Equal(mevm.RevertKindPanic, revertErr.Kind)
+	s.Require().Equal(big.NewInt(0x11), revertErr.PanicCode)
+	s.Require().Equal("arithmetic underflow or overflow", revertErr.Reason)
+
+	// custom error of the ABI
+	account := common.HexToAddress(testSolContractAddress)
+	data = packRevertData(s, "InsufficientBalance(uint256,address)", abi.Arguments{{Type: uint256Type}, {Type: addressType}}, big.NewInt(10), account)
+	revertErr = mevm.DecodeRevertData(data, testErrorABI)
+	s.Require().Equal(mevm.RevertKindCustom, revertErr.Kind)
+	s.Require().Equal("InsufficientBalance", revertErr.ErrorName)
+	s.Require().Equal("InsufficientBalance(uint256,address)", revertErr.ErrorSignature)
+	s.Require().Equal(big.NewInt(10), revertErr.Args["available"])
+	s.Require().Equal(account, revertErr.Args["arg1"])
+	s.Require().True(strings.Contains(revertErr.Error(), "InsufficientBalance"))
+
+	// custom error without the ABI
+	revertErr = mevm.DecodeRevertData(data, "")
+	s.Require().Equal(mevm.RevertKindCustom, revertErr.Kind)
+	s.Require().Equal("", revertErr.ErrorName)
+	s.Require().Contains(revertErr.Error(), hexutil.Encode(data[:4]))
+
+	// empty revert data
+	revertErr = mevm.DecodeRevertData(nil, testErrorABI)
+	s.Require().Equal(mevm.RevertKindUnknown, revertErr.Kind)
+
+	// revert error of the RPC error
+	revertErr = mevm.RevertErrorOf(testDataError{data: hexutil.Encode(data)}, testErrorABI)
+	s.Require().NotNil(revertErr)
+	s.Require().Equal("InsufficientBalance", revertErr.ErrorName)
+	s.Require().Equal("execution reverted", revertErr.Message)
+	s.Require().Nil(mevm.RevertErrorOf(testDataError{data: "invalid"}, testErrorABI))
+	s.Require().Nil(mevm.RevertErrorOf(nil, testErrorABI))
+}
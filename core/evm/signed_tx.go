@@ -0,0 +1,36 @@
+package evm
+
+import (
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// Decode the signed evm transaction which is made by CreateAndSignTx.
+// The raw transaction for eth_sendRawTransaction is made by MarshalBinary of the decoded transaction.
+func DecodeSignedEvmTx(txBytes []byte) (*ethtypes.Transaction, error) {
+	var signedTx ethtypes.Transaction
+	if err := signedTx.UnmarshalJSON(txBytes); err != nil {
+		return nil, util.LogErr(errors.ErrFailedToUnmarshal, err)
+	}
+
+	return &signedTx, nil
+}
+
+// Get the address of the contract which is created by the signed deployment transaction.
+// The address is derived from the sender and the nonce, so it is known before the transaction is broadcasted.
+func EvmContractAddress(signedTx *ethtypes.Transaction) (common.Address, error) {
+	if signedTx.To() != nil {
+		return common.Address{}, util.LogErr(errors.ErrInvalidRequest, "not the contract deployment transaction")
+	}
+
+	sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(signedTx.ChainId()), signedTx)
+	if err != nil {
+		return common.Address{}, util.LogErr(errors.ErrInvalidRequest, err)
+	}
+
+	return ethcrypto.CreateAddress(sender, signedTx.Nonce()), nil
+}
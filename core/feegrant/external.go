@@ -20,11 +20,11 @@ func NewFeegrantExternal(xplac provider.XplaClient) (e FeegrantExternal) {
 
 // Grant fee allowance to an address.
 func (e FeegrantExternal) FeeGrant(grantMsg types.FeeGrantMsg) provider.XplaClient {
-	msg, err := MakeFeeGrantMsg(grantMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeFeeGrantMsg(grantMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(FeegrantModule).
+	e.Xplac = e.Xplac.WithModule(FeegrantModule).
 		WithMsgType(FeegrantGrantMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -32,11 +32,11 @@ func (e FeegrantExternal) FeeGrant(grantMsg types.FeeGrantMsg) provider.XplaClie
 
 // Revoke fee-grant.
 func (e FeegrantExternal) RevokeFeeGrant(revokeGrantMsg types.RevokeFeeGrantMsg) provider.XplaClient {
-	msg, err := MakeRevokeFeeGrantMsg(revokeGrantMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeRevokeFeeGrantMsg(revokeGrantMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(FeegrantModule).
+	e.Xplac = e.Xplac.WithModule(FeegrantModule).
 		WithMsgType(FeegrantRevokeGrantMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -51,27 +51,27 @@ func (e FeegrantExternal) QueryFeeGrants(queryFeeGrantMsg types.QueryFeeGrantMsg
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(FeegrantModule).
+		e.Xplac = e.Xplac.WithModule(FeegrantModule).
 			WithMsgType(FeegrantQueryGrantMsgType).
 			WithMsg(msg)
 	} else if queryFeeGrantMsg.Grantee != "" && queryFeeGrantMsg.Granter == "" {
-		msg, err := MakeQueryFeeGrantsByGranteeMsg(queryFeeGrantMsg)
+		msg, err := MakeQueryFeeGrantsByGranteeMsg(queryFeeGrantMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(FeegrantModule).
+		e.Xplac = e.Xplac.WithModule(FeegrantModule).
 			WithMsgType(FeegrantQueryGrantsByGranteeMsgType).
 			WithMsg(msg)
 	} else if queryFeeGrantMsg.Grantee == "" && queryFeeGrantMsg.Granter != "" {
-		msg, err := MakeQueryFeeGrantsByGranterMsg(queryFeeGrantMsg)
+		msg, err := MakeQueryFeeGrantsByGranterMsg(queryFeeGrantMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(FeegrantModule).
+		e.Xplac = e.Xplac.WithModule(FeegrantModule).
 			WithMsgType(FeegrantQueryGrantsByGranterMsgType).
 			WithMsg(msg)
 	} else {
-		provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInsufficientParams, "no query grants parameters"))
+		e.Xplac = provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInsufficientParams, "no query grants parameters"))
 	}
 
 	return e.Xplac
@@ -7,7 +7,7 @@ import (
 )
 
 func (s *IntegrationTestSuite) TestFeegrantTx() {
-	s.xplac.WithPrivateKey(s.accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(s.accounts[0].PrivKey)
 	// feegrant
 	feeGrantMsg := types.FeeGrantMsg{
 		Granter:    s.accounts[0].Address.String(),
@@ -17,9 +17,9 @@ func (s *IntegrationTestSuite) TestFeegrantTx() {
 		// PeriodLimit: "10",
 		Expiration: "2100-01-01T23:59:59+00:00",
 	}
-	s.xplac.FeeGrant(feeGrantMsg)
+	s.xplac = s.xplac.FeeGrant(feeGrantMsg)
 
-	makeFeeGrantMsg, err := mfeegrant.MakeFeeGrantMsg(feeGrantMsg, s.xplac.GetPrivateKey())
+	makeFeeGrantMsg, err := mfeegrant.MakeFeeGrantMsg(feeGrantMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeFeeGrantMsg, s.xplac.GetMsg())
@@ -38,9 +38,9 @@ func (s *IntegrationTestSuite) TestFeegrantTx() {
 		Granter: s.accounts[0].Address.String(),
 		Grantee: s.accounts[1].Address.String(),
 	}
-	s.xplac.RevokeFeeGrant(revokeFeeGrantMsg)
+	s.xplac = s.xplac.RevokeFeeGrant(revokeFeeGrantMsg)
 
-	makeRevokeFeeGrantMsg, err := mfeegrant.MakeRevokeFeeGrantMsg(revokeFeeGrantMsg, s.xplac.GetPrivateKey())
+	makeRevokeFeeGrantMsg, err := mfeegrant.MakeRevokeFeeGrantMsg(revokeFeeGrantMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeRevokeFeeGrantMsg, s.xplac.GetMsg())
@@ -61,7 +61,7 @@ func (s *IntegrationTestSuite) TestFeegrant() {
 		Grantee: s.accounts[0].Address.String(),
 		Granter: s.accounts[1].Address.String(),
 	}
-	s.xplac.QueryFeeGrants(queryFeeGrantMsg)
+	s.xplac = s.xplac.QueryFeeGrants(queryFeeGrantMsg)
 
 	makeQueryFeeGrantMsg, err := mfeegrant.MakeQueryFeeGrantMsg(queryFeeGrantMsg)
 	s.Require().NoError(err)
@@ -74,9 +74,9 @@ func (s *IntegrationTestSuite) TestFeegrant() {
 	queryFeeGrantMsg = types.QueryFeeGrantMsg{
 		Grantee: s.accounts[0].Address.String(),
 	}
-	s.xplac.QueryFeeGrants(queryFeeGrantMsg)
+	s.xplac = s.xplac.QueryFeeGrants(queryFeeGrantMsg)
 
-	makeQueryFeeGrantsByGranteeMsg, err := mfeegrant.MakeQueryFeeGrantsByGranteeMsg(queryFeeGrantMsg)
+	makeQueryFeeGrantsByGranteeMsg, err := mfeegrant.MakeQueryFeeGrantsByGranteeMsg(queryFeeGrantMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryFeeGrantsByGranteeMsg, s.xplac.GetMsg())
@@ -87,9 +87,9 @@ func (s *IntegrationTestSuite) TestFeegrant() {
 	queryFeeGrantMsg = types.QueryFeeGrantMsg{
 		Granter: s.accounts[1].Address.String(),
 	}
-	s.xplac.QueryFeeGrants(queryFeeGrantMsg)
+	s.xplac = s.xplac.QueryFeeGrants(queryFeeGrantMsg)
 
-	makeQueryFeeGrantsByGranterMsg, err := mfeegrant.MakeQueryFeeGrantsByGranterMsg(queryFeeGrantMsg)
+	makeQueryFeeGrantsByGranterMsg, err := mfeegrant.MakeQueryFeeGrantsByGranterMsg(queryFeeGrantMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryFeeGrantsByGranterMsg, s.xplac.GetMsg())
@@ -13,7 +13,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 	src := rand.NewSource(1)
 	r := rand.New(src)
 	accounts := testutil.RandomAccounts(r, 2)
-	s.xplac.WithPrivateKey(accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(accounts[0].PrivKey)
 
 	c := feegrant.NewCoreModule()
 
@@ -34,7 +34,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 		Expiration: "2100-01-01T23:59:59+00:00",
 	}
 
-	makeFeeGrantMsg, err := feegrant.MakeFeeGrantMsg(feeGrantMsg, s.xplac.GetPrivateKey())
+	makeFeeGrantMsg, err := feegrant.MakeFeeGrantMsg(feeGrantMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	testMsg = makeFeeGrantMsg
@@ -48,7 +48,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 		Grantee: accounts[1].Address.String(),
 	}
 
-	makeRevokeFeeGrantMsg, err := feegrant.MakeRevokeFeeGrantMsg(revokeFeeGrantMsg, s.xplac.GetPrivateKey())
+	makeRevokeFeeGrantMsg, err := feegrant.MakeRevokeFeeGrantMsg(revokeFeeGrantMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	testMsg = makeRevokeFeeGrantMsg
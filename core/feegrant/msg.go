@@ -1,19 +1,19 @@
 package feegrant
 
 import (
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
 // (Tx) make msg - fee grant
-func MakeFeeGrantMsg(feeGrantMsg types.FeeGrantMsg, privKey key.PrivateKey) (feegrant.MsgGrantAllowance, error) {
-	return parseFeeGrantArgs(feeGrantMsg, privKey)
+func MakeFeeGrantMsg(feeGrantMsg types.FeeGrantMsg, signer types.Signer) (feegrant.MsgGrantAllowance, error) {
+	return parseFeeGrantArgs(feeGrantMsg, signer)
 }
 
 // (Tx) make msg - fee grant revoke
-func MakeRevokeFeeGrantMsg(revokeFeeGrantMsg types.RevokeFeeGrantMsg, privKey key.PrivateKey) (feegrant.MsgRevokeAllowance, error) {
-	return parseRevokeFeeGrantArgs(revokeFeeGrantMsg, privKey)
+func MakeRevokeFeeGrantMsg(revokeFeeGrantMsg types.RevokeFeeGrantMsg, signer types.Signer) (feegrant.MsgRevokeAllowance, error) {
+	return parseRevokeFeeGrantArgs(revokeFeeGrantMsg, signer)
 }
 
 // (Query) make msg - query fee grants
@@ -22,11 +22,11 @@ func MakeQueryFeeGrantMsg(queryFeeGrantMsg types.QueryFeeGrantMsg) (feegrant.Que
 }
 
 // (Query) make msg - fee grants by grantee
-func MakeQueryFeeGrantsByGranteeMsg(queryFeeGrantMsg types.QueryFeeGrantMsg) (feegrant.QueryAllowancesRequest, error) {
-	return parseQueryFeeGrantsByGranteeArgs(queryFeeGrantMsg)
+func MakeQueryFeeGrantsByGranteeMsg(queryFeeGrantMsg types.QueryFeeGrantMsg, pageReq *query.PageRequest) (feegrant.QueryAllowancesRequest, error) {
+	return parseQueryFeeGrantsByGranteeArgs(queryFeeGrantMsg, pageReq)
 }
 
 // (Query) make msg - fee grants by granter
-func MakeQueryFeeGrantsByGranterMsg(queryFeeGrantMsg types.QueryFeeGrantMsg) (feegrant.QueryAllowancesByGranterRequest, error) {
-	return parseQueryFeeGrantsByGranterArgs(queryFeeGrantMsg)
+func MakeQueryFeeGrantsByGranterMsg(queryFeeGrantMsg types.QueryFeeGrantMsg, pageReq *query.PageRequest) (feegrant.QueryAllowancesByGranterRequest, error) {
+	return parseQueryFeeGrantsByGranterArgs(queryFeeGrantMsg, pageReq)
 }
@@ -3,21 +3,20 @@ package feegrant
 import (
 	"time"
 
-	"github.com/Moonyongjung/xpriv.go/core"
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
 // Parsing - fee grant
-func parseFeeGrantArgs(feeGrantMsg types.FeeGrantMsg, privKey key.PrivateKey) (feegrant.MsgGrantAllowance, error) {
-	granter, err := util.GetAddrByPrivKey(privKey)
+func parseFeeGrantArgs(feeGrantMsg types.FeeGrantMsg, signer types.Signer) (feegrant.MsgGrantAllowance, error) {
+	granter, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return feegrant.MsgGrantAllowance{}, util.LogErr(errors.ErrParse, err)
+		return feegrant.MsgGrantAllowance{}, err
 	}
 
 	if feeGrantMsg.Granter != granter.String() {
@@ -105,10 +104,10 @@ func parseFeeGrantArgs(feeGrantMsg types.FeeGrantMsg, privKey key.PrivateKey) (f
 }
 
 // Parsing - fee grant revoke
-func parseRevokeFeeGrantArgs(revokeFeeGrantMsg types.RevokeFeeGrantMsg, privKey key.PrivateKey) (feegrant.MsgRevokeAllowance, error) {
-	granter, err := util.GetAddrByPrivKey(privKey)
+func parseRevokeFeeGrantArgs(revokeFeeGrantMsg types.RevokeFeeGrantMsg, signer types.Signer) (feegrant.MsgRevokeAllowance, error) {
+	granter, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return feegrant.MsgRevokeAllowance{}, util.LogErr(errors.ErrParse, err)
+		return feegrant.MsgRevokeAllowance{}, err
 	}
 
 	if revokeFeeGrantMsg.Granter != granter.String() {
@@ -142,7 +141,7 @@ func parseQueryFeeGrantArgs(queryGrantMsg types.QueryFeeGrantMsg) (feegrant.Quer
 }
 
 // Parsing - grants by grantee
-func parseQueryFeeGrantsByGranteeArgs(queryGrantMsg types.QueryFeeGrantMsg) (feegrant.QueryAllowancesRequest, error) {
+func parseQueryFeeGrantsByGranteeArgs(queryGrantMsg types.QueryFeeGrantMsg, pageReq *query.PageRequest) (feegrant.QueryAllowancesRequest, error) {
 	grantee, err := sdk.AccAddressFromBech32(queryGrantMsg.Grantee)
 	if err != nil {
 		return feegrant.QueryAllowancesRequest{}, util.LogErr(errors.ErrParse, err)
@@ -150,12 +149,12 @@ func parseQueryFeeGrantsByGranteeArgs(queryGrantMsg types.QueryFeeGrantMsg) (fee
 
 	return feegrant.QueryAllowancesRequest{
 		Grantee:    grantee.String(),
-		Pagination: core.PageRequest,
+		Pagination: pageReq,
 	}, nil
 }
 
 // Parsing - grants by granter
-func parseQueryFeeGrantsByGranterArgs(queryGrantMsg types.QueryFeeGrantMsg) (feegrant.QueryAllowancesByGranterRequest, error) {
+func parseQueryFeeGrantsByGranterArgs(queryGrantMsg types.QueryFeeGrantMsg, pageReq *query.PageRequest) (feegrant.QueryAllowancesByGranterRequest, error) {
 	granter, err := sdk.AccAddressFromBech32(queryGrantMsg.Granter)
 	if err != nil {
 		return feegrant.QueryAllowancesByGranterRequest{}, util.LogErr(errors.ErrParse, err)
@@ -163,7 +162,7 @@ func parseQueryFeeGrantsByGranterArgs(queryGrantMsg types.QueryFeeGrantMsg) (fee
 
 	return feegrant.QueryAllowancesByGranterRequest{
 		Granter:    granter.String(),
-		Pagination: core.PageRequest,
+		Pagination: pageReq,
 	}, nil
 }
 
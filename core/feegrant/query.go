@@ -11,12 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for fee-grant module.
-func QueryFeegrant(i core.QueryClient) (string, error) {
+func QueryFeegrant(i core.QueryClient) (*core.QueryResponse, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcFeegrant(i)
 	} else {
@@ -25,7 +21,10 @@ func QueryFeegrant(i core.QueryClient) (string, error) {
 
 }
 
-func queryByGrpcFeegrant(i core.QueryClient) (string, error) {
+func queryByGrpcFeegrant(i core.QueryClient) (*core.QueryResponse, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := feegrant.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -37,7 +36,7 @@ func queryByGrpcFeegrant(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Feegrant grants by grantee
@@ -48,7 +47,7 @@ func queryByGrpcFeegrant(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Feegrant grants by granter
@@ -59,19 +58,14 @@ func queryByGrpcFeegrant(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", err
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	return string(out), nil
+	return core.ProtoQueryResponse(i, res), nil
 }
 
 const (
@@ -79,7 +73,7 @@ const (
 	feegrantAllowancesLabel = "allowances"
 )
 
-func queryByLcdFeegrant(i core.QueryClient) (string, error) {
+func queryByLcdFeegrant(i core.QueryClient) (*core.QueryResponse, error) {
 	url := util.MakeQueryLcdUrl(feegrantv1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
@@ -97,17 +91,17 @@ func queryByLcdFeegrant(i core.QueryClient) (string, error) {
 
 	// Feegrant grants by granter
 	case i.Ixplac.GetMsgType() == FeegrantQueryGrantsByGranterMsgType:
-		return "", util.LogErr(errors.ErrNotSupport, "unsupported querying feegrant state(grants by granter) by using LCD")
+		return nil, util.LogErr(errors.ErrNotSupport, "unsupported querying feegrant state(grants by granter) by using LCD")
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+url, i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
+	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+core.LcdPaginationURL(url, i.Ixplac.GetMsg()), i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return string(out), nil
+	return core.RawQueryResponse(i, out), nil
 
 }
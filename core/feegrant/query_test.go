@@ -83,9 +83,9 @@ func (s *IntegrationTestSuite) TestFeegrants() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		queryFeeGrantMsg := types.QueryFeeGrantMsg{
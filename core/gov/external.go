@@ -20,11 +20,11 @@ func NewGovExternal(xplac provider.XplaClient) (e GovExternal) {
 
 // Submit a proposal along with an initial deposit.
 func (e GovExternal) SubmitProposal(submitProposalMsg types.SubmitProposalMsg) provider.XplaClient {
-	msg, err := MakeSubmitProposalMsg(submitProposalMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeSubmitProposalMsg(submitProposalMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(GovModule).
+	e.Xplac = e.Xplac.WithModule(GovModule).
 		WithMsgType(GovSubmitProposalMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -32,11 +32,11 @@ func (e GovExternal) SubmitProposal(submitProposalMsg types.SubmitProposalMsg) p
 
 // Deposit tokens for an active proposal.
 func (e GovExternal) GovDeposit(govDepositMsg types.GovDepositMsg) provider.XplaClient {
-	msg, err := MakeGovDepositMsg(govDepositMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeGovDepositMsg(govDepositMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(GovModule).
+	e.Xplac = e.Xplac.WithModule(GovModule).
 		WithMsgType(GovDepositMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -44,11 +44,11 @@ func (e GovExternal) GovDeposit(govDepositMsg types.GovDepositMsg) provider.Xpla
 
 // Vote for an active proposal, options: yes/no/no_with_veto/abstain.
 func (e GovExternal) Vote(voteMsg types.VoteMsg) provider.XplaClient {
-	msg, err := MakeVoteMsg(voteMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeVoteMsg(voteMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(GovModule).
+	e.Xplac = e.Xplac.WithModule(GovModule).
 		WithMsgType(GovVoteMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -56,11 +56,11 @@ func (e GovExternal) Vote(voteMsg types.VoteMsg) provider.XplaClient {
 
 // Vote for an active proposal, options: yes/no/no_with_veto/abstain.
 func (e GovExternal) WeightedVote(weightedVoteMsg types.WeightedVoteMsg) provider.XplaClient {
-	msg, err := MakeWeightedVoteMsg(weightedVoteMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeWeightedVoteMsg(weightedVoteMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(GovModule).
+	e.Xplac = e.Xplac.WithModule(GovModule).
 		WithMsgType(GovWeightedVoteMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -74,7 +74,7 @@ func (e GovExternal) QueryProposal(queryProposal types.QueryProposalMsg) provide
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(GovModule).
+	e.Xplac = e.Xplac.WithModule(GovModule).
 		WithMsgType(GovQueryProposalMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -82,11 +82,11 @@ func (e GovExternal) QueryProposal(queryProposal types.QueryProposalMsg) provide
 
 // Query proposals with optional filters.
 func (e GovExternal) QueryProposals(queryProposals types.QueryProposalsMsg) provider.XplaClient {
-	msg, err := MakeQueryProposalsMsg(queryProposals)
+	msg, err := MakeQueryProposalsMsg(queryProposals, e.Xplac.GetPagination())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(GovModule).
+	e.Xplac = e.Xplac.WithModule(GovModule).
 		WithMsgType(GovQueryProposalsMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -107,25 +107,25 @@ func (e GovExternal) QueryDeposit(queryDepositMsg types.QueryDepositMsg) provide
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
 		if argsType == "params" {
-			e.Xplac.WithModule(GovModule).
+			e.Xplac = e.Xplac.WithModule(GovModule).
 				WithMsgType(GovQueryDepositParamsMsgType).
 				WithMsg(msg)
 		} else {
-			e.Xplac.WithModule(GovModule).
+			e.Xplac = e.Xplac.WithModule(GovModule).
 				WithMsgType(GovQueryDepositRequestMsgType).
 				WithMsg(msg)
 		}
 	} else {
-		msg, argsType, err := MakeQueryDepositsMsg(queryDepositMsg, e.Xplac.GetGrpcClient(), e.Xplac.GetContext(), e.Xplac.GetLcdURL(), queryType)
+		msg, argsType, err := MakeQueryDepositsMsg(queryDepositMsg, e.Xplac.GetGrpcClient(), e.Xplac.GetContext(), e.Xplac.GetLcdURL(), queryType, e.Xplac.GetPagination())
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
 		if argsType == "params" {
-			e.Xplac.WithModule(GovModule).
+			e.Xplac = e.Xplac.WithModule(GovModule).
 				WithMsgType(GovQueryDepositsParamsMsgType).
 				WithMsg(msg)
 		} else {
-			e.Xplac.WithModule(GovModule).
+			e.Xplac = e.Xplac.WithModule(GovModule).
 				WithMsgType(GovQueryDepositsRequestMsgType).
 				WithMsg(msg)
 		}
@@ -147,21 +147,21 @@ func (e GovExternal) QueryVote(queryVoteMsg types.QueryVoteMsg) provider.XplaCli
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(GovModule).
+		e.Xplac = e.Xplac.WithModule(GovModule).
 			WithMsgType(GovQueryVoteMsgType).
 			WithMsg(msg)
 
 	} else {
-		msg, status, err := MakeQueryVotesMsg(queryVoteMsg, e.Xplac.GetGrpcClient(), e.Xplac.GetContext(), e.Xplac.GetLcdURL(), queryType)
+		msg, status, err := MakeQueryVotesMsg(queryVoteMsg, e.Xplac.GetGrpcClient(), e.Xplac.GetContext(), e.Xplac.GetLcdURL(), queryType, e.Xplac.GetPagination())
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
 		if status == "notPassed" {
-			e.Xplac.WithModule(GovModule).
+			e.Xplac = e.Xplac.WithModule(GovModule).
 				WithMsgType(GovQueryVotesNotPassedMsgType).
 				WithMsg(msg)
 		} else {
-			e.Xplac.WithModule(GovModule).
+			e.Xplac = e.Xplac.WithModule(GovModule).
 				WithMsgType(GovQueryVotesPassedMsgType).
 				WithMsg(msg)
 		}
@@ -182,7 +182,7 @@ func (e GovExternal) Tally(tallyMsg types.TallyMsg) provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(GovModule).
+	e.Xplac = e.Xplac.WithModule(GovModule).
 		WithMsgType(GovTallyMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -191,7 +191,7 @@ func (e GovExternal) Tally(tallyMsg types.TallyMsg) provider.XplaClient {
 // Query parameters of the governance process or the parameters (voting|tallying|deposit) of the governance process.
 func (e GovExternal) GovParams(govParamsMsg ...types.GovParamsMsg) provider.XplaClient {
 	if len(govParamsMsg) == 0 {
-		e.Xplac.WithModule(GovModule).
+		e.Xplac = e.Xplac.WithModule(GovModule).
 			WithMsgType(GovQueryGovParamsMsgType).
 			WithMsg(nil)
 	} else if len(govParamsMsg) == 1 {
@@ -199,25 +199,25 @@ func (e GovExternal) GovParams(govParamsMsg ...types.GovParamsMsg) provider.Xpla
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(GovModule)
+		e.Xplac = e.Xplac.WithModule(GovModule)
 		switch govParamsMsg[0].ParamType {
 		case "voting":
-			e.Xplac.WithMsgType(GovQueryGovParamVotingMsgType)
+			e.Xplac = e.Xplac.WithMsgType(GovQueryGovParamVotingMsgType)
 		case "tallying":
-			e.Xplac.WithMsgType(GovQueryGovParamTallyingMsgType)
+			e.Xplac = e.Xplac.WithMsgType(GovQueryGovParamTallyingMsgType)
 		case "deposit":
-			e.Xplac.WithMsgType(GovQueryGovParamDepositMsgType)
+			e.Xplac = e.Xplac.WithMsgType(GovQueryGovParamDepositMsgType)
 		}
-		e.Xplac.WithMsg(msg)
+		e.Xplac = e.Xplac.WithMsg(msg)
 	} else {
-		provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
+		e.Xplac = provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
 	}
 	return e.Xplac
 }
 
 // Query the proposer of a governance proposal.
 func (e GovExternal) Proposer(proposerMsg types.ProposerMsg) provider.XplaClient {
-	e.Xplac.WithModule(GovModule).
+	e.Xplac = e.Xplac.WithModule(GovModule).
 		WithMsgType(GovQueryProposerMsgType).
 		WithMsg(proposerMsg.ProposalID)
 	return e.Xplac
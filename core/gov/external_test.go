@@ -13,7 +13,7 @@ import (
 )
 
 func (s *IntegrationTestSuite) TestGovTx() {
-	s.xplac.WithPrivateKey(s.accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(s.accounts[0].PrivKey)
 	// submit proposal
 	submitProposalMsg := types.SubmitProposalMsg{
 		Title:       "Test proposal",
@@ -21,9 +21,9 @@ func (s *IntegrationTestSuite) TestGovTx() {
 		Type:        "text",
 		Deposit:     "1000",
 	}
-	s.xplac.SubmitProposal(submitProposalMsg)
+	s.xplac = s.xplac.SubmitProposal(submitProposalMsg)
 
-	makeSubmitProposalMsg, err := mgov.MakeSubmitProposalMsg(submitProposalMsg, s.xplac.GetPrivateKey())
+	makeSubmitProposalMsg, err := mgov.MakeSubmitProposalMsg(submitProposalMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeSubmitProposalMsg, s.xplac.GetMsg())
@@ -42,9 +42,9 @@ func (s *IntegrationTestSuite) TestGovTx() {
 		ProposalID: "1",
 		Deposit:    "1000",
 	}
-	s.xplac.GovDeposit(govDepositMsg)
+	s.xplac = s.xplac.GovDeposit(govDepositMsg)
 
-	makeGovDepositMsg, err := mgov.MakeGovDepositMsg(govDepositMsg, s.xplac.GetPrivateKey())
+	makeGovDepositMsg, err := mgov.MakeGovDepositMsg(govDepositMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeGovDepositMsg, s.xplac.GetMsg())
@@ -63,9 +63,9 @@ func (s *IntegrationTestSuite) TestGovTx() {
 		ProposalID: "1",
 		Option:     "yes",
 	}
-	s.xplac.Vote(voteMsg)
+	s.xplac = s.xplac.Vote(voteMsg)
 
-	makeVoteMsg, err := mgov.MakeVoteMsg(voteMsg, s.xplac.GetPrivateKey())
+	makeVoteMsg, err := mgov.MakeVoteMsg(voteMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeVoteMsg, s.xplac.GetMsg())
@@ -87,9 +87,9 @@ func (s *IntegrationTestSuite) TestGovTx() {
 		Abstain:    "0.05",
 		NoWithVeto: "0.05",
 	}
-	s.xplac.WeightedVote(weightedVoteMsg)
+	s.xplac = s.xplac.WeightedVote(weightedVoteMsg)
 
-	makeWeightedVoteMsg, err := mgov.MakeWeightedVoteMsg(weightedVoteMsg, s.xplac.GetPrivateKey())
+	makeWeightedVoteMsg, err := mgov.MakeWeightedVoteMsg(weightedVoteMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeWeightedVoteMsg, s.xplac.GetMsg())
@@ -121,7 +121,7 @@ func (s *IntegrationTestSuite) TestGov() {
 	queryProposalMsg := types.QueryProposalMsg{
 		ProposalID: "1",
 	}
-	s.xplac.QueryProposal(queryProposalMsg)
+	s.xplac = s.xplac.QueryProposal(queryProposalMsg)
 
 	makeQueryProposalMsg, err := mgov.MakeQueryProposalMsg(queryProposalMsg)
 	s.Require().NoError(err)
@@ -136,9 +136,9 @@ func (s *IntegrationTestSuite) TestGov() {
 		Voter:     s.accounts[0].Address.String(),
 		Depositor: s.accounts[1].Address.String(),
 	}
-	s.xplac.QueryProposals(queryProposalsMsg)
+	s.xplac = s.xplac.QueryProposals(queryProposalsMsg)
 
-	makeQueryProposalsMsg, err := mgov.MakeQueryProposalsMsg(queryProposalsMsg)
+	makeQueryProposalsMsg, err := mgov.MakeQueryProposalsMsg(queryProposalsMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryProposalsMsg, s.xplac.GetMsg())
@@ -148,10 +148,10 @@ func (s *IntegrationTestSuite) TestGov() {
 	var queryType int
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 			queryType = types.QueryLcd
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 			queryType = types.QueryGrpc
 		}
 
@@ -160,7 +160,7 @@ func (s *IntegrationTestSuite) TestGov() {
 			ProposalID: "1",
 			Depositor:  s.accounts[0].Address.String(),
 		}
-		s.xplac.QueryDeposit(queryDepositMsg)
+		s.xplac = s.xplac.QueryDeposit(queryDepositMsg)
 
 		makeQueryDepositMsg, _, err := mgov.MakeQueryDepositMsg(queryDepositMsg, s.xplac.GetGrpcClient(), s.xplac.GetContext(), s.xplac.GetLcdURL(), queryType)
 		s.Require().NoError(err)
@@ -173,9 +173,9 @@ func (s *IntegrationTestSuite) TestGov() {
 		queryDepositMsg = types.QueryDepositMsg{
 			ProposalID: "1",
 		}
-		s.xplac.QueryDeposit(queryDepositMsg)
+		s.xplac = s.xplac.QueryDeposit(queryDepositMsg)
 
-		makeQueryDepositsMsg, _, err := mgov.MakeQueryDepositsMsg(queryDepositMsg, s.xplac.GetGrpcClient(), s.xplac.GetContext(), s.xplac.GetLcdURL(), queryType)
+		makeQueryDepositsMsg, _, err := mgov.MakeQueryDepositsMsg(queryDepositMsg, s.xplac.GetGrpcClient(), s.xplac.GetContext(), s.xplac.GetLcdURL(), queryType, s.xplac.GetPagination())
 		s.Require().NoError(err)
 
 		s.Require().Equal(makeQueryDepositsMsg, s.xplac.GetMsg())
@@ -187,7 +187,7 @@ func (s *IntegrationTestSuite) TestGov() {
 			ProposalID: "1",
 			VoterAddr:  val.Address.String(),
 		}
-		s.xplac.QueryVote(queryVoteMsg)
+		s.xplac = s.xplac.QueryVote(queryVoteMsg)
 
 		makeQueryVoteMsg, err := mgov.MakeQueryVoteMsg(queryVoteMsg, s.xplac.GetGrpcClient(), s.xplac.GetContext(), s.xplac.GetLcdURL(), queryType)
 		s.Require().NoError(err)
@@ -200,9 +200,9 @@ func (s *IntegrationTestSuite) TestGov() {
 		queryVoteMsg = types.QueryVoteMsg{
 			ProposalID: "1",
 		}
-		s.xplac.QueryVote(queryVoteMsg)
+		s.xplac = s.xplac.QueryVote(queryVoteMsg)
 
-		makeQueryVotesMsg, _, err := mgov.MakeQueryVotesMsg(queryVoteMsg, s.xplac.GetGrpcClient(), s.xplac.GetContext(), s.xplac.GetLcdURL(), queryType)
+		makeQueryVotesMsg, _, err := mgov.MakeQueryVotesMsg(queryVoteMsg, s.xplac.GetGrpcClient(), s.xplac.GetContext(), s.xplac.GetLcdURL(), queryType, s.xplac.GetPagination())
 		s.Require().NoError(err)
 
 		s.Require().Equal(makeQueryVotesMsg, s.xplac.GetMsg())
@@ -213,7 +213,7 @@ func (s *IntegrationTestSuite) TestGov() {
 		tallyMsg := types.TallyMsg{
 			ProposalID: "1",
 		}
-		s.xplac.Tally(tallyMsg)
+		s.xplac = s.xplac.Tally(tallyMsg)
 
 		makeGovTallyMsg, err := mgov.MakeGovTallyMsg(tallyMsg, s.xplac.GetGrpcClient(), s.xplac.GetContext(), s.xplac.GetLcdURL(), queryType)
 		s.Require().NoError(err)
@@ -225,7 +225,7 @@ func (s *IntegrationTestSuite) TestGov() {
 	s.xplac = provider.ResetXplac(s.xplac)
 
 	// gov params
-	s.xplac.GovParams()
+	s.xplac = s.xplac.GovParams()
 
 	s.Require().Equal(nil, s.xplac.GetMsg())
 	s.Require().Equal(mgov.GovModule, s.xplac.GetModule())
@@ -235,7 +235,7 @@ func (s *IntegrationTestSuite) TestGov() {
 	govParamsMsg := types.GovParamsMsg{
 		ParamType: "voting",
 	}
-	s.xplac.GovParams(govParamsMsg)
+	s.xplac = s.xplac.GovParams(govParamsMsg)
 
 	makeGovParamsMsg, err := mgov.MakeGovParamsMsg(govParamsMsg)
 	s.Require().NoError(err)
@@ -248,7 +248,7 @@ func (s *IntegrationTestSuite) TestGov() {
 	govParamsMsg = types.GovParamsMsg{
 		ParamType: "tallying",
 	}
-	s.xplac.GovParams(govParamsMsg)
+	s.xplac = s.xplac.GovParams(govParamsMsg)
 
 	makeGovParamsMsg, err = mgov.MakeGovParamsMsg(govParamsMsg)
 	s.Require().NoError(err)
@@ -261,7 +261,7 @@ func (s *IntegrationTestSuite) TestGov() {
 	govParamsMsg = types.GovParamsMsg{
 		ParamType: "deposit",
 	}
-	s.xplac.GovParams(govParamsMsg)
+	s.xplac = s.xplac.GovParams(govParamsMsg)
 
 	makeGovParamsMsg, err = mgov.MakeGovParamsMsg(govParamsMsg)
 	s.Require().NoError(err)
@@ -274,7 +274,7 @@ func (s *IntegrationTestSuite) TestGov() {
 	proposerMsg := types.ProposerMsg{
 		ProposalID: "1",
 	}
-	s.xplac.Proposer(proposerMsg)
+	s.xplac = s.xplac.Proposer(proposerMsg)
 
 	s.Require().Equal(proposerMsg.ProposalID, s.xplac.GetMsg())
 	s.Require().Equal(mgov.GovModule, s.xplac.GetModule())
@@ -3,33 +3,33 @@ package gov
 import (
 	"context"
 
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
 
+	"github.com/cosmos/cosmos-sdk/types/query"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/gogo/protobuf/grpc"
 )
 
 // (Tx) make msg - submit proposal
-func MakeSubmitProposalMsg(submitProposalMsg types.SubmitProposalMsg, privKey key.PrivateKey) (govtypes.MsgSubmitProposal, error) {
-	return parseSubmitProposalArgs(submitProposalMsg, privKey)
+func MakeSubmitProposalMsg(submitProposalMsg types.SubmitProposalMsg, signer types.Signer) (govtypes.MsgSubmitProposal, error) {
+	return parseSubmitProposalArgs(submitProposalMsg, signer)
 }
 
 // (Tx) make msg - deposit
-func MakeGovDepositMsg(govDepositMsg types.GovDepositMsg, privKey key.PrivateKey) (govtypes.MsgDeposit, error) {
-	return parseGovDepositArgs(govDepositMsg, privKey)
+func MakeGovDepositMsg(govDepositMsg types.GovDepositMsg, signer types.Signer) (govtypes.MsgDeposit, error) {
+	return parseGovDepositArgs(govDepositMsg, signer)
 }
 
 // (Tx) make msg - vote
-func MakeVoteMsg(voteMsg types.VoteMsg, privKey key.PrivateKey) (govtypes.MsgVote, error) {
-	return parseVoteArgs(voteMsg, privKey)
+func MakeVoteMsg(voteMsg types.VoteMsg, signer types.Signer) (govtypes.MsgVote, error) {
+	return parseVoteArgs(voteMsg, signer)
 }
 
 // (Tx) make msg - weighted vote
-func MakeWeightedVoteMsg(weightedVoteMsg types.WeightedVoteMsg, privKey key.PrivateKey) (govtypes.MsgVoteWeighted, error) {
-	return parseWeightedVoteArgs(weightedVoteMsg, privKey)
+func MakeWeightedVoteMsg(weightedVoteMsg types.WeightedVoteMsg, signer types.Signer) (govtypes.MsgVoteWeighted, error) {
+	return parseWeightedVoteArgs(weightedVoteMsg, signer)
 }
 
 // (Query) make msg - proposal
@@ -44,8 +44,8 @@ func MakeQueryProposalMsg(queryProposalMsg types.QueryProposalMsg) (govtypes.Que
 }
 
 // (Query) make msg - proposals
-func MakeQueryProposalsMsg(queryProposalsMsg types.QueryProposalsMsg) (govtypes.QueryProposalsRequest, error) {
-	return parseQueryProposalsArgs(queryProposalsMsg)
+func MakeQueryProposalsMsg(queryProposalsMsg types.QueryProposalsMsg, pageReq *query.PageRequest) (govtypes.QueryProposalsRequest, error) {
+	return parseQueryProposalsArgs(queryProposalsMsg, pageReq)
 }
 
 // (Query) make msg - query deposit
@@ -54,8 +54,8 @@ func MakeQueryDepositMsg(queryDepositMsg types.QueryDepositMsg, grpcConn grpc.Cl
 }
 
 // (Query) make msg - query deposits
-func MakeQueryDepositsMsg(queryDepositMsg types.QueryDepositMsg, grpcConn grpc.ClientConn, ctx context.Context, lcdUrl string, queryType int) (interface{}, string, error) {
-	return parseQueryDepositsArgs(queryDepositMsg, grpcConn, ctx, lcdUrl, queryType)
+func MakeQueryDepositsMsg(queryDepositMsg types.QueryDepositMsg, grpcConn grpc.ClientConn, ctx context.Context, lcdUrl string, queryType int, pageReq *query.PageRequest) (interface{}, string, error) {
+	return parseQueryDepositsArgs(queryDepositMsg, grpcConn, ctx, lcdUrl, queryType, pageReq)
 }
 
 // (Query) make msg - tally
@@ -74,6 +74,6 @@ func MakeQueryVoteMsg(queryVoteMsg types.QueryVoteMsg, grpcConn grpc.ClientConn,
 }
 
 // (Query) make msg - query votes
-func MakeQueryVotesMsg(queryVoteMsg types.QueryVoteMsg, grpcConn grpc.ClientConn, ctx context.Context, lcdUrl string, queryType int) (interface{}, string, error) {
-	return parseQueryVotesArgs(queryVoteMsg, grpcConn, ctx, lcdUrl, queryType)
+func MakeQueryVotesMsg(queryVoteMsg types.QueryVoteMsg, grpcConn grpc.ClientConn, ctx context.Context, lcdUrl string, queryType int, pageReq *query.PageRequest) (interface{}, string, error) {
+	return parseQueryVotesArgs(queryVoteMsg, grpcConn, ctx, lcdUrl, queryType, pageReq)
 }
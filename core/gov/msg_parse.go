@@ -3,24 +3,23 @@ package gov
 import (
 	"context"
 
-	"github.com/Moonyongjung/xpriv.go/core"
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
 
 	govv1beta1 "cosmossdk.io/api/cosmos/gov/v1beta1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/gogo/protobuf/grpc"
 )
 
 // Parsing - submit proposal
-func parseSubmitProposalArgs(submitProposalMsg types.SubmitProposalMsg, privKey key.PrivateKey) (govtypes.MsgSubmitProposal, error) {
-	proposer, err := util.GetAddrByPrivKey(privKey)
+func parseSubmitProposalArgs(submitProposalMsg types.SubmitProposalMsg, signer types.Signer) (govtypes.MsgSubmitProposal, error) {
+	proposer, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return govtypes.MsgSubmitProposal{}, util.LogErr(errors.ErrParse, err)
+		return govtypes.MsgSubmitProposal{}, err
 	}
 	amount, err := sdk.ParseCoinsNormalized(util.DenomAdd(submitProposalMsg.Deposit))
 	if err != nil {
@@ -42,14 +41,14 @@ func parseSubmitProposalArgs(submitProposalMsg types.SubmitProposalMsg, privKey
 }
 
 // Parsing - deposit
-func parseGovDepositArgs(govDepositMsg types.GovDepositMsg, privKey key.PrivateKey) (govtypes.MsgDeposit, error) {
+func parseGovDepositArgs(govDepositMsg types.GovDepositMsg, signer types.Signer) (govtypes.MsgDeposit, error) {
 	proposalId, err := util.FromStringToUint64(govDepositMsg.ProposalID)
 	if err != nil {
 		return govtypes.MsgDeposit{}, util.LogErr(errors.ErrParse, err)
 	}
-	from, err := util.GetAddrByPrivKey(privKey)
+	from, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return govtypes.MsgDeposit{}, util.LogErr(errors.ErrParse, err)
+		return govtypes.MsgDeposit{}, err
 	}
 	amount, err := sdk.ParseCoinsNormalized(util.DenomAdd(govDepositMsg.Deposit))
 	if err != nil {
@@ -62,14 +61,14 @@ func parseGovDepositArgs(govDepositMsg types.GovDepositMsg, privKey key.PrivateK
 }
 
 // Parsing - vote
-func parseVoteArgs(voteMsg types.VoteMsg, privKey key.PrivateKey) (govtypes.MsgVote, error) {
+func parseVoteArgs(voteMsg types.VoteMsg, signer types.Signer) (govtypes.MsgVote, error) {
 	proposalId, err := util.FromStringToUint64(voteMsg.ProposalID)
 	if err != nil {
 		return govtypes.MsgVote{}, util.LogErr(errors.ErrParse, err)
 	}
-	from, err := util.GetAddrByPrivKey(privKey)
+	from, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return govtypes.MsgVote{}, util.LogErr(errors.ErrParse, err)
+		return govtypes.MsgVote{}, err
 	}
 
 	byteVoteOption, err := govtypes.VoteOptionFromString(govutils.NormalizeVoteOption(voteMsg.Option))
@@ -82,14 +81,14 @@ func parseVoteArgs(voteMsg types.VoteMsg, privKey key.PrivateKey) (govtypes.MsgV
 }
 
 // Parsing - weighted vote
-func parseWeightedVoteArgs(weightedVoteMsg types.WeightedVoteMsg, privKey key.PrivateKey) (govtypes.MsgVoteWeighted, error) {
+func parseWeightedVoteArgs(weightedVoteMsg types.WeightedVoteMsg, signer types.Signer) (govtypes.MsgVoteWeighted, error) {
 	proposalId, err := util.FromStringToUint64(weightedVoteMsg.ProposalID)
 	if err != nil {
 		return govtypes.MsgVoteWeighted{}, util.LogErr(errors.ErrParse, err)
 	}
-	from, err := util.GetAddrByPrivKey(privKey)
+	from, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return govtypes.MsgVoteWeighted{}, util.LogErr(errors.ErrParse, err)
+		return govtypes.MsgVoteWeighted{}, err
 	}
 
 	options := weightedVoteOptionConverting(weightedVoteMsg)
@@ -104,7 +103,7 @@ func parseWeightedVoteArgs(weightedVoteMsg types.WeightedVoteMsg, privKey key.Pr
 }
 
 // Parsing - proposals
-func parseQueryProposalsArgs(queryProposalsMsg types.QueryProposalsMsg) (govtypes.QueryProposalsRequest, error) {
+func parseQueryProposalsArgs(queryProposalsMsg types.QueryProposalsMsg, pageReq *query.PageRequest) (govtypes.QueryProposalsRequest, error) {
 	depositorAddr := queryProposalsMsg.Depositor
 	voterAddr := queryProposalsMsg.Voter
 	strProposalStatus := queryProposalsMsg.Status
@@ -137,7 +136,7 @@ func parseQueryProposalsArgs(queryProposalsMsg types.QueryProposalsMsg) (govtype
 		ProposalStatus: proposalStatus,
 		Voter:          voterAddr,
 		Depositor:      depositorAddr,
-		Pagination:     core.PageRequest,
+		Pagination:     pageReq,
 	}, nil
 }
 
@@ -195,7 +194,7 @@ func parseQueryDepositArgs(queryDepositMsg types.QueryDepositMsg, grpcConn grpc.
 }
 
 // Parsing - query deposits
-func parseQueryDepositsArgs(queryDepositMsg types.QueryDepositMsg, grpcConn grpc.ClientConn, ctx context.Context, lcdUrl string, queryType int) (interface{}, string, error) {
+func parseQueryDepositsArgs(queryDepositMsg types.QueryDepositMsg, grpcConn grpc.ClientConn, ctx context.Context, lcdUrl string, queryType int, pageReq *query.PageRequest) (interface{}, string, error) {
 	var propStatus govtypes.ProposalStatus
 	proposalId, err := util.FromStringToUint64(queryDepositMsg.ProposalID)
 	if err != nil {
@@ -238,7 +237,7 @@ func parseQueryDepositsArgs(queryDepositMsg types.QueryDepositMsg, grpcConn grpc
 
 	return govtypes.QueryDepositsRequest{
 		ProposalId: proposalId,
-		Pagination: core.PageRequest,
+		Pagination: pageReq,
 	}, "request", nil
 }
 
@@ -323,7 +322,7 @@ func parseQueryVoteArgs(queryVoteMsg types.QueryVoteMsg, grpcConn grpc.ClientCon
 }
 
 // Parsing - query votes
-func parseQueryVotesArgs(queryVoteMsg types.QueryVoteMsg, grpcConn grpc.ClientConn, ctx context.Context, lcdUrl string, queryType int) (interface{}, string, error) {
+func parseQueryVotesArgs(queryVoteMsg types.QueryVoteMsg, grpcConn grpc.ClientConn, ctx context.Context, lcdUrl string, queryType int, pageReq *query.PageRequest) (interface{}, string, error) {
 	var propStatus govtypes.ProposalStatus
 	proposalId, err := util.FromStringToUint64(queryVoteMsg.ProposalID)
 	if err != nil {
@@ -366,7 +365,7 @@ func parseQueryVotesArgs(queryVoteMsg types.QueryVoteMsg, grpcConn grpc.ClientCo
 
 	return govtypes.QueryVotesRequest{
 		ProposalId: proposalId,
-		Pagination: core.PageRequest,
+		Pagination: pageReq,
 	}, "passed", nil
 
 }
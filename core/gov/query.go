@@ -13,12 +13,8 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for gov module.
-func QueryGov(i core.QueryClient) (string, error) {
+func QueryGov(i core.QueryClient) (*core.QueryResponse, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcGov(i)
 	} else {
@@ -26,7 +22,10 @@ func QueryGov(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcGov(i core.QueryClient) (string, error) {
+func queryByGrpcGov(i core.QueryClient) (*core.QueryResponse, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := govtypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -38,7 +37,7 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Gov proposals
@@ -49,7 +48,7 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Gov deposit parameter
@@ -60,12 +59,12 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 
 		clientCtx, err := core.ClientForQuery(i)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		resByTxQuery, err := govutils.QueryDepositByTxQuery(clientCtx, convertMsg)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 		clientCtx.Codec.MustUnmarshalJSON(resByTxQuery, &deposit)
 		res = &deposit
@@ -78,7 +77,7 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Gov deposits parameter
@@ -88,20 +87,16 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 		var deposit govtypes.Deposits
 		clientCtx, err := core.ClientForQuery(i)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		resByTxQuery, err := govutils.QueryDepositsByTxQuery(clientCtx, convertMsg)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		clientCtx.LegacyAmino.MustUnmarshalJSON(resByTxQuery, &deposit)
-		out, err := core.PrintObjectLegacy(i, deposit)
-		if err != nil {
-			return "", util.LogErr(errors.ErrParse, err)
-		}
-		return string(out), nil
+		return core.LegacyQueryResponse(i, deposit), nil
 
 	// Gov deposits
 	case i.Ixplac.GetMsgType() == GovQueryDepositsRequestMsgType:
@@ -111,7 +106,7 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Gov tally
@@ -122,7 +117,7 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Gov params
@@ -132,7 +127,7 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&govtypes.QueryParamsRequest{ParamsType: "voting"},
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		tallyRes, err := queryClient.Params(
@@ -140,7 +135,7 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&govtypes.QueryParamsRequest{ParamsType: "tallying"},
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		depositRes, err := queryClient.Params(
@@ -148,7 +143,7 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&govtypes.QueryParamsRequest{ParamsType: "deposit"},
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		govAllParams := govtypes.NewParams(
@@ -157,11 +152,7 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			depositRes.GetDepositParams(),
 		)
 
-		bytes, err := util.JsonMarshalData(govAllParams)
-		if err != nil {
-			return "", util.LogErr(errors.ErrFailedToMarshal, err)
-		}
-		return string(bytes), nil
+		return core.JsonQueryResponse(i, govAllParams), nil
 
 	// Gov params of voting
 	case i.Ixplac.GetMsgType() == GovQueryGovParamVotingMsgType:
@@ -171,14 +162,10 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
-		bytes, err := util.JsonMarshalData(resParams.GetVotingParams())
-		if err != nil {
-			return "", util.LogErr(errors.ErrFailedToMarshal, err)
-		}
-		return string(bytes), nil
+		return core.JsonQueryResponse(i, resParams.GetVotingParams()), nil
 
 	// Gov params of tally
 	case i.Ixplac.GetMsgType() == GovQueryGovParamTallyingMsgType:
@@ -188,14 +175,10 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
-		bytes, err := util.JsonMarshalData(resParams.GetTallyParams())
-		if err != nil {
-			return "", util.LogErr(errors.ErrFailedToMarshal, err)
-		}
-		return string(bytes), nil
+		return core.JsonQueryResponse(i, resParams.GetTallyParams()), nil
 
 	// Gov params of deposit
 	case i.Ixplac.GetMsgType() == GovQueryGovParamDepositMsgType:
@@ -205,38 +188,30 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
-		bytes, err := util.JsonMarshalData(resParams.GetDepositParams())
-		if err != nil {
-			return "", util.LogErr(errors.ErrFailedToMarshal, err)
-		}
-		return string(bytes), nil
+		return core.JsonQueryResponse(i, resParams.GetDepositParams()), nil
 
 	// Gov proposer
 	case i.Ixplac.GetMsgType() == GovQueryProposerMsgType:
 		convertMsg := i.Ixplac.GetMsg().(string)
 		proposalId, err := util.FromStringToUint64(convertMsg)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		clientCtx, err := core.ClientForQuery(i)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		prop, err := govutils.QueryProposerByTxQuery(clientCtx, proposalId)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
-		bytes, err := util.JsonMarshalData(prop)
-		if err != nil {
-			return "", util.LogErr(errors.ErrFailedToMarshal, err)
-		}
-		return string(bytes), nil
+		return core.JsonQueryResponse(i, prop), nil
 
 	// Gov vote
 	case i.Ixplac.GetMsgType() == GovQueryVoteMsgType:
@@ -246,17 +221,17 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		clientCtx, err := core.ClientForQuery(i)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
 		voterAddr, err := sdk.AccAddressFromBech32(convertMsg.Voter)
 		if err != nil {
-			return "", util.LogErr(errors.ErrParse, err)
+			return nil, util.LogErr(errors.ErrParse, err)
 		}
 
 		vote := resVote.GetVote()
@@ -264,11 +239,11 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			params := govtypes.NewQueryVoteParams(convertMsg.ProposalId, voterAddr)
 			resByTxQuery, err := govutils.QueryVoteByTxQuery(clientCtx, params)
 			if err != nil {
-				return "", util.LogErr(errors.ErrGrpcRequest, err)
+				return nil, util.LogErr(errors.ErrGrpcRequest, err)
 			}
 
 			if err := clientCtx.Codec.UnmarshalJSON(resByTxQuery, &vote); err != nil {
-				return "", util.LogErr(errors.ErrFailedToUnmarshal, err)
+				return nil, util.LogErr(errors.ErrFailedToUnmarshal, err)
 			}
 		}
 
@@ -279,21 +254,17 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 		convertMsg := i.Ixplac.GetMsg().(govtypes.QueryProposalVotesParams)
 		clientCtx, err := core.ClientForQuery(i)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 		resByTxQuery, err := govutils.QueryVotesByTxQuery(clientCtx, convertMsg)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		var votes govtypes.Votes
 
 		clientCtx.LegacyAmino.MustUnmarshalJSON(resByTxQuery, &votes)
-		out, err := core.PrintObjectLegacy(i, votes)
-		if err != nil {
-			return "", util.LogErr(errors.ErrParse, err)
-		}
-		return string(out), nil
+		return core.LegacyQueryResponse(i, votes), nil
 
 	// Gov votes passed
 	case i.Ixplac.GetMsgType() == GovQueryVotesPassedMsgType:
@@ -303,19 +274,14 @@ func queryByGrpcGov(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", err
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	return string(out), nil
+	return core.ProtoQueryResponse(i, res), nil
 }
 
 const (
@@ -326,7 +292,7 @@ const (
 	govVotesLabel     = "votes"
 )
 
-func queryByLcdGov(i core.QueryClient) (string, error) {
+func queryByLcdGov(i core.QueryClient) (*core.QueryResponse, error) {
 	url := util.MakeQueryLcdUrl(govv1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
@@ -372,7 +338,7 @@ func queryByLcdGov(i core.QueryClient) (string, error) {
 
 	// Gov params
 	case i.Ixplac.GetMsgType() == GovQueryGovParamsMsgType:
-		return "", util.LogErr(errors.ErrNotSupport, "unsupported querying all gov params by using LCD. query each parameter(voting|tallying|deposit)")
+		return nil, util.LogErr(errors.ErrNotSupport, "unsupported querying all gov params by using LCD. query each parameter(voting|tallying|deposit)")
 
 	// Gov params of voting
 	case i.Ixplac.GetMsgType() == GovQueryGovParamVotingMsgType:
@@ -394,7 +360,7 @@ func queryByLcdGov(i core.QueryClient) (string, error) {
 
 	// Gov proposer
 	case i.Ixplac.GetMsgType() == GovQueryProposerMsgType:
-		return "", util.LogErr(errors.ErrNotSupport, "unsupported querying proposer by using LCD")
+		return nil, util.LogErr(errors.ErrNotSupport, "unsupported querying proposer by using LCD")
 
 	// Gov vote
 	case i.Ixplac.GetMsgType() == GovQueryVoteMsgType:
@@ -415,14 +381,14 @@ func queryByLcdGov(i core.QueryClient) (string, error) {
 		url = url + util.MakeQueryLabels(govProposalsLabel, util.FromUint64ToString(convertMsg.ProposalId), govVotesLabel)
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+url, i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
+	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+core.LcdPaginationURL(url, i.Ixplac.GetMsg()), i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return string(out), nil
+	return core.RawQueryResponse(i, out), nil
 
 }
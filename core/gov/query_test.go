@@ -97,9 +97,9 @@ func (s *IntegrationTestSuite) TearDownSuite() {
 func (s *IntegrationTestSuite) TestQueryProposal() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		queryProposalMsg := types.QueryProposalMsg{
@@ -125,9 +125,9 @@ func (s *IntegrationTestSuite) TestQueryProposal() {
 func (s *IntegrationTestSuite) TestQueryProposals() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		queryProposalsMsg := types.QueryProposalsMsg{}
@@ -148,9 +148,9 @@ func (s *IntegrationTestSuite) TestDeposit() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		queryDepositMsg := types.QueryDepositMsg{
@@ -177,9 +177,9 @@ func (s *IntegrationTestSuite) TestVote() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		queryVoteMsg := types.QueryVoteMsg{
@@ -209,9 +209,9 @@ func (s *IntegrationTestSuite) TestVote() {
 func (s *IntegrationTestSuite) TestTally() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		tallyMsg := types.TallyMsg{
@@ -236,7 +236,7 @@ func (s *IntegrationTestSuite) TestTally() {
 func (s *IntegrationTestSuite) TestGovParams() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 
 			// only query tally params
 			govParamsMsg := types.GovParamsMsg{
@@ -297,7 +297,7 @@ func (s *IntegrationTestSuite) TestGovParams() {
 			s.Require().Equal("48h0m0s", queryParamsResponse3.DepositParams.MaxDepositPeriod.String())
 
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 
 			// only query tally params
 			govParamsMsg := types.GovParamsMsg{
@@ -21,7 +21,7 @@ func (e MintExternal) MintParams() provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(MintModule).
+	e.Xplac = e.Xplac.WithModule(MintModule).
 		WithMsgType(MintQueryMintParamsMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -33,7 +33,7 @@ func (e MintExternal) Inflation() provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(MintModule).
+	e.Xplac = e.Xplac.WithModule(MintModule).
 		WithMsgType(MintQueryInflationMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -45,7 +45,7 @@ func (e MintExternal) AnnualProvisions() provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(MintModule).
+	e.Xplac = e.Xplac.WithModule(MintModule).
 		WithMsgType(MintQueryAnnualProvisionsMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -4,7 +4,7 @@ import mmint "github.com/Moonyongjung/xpriv.go/core/mint"
 
 func (s *IntegrationTestSuite) TestMint() {
 	// mint params
-	s.xplac.MintParams()
+	s.xplac = s.xplac.MintParams()
 
 	makeQueryMintParamsMsg, err := mmint.MakeQueryMintParamsMsg()
 	s.Require().NoError(err)
@@ -14,7 +14,7 @@ func (s *IntegrationTestSuite) TestMint() {
 	s.Require().Equal(mmint.MintQueryMintParamsMsgType, s.xplac.GetMsgType())
 
 	// inflation
-	s.xplac.Inflation()
+	s.xplac = s.xplac.Inflation()
 
 	makeQueryInflationMsg, err := mmint.MakeQueryInflationMsg()
 	s.Require().NoError(err)
@@ -24,7 +24,7 @@ func (s *IntegrationTestSuite) TestMint() {
 	s.Require().Equal(mmint.MintQueryInflationMsgType, s.xplac.GetMsgType())
 
 	// annual provisions
-	s.xplac.AnnualProvisions()
+	s.xplac = s.xplac.AnnualProvisions()
 
 	makeQueryAnnualProvisionsMsg, err := mmint.MakeQueryAnnualProvisionsMsg()
 	s.Require().NoError(err)
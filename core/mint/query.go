@@ -11,12 +11,8 @@ import (
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for mint module.
-func QueryMint(i core.QueryClient) (string, error) {
+func QueryMint(i core.QueryClient) (*core.QueryResponse, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcMint(i)
 	} else {
@@ -24,7 +20,10 @@ func QueryMint(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcMint(i core.QueryClient) (string, error) {
+func queryByGrpcMint(i core.QueryClient) (*core.QueryResponse, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := minttypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -36,7 +35,7 @@ func queryByGrpcMint(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Mint inflation
@@ -47,7 +46,7 @@ func queryByGrpcMint(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Mint annual provisions
@@ -58,19 +57,14 @@ func queryByGrpcMint(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", err
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	return string(out), nil
+	return core.ProtoQueryResponse(i, res), nil
 }
 
 const (
@@ -79,7 +73,7 @@ const (
 	mintAnnualProvisionsLabel = "annual_provisions"
 )
 
-func queryByLcdMint(i core.QueryClient) (string, error) {
+func queryByLcdMint(i core.QueryClient) (*core.QueryResponse, error) {
 	url := util.MakeQueryLcdUrl(mintv1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
@@ -96,13 +90,13 @@ func queryByLcdMint(i core.QueryClient) (string, error) {
 		url = url + mintAnnualProvisionsLabel
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
 	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+url, i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return string(out), nil
+	return core.RawQueryResponse(i, out), nil
 }
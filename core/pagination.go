@@ -1,14 +1,19 @@
 package core
 
 import (
+	"context"
+	"encoding/base64"
+	neturl "net/url"
+	"reflect"
+	"strings"
+
+	"github.com/Moonyongjung/xpriv.go/provider"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
 	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
-var PageRequest *query.PageRequest
-
 // Set default pagination.
 func DefaultPagination() *query.PageRequest {
 	return &query.PageRequest{
@@ -45,3 +50,258 @@ func ReadPageRequest(pagination types.Pagination) (*query.PageRequest, error) {
 		Reverse:    reverse,
 	}, nil
 }
+
+// Get the page request of the query message.
+// It returns false if the query message does not have the pagination field.
+func GetPageRequest(msg interface{}) (*query.PageRequest, bool) {
+	field, ok := paginationField(reflect.ValueOf(msg), reflect.TypeOf(&query.PageRequest{}))
+	if !ok {
+		return nil, false
+	}
+
+	pageReq, _ := field.Interface().(*query.PageRequest)
+	return pageReq, true
+}
+
+// Copy the query message and set the page request to the pagination field of the copied message.
+func SetPageRequest(msg interface{}, pageReq *query.PageRequest) (interface{}, error) {
+	msgValue := reflect.ValueOf(msg)
+	if !msgValue.IsValid() {
+		return nil, util.LogErr(errors.ErrInvalidRequest, "query message is empty")
+	}
+
+	isPtr := msgValue.Kind() == reflect.Ptr
+	if isPtr {
+		if msgValue.IsNil() {
+			return nil, util.LogErr(errors.ErrInvalidRequest, "query message is empty")
+		}
+		msgValue = msgValue.Elem()
+	}
+
+	copied := reflect.New(msgValue.Type())
+	copied.Elem().Set(msgValue)
+
+	field, ok := paginationField(copied, reflect.TypeOf(pageReq))
+	if !ok {
+		return nil, util.LogErr(errors.ErrInvalidRequest, "query message does not have pagination")
+	}
+	field.Set(reflect.ValueOf(pageReq))
+
+	if isPtr {
+		return copied.Interface(), nil
+	}
+	return copied.Elem().Interface(), nil
+}
+
+// Get the page response of the query response.
+// It returns false if the query response does not have the pagination field.
+func GetPageResponse(res interface{}) (*query.PageResponse, bool) {
+	field, ok := paginationField(reflect.ValueOf(res), reflect.TypeOf(&query.PageResponse{}))
+	if !ok {
+		return nil, false
+	}
+
+	pageRes, _ := field.Interface().(*query.PageResponse)
+	return pageRes, true
+}
+
+// Make URL of LCD query with parameters of the pagination in the query message.
+// The URL is not changed if the query message does not have the pagination.
+func LcdPaginationURL(url string, msg interface{}) string {
+	pageReq, ok := GetPageRequest(msg)
+	if !ok || pageReq == nil {
+		return url
+	}
+
+	params := neturl.Values{}
+	if len(pageReq.Key) > 0 {
+		params.Set("pagination.key", base64.StdEncoding.EncodeToString(pageReq.Key))
+	}
+	if pageReq.Offset > 0 {
+		params.Set("pagination.offset", util.FromUint64ToString(pageReq.Offset))
+	}
+	if pageReq.Limit > 0 {
+		params.Set("pagination.limit", util.FromUint64ToString(pageReq.Limit))
+	}
+	if pageReq.CountTotal {
+		params.Set("pagination.count_total", "true")
+	}
+	if pageReq.Reverse {
+		params.Set("pagination.reverse", "true")
+	}
+
+	if len(params) == 0 {
+		return url
+	}
+
+	if strings.Contains(url, "?") {
+		return url + "&" + params.Encode()
+	}
+	return url + "?" + params.Encode()
+}
+
+func paginationField(v reflect.Value, fieldType reflect.Type) (reflect.Value, bool) {
+	if !v.IsValid() {
+		return reflect.Value{}, false
+	}
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+
+	field := v.FieldByName("Pagination")
+	if !field.IsValid() || field.Type() != fieldType {
+		return reflect.Value{}, false
+	}
+
+	return field, true
+}
+
+// Iterator of pages for the paginated query.
+// It follows the next key of the page response until the last page, by using gRPC or LCD.
+//
+// e.g.
+//
+//	it := core.NewPageIterator[authtypes.QueryAccountsResponse](ctx, xplac.Accounts())
+//	for it.Next() {
+//	    accounts := it.Page().Accounts
+//	}
+//	if it.Err() != nil { ... }
+type PageIterator[T any] struct {
+	ctx     context.Context
+	xplac   provider.XplaClient
+	pageReq *query.PageRequest
+	page    *T
+	err     error
+	done    bool
+}
+
+// Make the page iterator of the query which is set by the module method of the xpla client.
+// The pagination of the xpla client is applied to the first page, and the limit of it is used
+// as the page size of next pages. The context is used for all queries of pages.
+func NewPageIterator[T any](ctx context.Context, xplac provider.XplaClient) *PageIterator[T] {
+	it := &PageIterator[T]{ctx: ctx, xplac: xplac}
+	if xplac.GetErr() != nil {
+		it.err = xplac.GetErr()
+		return it
+	}
+
+	pageReq, ok := GetPageRequest(xplac.GetMsg())
+	if !ok {
+		it.err = util.LogErr(errors.ErrInvalidRequest, "the query does not support pagination, msg type:", xplac.GetMsgType())
+		return it
+	}
+	if pageReq == nil {
+		pageReq = DefaultPagination()
+	}
+	it.pageReq = pageReq
+
+	return it
+}
+
+// Query the next page. It returns false when there is no more page, the error is occurred
+// or the context is done. Check Err after the iteration is finished.
+func (it *PageIterator[T]) Next() bool {
+	if it.done || it.err != nil {
+		return false
+	}
+
+	if err := it.ctx.Err(); err != nil {
+		it.err = util.LogErr(errors.ErrTimeout, err)
+		return false
+	}
+
+	msg, err := SetPageRequest(it.xplac.GetMsg(), it.pageReq)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	var page T
+	err = it.xplac.WithContext(it.ctx).WithMsg(msg).QueryTyped(&page)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	it.page = &page
+
+	pageRes, _ := GetPageResponse(&page)
+	if pageRes == nil || len(pageRes.NextKey) == 0 {
+		it.done = true
+		return true
+	}
+
+	// Next pages are requested by the key, so the offset and the count total are not used.
+	it.pageReq = &query.PageRequest{
+		Key:     pageRes.NextKey,
+		Limit:   it.pageReq.Limit,
+		Reverse: it.pageReq.Reverse,
+	}
+
+	return true
+}
+
+// Get the current page.
+func (it *PageIterator[T]) Page() *T {
+	return it.page
+}
+
+// Get the error which is occurred during the iteration.
+func (it *PageIterator[T]) Err() error {
+	return it.err
+}
+
+// Set the page size of the next page.
+func (it *PageIterator[T]) setLimit(limit uint64) {
+	pageReq := *it.pageReq
+	pageReq.Limit = limit
+	it.pageReq = &pageReq
+}
+
+// Query all pages of the paginated query and collect items of pages.
+// The items function selects items in the page response, e.g. the accounts of the accounts response.
+// If max items is larger than 0, the query is stopped when the number of collected items reaches max items.
+//
+// e.g.
+//
+//	accounts, err := core.QueryAll(ctx, xplac.Accounts(), func(res *authtypes.QueryAccountsResponse) []*codectypes.Any {
+//	    return res.Accounts
+//	}, 1000)
+func QueryAll[T any, I any](ctx context.Context, xplac provider.XplaClient, items func(*T) []I, maxItems ...int) ([]I, error) {
+	var max int
+	if len(maxItems) > 0 {
+		max = maxItems[0]
+	}
+
+	it := NewPageIterator[T](ctx, xplac)
+
+	var all []I
+	for {
+		if max > 0 {
+			remaining := uint64(max - len(all))
+			if it.pageReq != nil && (it.pageReq.Limit == 0 || it.pageReq.Limit > remaining) {
+				it.setLimit(remaining)
+			}
+		}
+
+		if !it.Next() {
+			break
+		}
+
+		all = append(all, items(it.Page())...)
+		if max > 0 && len(all) >= max {
+			return all[:max], nil
+		}
+	}
+
+	if it.Err() != nil {
+		return nil, it.Err()
+	}
+
+	return all, nil
+}
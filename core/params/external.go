@@ -18,11 +18,11 @@ func NewParamsExternal(xplac provider.XplaClient) (e ParamsExternal) {
 
 // Submit a parameter change proposal.
 func (e ParamsExternal) ParamChange(paramChangeMsg types.ParamChangeMsg) provider.XplaClient {
-	msg, err := MakeProposalParamChangeMsg(paramChangeMsg, e.Xplac.GetPrivateKey(), e.Xplac.GetEncoding())
+	msg, err := MakeProposalParamChangeMsg(paramChangeMsg, e.Xplac.GetSigner(), e.Xplac.GetEncoding())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(ParamsModule).
+	e.Xplac = e.Xplac.WithModule(ParamsModule).
 		WithMsgType(ParamsProposalParamChangeMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -36,7 +36,7 @@ func (e ParamsExternal) QuerySubspace(subspaceMsg types.SubspaceMsg) provider.Xp
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(ParamsModule).
+	e.Xplac = e.Xplac.WithModule(ParamsModule).
 		WithMsgType(ParamsQuerySubpsaceMsgType).
 		WithMsg(msg)
 
@@ -13,7 +13,7 @@ func (s *IntegrationTestSuite) TestParamsTx() {
 	r := rand.New(src)
 	accounts := testutil.RandomAccounts(r, 2)
 
-	s.xplac.WithPrivateKey(accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(accounts[0].PrivKey)
 	// change params
 	paramChangeMsg := types.ParamChangeMsg{
 		Title:       "Staking param change",
@@ -27,9 +27,9 @@ func (s *IntegrationTestSuite) TestParamsTx() {
 		},
 		Deposit: "1000",
 	}
-	s.xplac.ParamChange(paramChangeMsg)
+	s.xplac = s.xplac.ParamChange(paramChangeMsg)
 
-	makeProposalParamChangeMsg, err := mparams.MakeProposalParamChangeMsg(paramChangeMsg, s.xplac.GetPrivateKey(), s.xplac.GetEncoding())
+	makeProposalParamChangeMsg, err := mparams.MakeProposalParamChangeMsg(paramChangeMsg, s.xplac.GetSigner(), s.xplac.GetEncoding())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeProposalParamChangeMsg, s.xplac.GetMsg())
@@ -50,7 +50,7 @@ func (s *IntegrationTestSuite) TestParams() {
 		Subspace: "staking",
 		Key:      "MaxValidators",
 	}
-	s.xplac.QuerySubspace(subspaceMsg)
+	s.xplac = s.xplac.QuerySubspace(subspaceMsg)
 
 	makeQueryParamsSubspaceMsg, err := mparams.MakeQueryParamsSubspaceMsg(subspaceMsg)
 	s.Require().NoError(err)
@@ -13,7 +13,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 	src := rand.NewSource(1)
 	r := rand.New(src)
 	accounts := testutil.RandomAccounts(r, 2)
-	s.xplac.WithPrivateKey(accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(accounts[0].PrivKey)
 
 	c := params.NewCoreModule()
 
@@ -38,7 +38,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 		Deposit: "1000",
 	}
 
-	makeProposalParamChangeMsg, err := params.MakeProposalParamChangeMsg(paramChangeMsg, s.xplac.GetPrivateKey(), s.xplac.GetEncoding())
+	makeProposalParamChangeMsg, err := params.MakeProposalParamChangeMsg(paramChangeMsg, s.xplac.GetSigner(), s.xplac.GetEncoding())
 	s.Require().NoError(err)
 
 	testMsg = makeProposalParamChangeMsg
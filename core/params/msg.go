@@ -1,7 +1,6 @@
 package params
 
 import (
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 
 	"github.com/Moonyongjung/xpla-private-chain/app/params"
@@ -10,8 +9,8 @@ import (
 )
 
 // (Tx) make msg - param change
-func MakeProposalParamChangeMsg(paramChangeMsg types.ParamChangeMsg, privKey key.PrivateKey, encodingConfig params.EncodingConfig) (govtypes.MsgSubmitProposal, error) {
-	return parseProposalParamChangeArgs(paramChangeMsg, privKey, encodingConfig)
+func MakeProposalParamChangeMsg(paramChangeMsg types.ParamChangeMsg, signer types.Signer, encodingConfig params.EncodingConfig) (govtypes.MsgSubmitProposal, error) {
+	return parseProposalParamChangeArgs(paramChangeMsg, signer, encodingConfig)
 }
 
 // (Query) make msg - subspace
@@ -1,7 +1,6 @@
 package params
 
 import (
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
@@ -14,7 +13,7 @@ import (
 )
 
 // Parsing - param change
-func parseProposalParamChangeArgs(paramChangeMsg types.ParamChangeMsg, privKey key.PrivateKey, encodingConfig params.EncodingConfig) (govtypes.MsgSubmitProposal, error) {
+func parseProposalParamChangeArgs(paramChangeMsg types.ParamChangeMsg, signer types.Signer, encodingConfig params.EncodingConfig) (govtypes.MsgSubmitProposal, error) {
 	var proposal paramscutils.ParamChangeProposalJSON
 	var err error
 
@@ -45,9 +44,9 @@ func parseProposalParamChangeArgs(paramChangeMsg types.ParamChangeMsg, privKey k
 		return govtypes.MsgSubmitProposal{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	from, err := util.GetAddrByPrivKey(privKey)
+	from, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return govtypes.MsgSubmitProposal{}, util.LogErr(errors.ErrParse, err)
+		return govtypes.MsgSubmitProposal{}, err
 	}
 	content := paramsproposal.NewParameterChangeProposal(
 		proposal.Title, proposal.Description, proposal.Changes.ToParamChanges(),
@@ -11,12 +11,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for params module.
-func QueryParams(i core.QueryClient) (string, error) {
+func QueryParams(i core.QueryClient) (*core.QueryResponse, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcParams(i)
 	} else {
@@ -25,7 +21,10 @@ func QueryParams(i core.QueryClient) (string, error) {
 
 }
 
-func queryByGrpcParams(i core.QueryClient) (string, error) {
+func queryByGrpcParams(i core.QueryClient) (*core.QueryResponse, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := proposal.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -37,26 +36,21 @@ func queryByGrpcParams(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", err
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	return string(out), nil
+	return core.ProtoQueryResponse(i, res), nil
 }
 
 const (
 	paramsParamsLabel = "params"
 )
 
-func queryByLcdParams(i core.QueryClient) (string, error) {
+func queryByLcdParams(i core.QueryClient) (*core.QueryResponse, error) {
 	url := util.MakeQueryLcdUrl(paramsv1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
@@ -73,13 +67,13 @@ func queryByLcdParams(i core.QueryClient) (string, error) {
 		url = url + paramsParamsLabel + subspace + key
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
 	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+url, i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return string(out), nil
+	return core.RawQueryResponse(i, out), nil
 }
@@ -18,11 +18,11 @@ func NewPrivateExternal(xplac provider.XplaClient) (e PrivateExternal) {
 
 // Set the initial administrator of the private chain
 func (e PrivateExternal) InitialAdmin(initialAdminMsg types.InitialAdminMsg) provider.XplaClient {
-	msg, err := MakeInitialAdminMsg(initialAdminMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeInitialAdminMsg(initialAdminMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(PrivateModule).
+	e.Xplac = e.Xplac.WithModule(PrivateModule).
 		WithMsgType(PrivateInitialAdminMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -30,11 +30,11 @@ func (e PrivateExternal) InitialAdmin(initialAdminMsg types.InitialAdminMsg) pro
 
 // Enroll additional admin of the private chain
 func (e PrivateExternal) AddAdmin(addAdminMsg types.AddAdminMsg) provider.XplaClient {
-	msg, err := MakeAddAdminMsg(addAdminMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeAddAdminMsg(addAdminMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(PrivateModule).
+	e.Xplac = e.Xplac.WithModule(PrivateModule).
 		WithMsgType(PrivateAddAdminMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -42,11 +42,11 @@ func (e PrivateExternal) AddAdmin(addAdminMsg types.AddAdminMsg) provider.XplaCl
 
 // Request to participate to the private chain.
 func (e PrivateExternal) Participate(participateMsg types.ParticipateMsg) provider.XplaClient {
-	msg, err := MakeParticipateMsg(participateMsg, e.Xplac.GetLcdURL(), e.Xplac.GetGrpcUrl(), e.Xplac.GetGrpcClient(), e.Xplac.GetPrivateKey(), e.Xplac.GetContext())
+	msg, err := MakeParticipateMsg(participateMsg, e.Xplac.GetLcdURL(), e.Xplac.GetGrpcUrl(), e.Xplac.GetGrpcClient(), e.Xplac.GetSigner(), e.Xplac.GetContext())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(PrivateModule).
+	e.Xplac = e.Xplac.WithModule(PrivateModule).
 		WithMsgType(PrivateParticipateMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -54,11 +54,11 @@ func (e PrivateExternal) Participate(participateMsg types.ParticipateMsg) provid
 
 // Accept a participant to join the private chain
 func (e PrivateExternal) Accept(acceptMsg types.AcceptMsg) provider.XplaClient {
-	msg, err := MakeAcceptMsg(acceptMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeAcceptMsg(acceptMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(PrivateModule).
+	e.Xplac = e.Xplac.WithModule(PrivateModule).
 		WithMsgType(PrivateAcceptMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -66,11 +66,11 @@ func (e PrivateExternal) Accept(acceptMsg types.AcceptMsg) provider.XplaClient {
 
 // Deny a participant to join the private chain
 func (e PrivateExternal) Deny(denyMsg types.DenyMsg) provider.XplaClient {
-	msg, err := MakeDenyMsg(denyMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeDenyMsg(denyMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(PrivateModule).
+	e.Xplac = e.Xplac.WithModule(PrivateModule).
 		WithMsgType(PrivateDenyMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -78,11 +78,11 @@ func (e PrivateExternal) Deny(denyMsg types.DenyMsg) provider.XplaClient {
 
 // Exile a participant from private chain
 func (e PrivateExternal) Exile(exileMsg types.ExileMsg) provider.XplaClient {
-	msg, err := MakeExileMsg(exileMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeExileMsg(exileMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(PrivateModule).
+	e.Xplac = e.Xplac.WithModule(PrivateModule).
 		WithMsgType(PrivateExileMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -90,11 +90,11 @@ func (e PrivateExternal) Exile(exileMsg types.ExileMsg) provider.XplaClient {
 
 // Quit from private chain
 func (e PrivateExternal) Quit(quitMsg types.QuitMsg) provider.XplaClient {
-	msg, err := MakeQuitMsg(quitMsg, e.Xplac.GetLcdURL(), e.Xplac.GetGrpcUrl(), e.Xplac.GetGrpcClient(), e.Xplac.GetPrivateKey(), e.Xplac.GetContext())
+	msg, err := MakeQuitMsg(quitMsg, e.Xplac.GetLcdURL(), e.Xplac.GetGrpcUrl(), e.Xplac.GetGrpcClient(), e.Xplac.GetSigner(), e.Xplac.GetContext())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(PrivateModule).
+	e.Xplac = e.Xplac.WithModule(PrivateModule).
 		WithMsgType(PrivateQuitMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -108,7 +108,7 @@ func (e PrivateExternal) Admin() provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(PrivateModule).
+	e.Xplac = e.Xplac.WithModule(PrivateModule).
 		WithMsgType(PrivateQueryAdminMsgType).
 		WithMsg(msg)
 
@@ -121,7 +121,7 @@ func (e PrivateExternal) ParticipateState(participateStateMsg types.ParticipateS
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(PrivateModule).
+	e.Xplac = e.Xplac.WithModule(PrivateModule).
 		WithMsgType(PrivateParticipateStateMsgType).
 		WithMsg(msg)
 
@@ -134,7 +134,7 @@ func (e PrivateExternal) ParticipateSequence(participateSequenceMsg types.Partic
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(PrivateModule).
+	e.Xplac = e.Xplac.WithModule(PrivateModule).
 		WithMsgType(PrivateParticipateSequenceMsgType).
 		WithMsg(msg)
 
@@ -147,7 +147,7 @@ func (e PrivateExternal) GenDIDSign(genDIDSignMsg types.GenDIDSignMsg) provider.
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(PrivateModule).
+	e.Xplac = e.Xplac.WithModule(PrivateModule).
 		WithMsgType(PrivateGenDIDSignMsgType).
 		WithMsg(msg)
 
@@ -161,7 +161,7 @@ func (e PrivateExternal) IssueVC(issueVCMsg types.IssueVCMsg) provider.XplaClien
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(PrivateModule).
+	e.Xplac = e.Xplac.WithModule(PrivateModule).
 		WithMsgType(PrivateIssueVCMsgType).
 		WithMsg(msg)
 
@@ -176,7 +176,7 @@ func (e PrivateExternal) GetVP(getVPMsg types.GetVPMsg) provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(PrivateModule).
+	e.Xplac = e.Xplac.WithModule(PrivateModule).
 		WithMsgType(PrivateGetVPMsgType).
 		WithMsg(msg)
 
@@ -185,11 +185,11 @@ func (e PrivateExternal) GetVP(getVPMsg types.GetVPMsg) provider.XplaClient {
 
 // Query to check all participate state under review
 func (e PrivateExternal) AllUnderReviews() provider.XplaClient {
-	msg, err := MakeAllUnderReviewsMsg()
+	msg, err := MakeAllUnderReviewsMsg(e.Xplac.GetPagination())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(PrivateModule).
+	e.Xplac = e.Xplac.WithModule(PrivateModule).
 		WithMsgType(PrivateAllUnderReviewsMsgType).
 		WithMsg(msg)
 
@@ -198,11 +198,11 @@ func (e PrivateExternal) AllUnderReviews() provider.XplaClient {
 
 // Query all participants
 func (e PrivateExternal) AllParticipants() provider.XplaClient {
-	msg, err := MakeAllParticipantsMsg()
+	msg, err := MakeAllParticipantsMsg(e.Xplac.GetPagination())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(PrivateModule).
+	e.Xplac = e.Xplac.WithModule(PrivateModule).
 		WithMsgType(PrivateAllParticipantsMsgType).
 		WithMsg(msg)
 
@@ -3,47 +3,46 @@ package private
 import (
 	"context"
 
-	"github.com/Moonyongjung/xpriv.go/core"
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/gogo/protobuf/grpc"
 
 	privtypes "github.com/Moonyongjung/xpla-private-chain/x/private/types"
 )
 
 // (Tx) make msg - initial admin
-func MakeInitialAdminMsg(initialAdminMsg types.InitialAdminMsg, privKey key.PrivateKey) (privtypes.MsgInitialAdmin, error) {
-	return parseInitialAdminArgs(initialAdminMsg, privKey)
+func MakeInitialAdminMsg(initialAdminMsg types.InitialAdminMsg, signer types.Signer) (privtypes.MsgInitialAdmin, error) {
+	return parseInitialAdminArgs(initialAdminMsg, signer)
 }
 
 // (Tx) make msg - add admin
-func MakeAddAdminMsg(addAdminMsg types.AddAdminMsg, privKey key.PrivateKey) (privtypes.MsgAddAdmin, error) {
-	return parseAddAdminArgs(addAdminMsg, privKey)
+func MakeAddAdminMsg(addAdminMsg types.AddAdminMsg, signer types.Signer) (privtypes.MsgAddAdmin, error) {
+	return parseAddAdminArgs(addAdminMsg, signer)
 }
 
 // (Tx) make msg - participate
-func MakeParticipateMsg(participateMsg types.ParticipateMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, privKey key.PrivateKey, ctx context.Context) (privtypes.MsgParticipate, error) {
-	return parseParticipateArgs(participateMsg, lcdUrl, grpcUrl, grpcConn, privKey, ctx)
+func MakeParticipateMsg(participateMsg types.ParticipateMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, signer types.Signer, ctx context.Context) (privtypes.MsgParticipate, error) {
+	return parseParticipateArgs(participateMsg, lcdUrl, grpcUrl, grpcConn, signer, ctx)
 }
 
 // (Tx) make msg - accept
-func MakeAcceptMsg(acceptMsg types.AcceptMsg, privKey key.PrivateKey) (privtypes.MsgAccept, error) {
-	return parseAcceptArgs(acceptMsg, privKey)
+func MakeAcceptMsg(acceptMsg types.AcceptMsg, signer types.Signer) (privtypes.MsgAccept, error) {
+	return parseAcceptArgs(acceptMsg, signer)
 }
 
 // (Tx) make msg - deny
-func MakeDenyMsg(denyMsg types.DenyMsg, privKey key.PrivateKey) (privtypes.MsgDeny, error) {
-	return parseDenyArgs(denyMsg, privKey)
+func MakeDenyMsg(denyMsg types.DenyMsg, signer types.Signer) (privtypes.MsgDeny, error) {
+	return parseDenyArgs(denyMsg, signer)
 }
 
 // (Tx) make msg - exile
-func MakeExileMsg(exileMsg types.ExileMsg, privKey key.PrivateKey) (privtypes.MsgExile, error) {
-	return parseExileArgs(exileMsg, privKey)
+func MakeExileMsg(exileMsg types.ExileMsg, signer types.Signer) (privtypes.MsgExile, error) {
+	return parseExileArgs(exileMsg, signer)
 }
 
 // (Tx) make msg - quit
-func MakeQuitMsg(quitMsg types.QuitMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, privKey key.PrivateKey, ctx context.Context) (privtypes.MsgQuit, error) {
-	return parseQuitArgs(quitMsg, lcdUrl, grpcUrl, grpcConn, privKey, ctx)
+func MakeQuitMsg(quitMsg types.QuitMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, signer types.Signer, ctx context.Context) (privtypes.MsgQuit, error) {
+	return parseQuitArgs(quitMsg, lcdUrl, grpcUrl, grpcConn, signer, ctx)
 }
 
 // (Query) make msg - query admin
@@ -81,15 +80,15 @@ func MakeGetVPMsg(getVPMsg types.GetVPMsg) (privtypes.QueryGetVPRequest, error)
 }
 
 // (Query) make msg - all under reviews
-func MakeAllUnderReviewsMsg() (privtypes.QueryAllUnderReviewsRequest, error) {
+func MakeAllUnderReviewsMsg(pageReq *query.PageRequest) (privtypes.QueryAllUnderReviewsRequest, error) {
 	return privtypes.QueryAllUnderReviewsRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageReq,
 	}, nil
 }
 
 // (Query) make msg - all participants
-func MakeAllParticipantsMsg() (privtypes.QueryAllParticipantsRequest, error) {
+func MakeAllParticipantsMsg(pageReq *query.PageRequest) (privtypes.QueryAllParticipantsRequest, error) {
 	return privtypes.QueryAllParticipantsRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageReq,
 	}, nil
 }
@@ -11,34 +11,32 @@ import (
 	"github.com/gogo/protobuf/grpc"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 
-	xcrypto "github.com/Moonyongjung/xpla-private-chain/crypto"
-	didcrypto "github.com/Moonyongjung/xpla-private-chain/x/did/client/crypto"
 	didtypes "github.com/Moonyongjung/xpla-private-chain/x/did/types"
 	privtypes "github.com/Moonyongjung/xpla-private-chain/x/private/types"
 )
 
 // Parsing - initial admin
-func parseInitialAdminArgs(initialAdminMsg types.InitialAdminMsg, privKey key.PrivateKey) (privtypes.MsgInitialAdmin, error) {
+func parseInitialAdminArgs(initialAdminMsg types.InitialAdminMsg, signer types.Signer) (privtypes.MsgInitialAdmin, error) {
 	did, didKey, err := privtypes.ParseDIDKey(initialAdminMsg.InitAdminDIDKey)
 	if err != nil {
 		return privtypes.MsgInitialAdmin{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	base64Proof, err := makeBase64Proof(did, didKey, did, initialAdminMsg.DIDKeyPath, initialAdminMsg.DIDPassphrase)
+	base64Proof, err := makeBase64Proof(did, didKey, did, initialAdminMsg.DIDKeyStore, initialAdminMsg.DIDKeyPath, initialAdminMsg.DIDPassphrase)
 	if err != nil {
 		return privtypes.MsgInitialAdmin{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	fromAddress, err := util.GetAddrByPrivKey(privKey)
+	fromAddress, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return privtypes.MsgInitialAdmin{}, util.LogErr(errors.ErrParse, err)
+		return privtypes.MsgInitialAdmin{}, err
 	}
 
 	return privtypes.NewMsgInitialAdmin(didKey, base64Proof, fromAddress.String()), nil
 }
 
 // Parsing - add admin
-func parseAddAdminArgs(addAdminMsg types.AddAdminMsg, privKey key.PrivateKey) (privtypes.MsgAddAdmin, error) {
+func parseAddAdminArgs(addAdminMsg types.AddAdminMsg, signer types.Signer) (privtypes.MsgAddAdmin, error) {
 	newAdminDID, newAdminDIDKey, err := privtypes.ParseDIDKey(addAdminMsg.NewAdminDIDKey)
 	if err != nil {
 		return privtypes.MsgAddAdmin{}, util.LogErr(errors.ErrParse, err)
@@ -49,21 +47,21 @@ func parseAddAdminArgs(addAdminMsg types.AddAdminMsg, privKey key.PrivateKey) (p
 		return privtypes.MsgAddAdmin{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	base64Proof, err := makeBase64Proof(initAdminDID, initAdminDIDKey, newAdminDID, addAdminMsg.InitAdminDIDKeyPath, addAdminMsg.InitAdminDIDPassphrase)
+	base64Proof, err := makeBase64Proof(initAdminDID, initAdminDIDKey, newAdminDID, addAdminMsg.InitAdminDIDKeyStore, addAdminMsg.InitAdminDIDKeyPath, addAdminMsg.InitAdminDIDPassphrase)
 	if err != nil {
 		return privtypes.MsgAddAdmin{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	fromAddress, err := util.GetAddrByPrivKey(privKey)
+	fromAddress, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return privtypes.MsgAddAdmin{}, util.LogErr(errors.ErrParse, err)
+		return privtypes.MsgAddAdmin{}, err
 	}
 
 	return privtypes.NewMsgAddAdmin(addAdminMsg.NewAdminAddress, newAdminDIDKey, initAdminDIDKey, base64Proof, fromAddress.String()), nil
 }
 
 // Parsing - participate
-func parseParticipateArgs(participantMsg types.ParticipateMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, privKey key.PrivateKey, ctx context.Context) (privtypes.MsgParticipate, error) {
+func parseParticipateArgs(participantMsg types.ParticipateMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, signer types.Signer, ctx context.Context) (privtypes.MsgParticipate, error) {
 	did, didKey, err := privtypes.ParseDIDKey(participantMsg.ParticipantDIDKey)
 	if err != nil {
 		return privtypes.MsgParticipate{}, util.LogErr(errors.ErrParse, err)
@@ -76,21 +74,21 @@ func parseParticipateArgs(participantMsg types.ParticipateMsg, lcdUrl, grpcUrl s
 
 	didSeq := util.FromUint64ToString(didDocumentWithSeq.Sequence)
 
-	didSigBase64, err := createSign(did, didKey, did, didSeq, participantMsg.DIDKeyPath, participantMsg.DIDPassphrase)
+	didSigBase64, err := createSign(did, didKey, did, didSeq, participantMsg.DIDKeyStore, participantMsg.DIDKeyPath, participantMsg.DIDPassphrase)
 	if err != nil {
 		return privtypes.MsgParticipate{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	fromAddress, err := util.GetAddrByPrivKey(privKey)
+	fromAddress, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return privtypes.MsgParticipate{}, util.LogErr(errors.ErrParse, err)
+		return privtypes.MsgParticipate{}, err
 	}
 
 	return privtypes.NewMsgParticipate(didKey, didSigBase64, fromAddress.String()), nil
 }
 
 // Parsing - accept
-func parseAcceptArgs(acceptMsg types.AcceptMsg, privKey key.PrivateKey) (privtypes.MsgAccept, error) {
+func parseAcceptArgs(acceptMsg types.AcceptMsg, signer types.Signer) (privtypes.MsgAccept, error) {
 	participantDID, err := didtypes.ParseDID(acceptMsg.ParticipantDID)
 	if err != nil {
 		return privtypes.MsgAccept{}, util.LogErr(errors.ErrParse, err)
@@ -101,12 +99,12 @@ func parseAcceptArgs(acceptMsg types.AcceptMsg, privKey key.PrivateKey) (privtyp
 		return privtypes.MsgAccept{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	fromAddress, err := util.GetAddrByPrivKey(privKey)
+	fromAddress, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return privtypes.MsgAccept{}, util.LogErr(errors.ErrParse, err)
+		return privtypes.MsgAccept{}, err
 	}
 
-	base64Proof, err := makeBase64Proof(adminDID, adminDIDKey, participantDID, acceptMsg.AdminDIDKeyPath, acceptMsg.AdminDIDPassphrase)
+	base64Proof, err := makeBase64Proof(adminDID, adminDIDKey, participantDID, acceptMsg.AdminDIDKeyStore, acceptMsg.AdminDIDKeyPath, acceptMsg.AdminDIDPassphrase)
 	if err != nil {
 		return privtypes.MsgAccept{}, util.LogErr(errors.ErrParse, err)
 	}
@@ -115,7 +113,7 @@ func parseAcceptArgs(acceptMsg types.AcceptMsg, privKey key.PrivateKey) (privtyp
 }
 
 // Parsing - deny
-func parseDenyArgs(denyMsg types.DenyMsg, privKey key.PrivateKey) (privtypes.MsgDeny, error) {
+func parseDenyArgs(denyMsg types.DenyMsg, signer types.Signer) (privtypes.MsgDeny, error) {
 	participandDID, err := didtypes.ParseDID(denyMsg.ParticipantDID)
 	if err != nil {
 		return privtypes.MsgDeny{}, util.LogErr(errors.ErrParse, err)
@@ -126,31 +124,31 @@ func parseDenyArgs(denyMsg types.DenyMsg, privKey key.PrivateKey) (privtypes.Msg
 		return privtypes.MsgDeny{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	fromAddress, err := util.GetAddrByPrivKey(privKey)
+	fromAddress, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return privtypes.MsgDeny{}, util.LogErr(errors.ErrParse, err)
+		return privtypes.MsgDeny{}, err
 	}
 
 	return privtypes.NewMsgDeny(participandDID, adminDID, fromAddress.String()), nil
 }
 
 // Parsing - exile
-func parseExileArgs(exileMsg types.ExileMsg, privKey key.PrivateKey) (privtypes.MsgExile, error) {
+func parseExileArgs(exileMsg types.ExileMsg, signer types.Signer) (privtypes.MsgExile, error) {
 	participantDID, err := didtypes.ParseDID(exileMsg.ParticipantDID)
 	if err != nil {
 		return privtypes.MsgExile{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	fromAddress, err := util.GetAddrByPrivKey(privKey)
+	fromAddress, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return privtypes.MsgExile{}, util.LogErr(errors.ErrParse, err)
+		return privtypes.MsgExile{}, err
 	}
 
 	return privtypes.NewMsgExile(participantDID, fromAddress.String()), nil
 }
 
 // Parsing - quit
-func parseQuitArgs(quitMsg types.QuitMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, privKey key.PrivateKey, ctx context.Context) (privtypes.MsgQuit, error) {
+func parseQuitArgs(quitMsg types.QuitMsg, lcdUrl, grpcUrl string, grpcConn grpc.ClientConn, signer types.Signer, ctx context.Context) (privtypes.MsgQuit, error) {
 	did, didKey, err := privtypes.ParseDIDKey(quitMsg.ParticipantDIDKey)
 	if err != nil {
 		return privtypes.MsgQuit{}, util.LogErr(errors.ErrParse, err)
@@ -163,14 +161,14 @@ func parseQuitArgs(quitMsg types.QuitMsg, lcdUrl, grpcUrl string, grpcConn grpc.
 
 	didSeq := util.FromUint64ToString(document.Sequence)
 
-	didSigBase64, err := createSign(did, didKey, did, didSeq, quitMsg.DIDKeyPath, quitMsg.DIDPassphrase)
+	didSigBase64, err := createSign(did, didKey, did, didSeq, quitMsg.DIDKeyStore, quitMsg.DIDKeyPath, quitMsg.DIDPassphrase)
 	if err != nil {
 		return privtypes.MsgQuit{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	fromAddress, err := util.GetAddrByPrivKey(privKey)
+	fromAddress, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return privtypes.MsgQuit{}, util.LogErr(errors.ErrParse, err)
+		return privtypes.MsgQuit{}, err
 	}
 
 	return privtypes.NewMsgQuit(didKey, didSigBase64, fromAddress.String()), nil
@@ -190,7 +188,7 @@ func parseGenDIDSignArgs(genDIDSignMsg types.GenDIDSignMsg, lcdUrl, grpcUrl stri
 
 	didSeq := util.FromUint64ToString(document.Sequence)
 
-	didSigBase64, err := createSign(did, didKey, did, didSeq, genDIDSignMsg.DIDKeyPath, genDIDSignMsg.DIDPassphrase)
+	didSigBase64, err := createSign(did, didKey, did, didSeq, genDIDSignMsg.DIDKeyStore, genDIDSignMsg.DIDKeyPath, genDIDSignMsg.DIDPassphrase)
 	if err != nil {
 		return "", util.LogErr(errors.ErrParse, err)
 	}
@@ -240,18 +238,18 @@ func parseGetVPArgs(getVPMsg types.GetVPMsg) (privtypes.QueryGetVPRequest, error
 	}, nil
 }
 
-func makeBase64Proof(adminDid, adminDidKey, participantDid, didKeyPath, didKeyPassphrase string) (string, error) {
-	return createSign(adminDid, adminDidKey, participantDid, privtypes.ProofSequence, didKeyPath, didKeyPassphrase)
+func makeBase64Proof(adminDid, adminDidKey, participantDid string, didKeyStore types.DIDKeyStore, didKeyPath, didKeyPassphrase string) (string, error) {
+	return createSign(adminDid, adminDidKey, participantDid, privtypes.ProofSequence, didKeyStore, didKeyPath, didKeyPassphrase)
 }
 
-// create the DID signature by using DID private key from the key store directory
-func createSign(signingDid, signingDidKey, targetDid, sigSeq, didKeyPath, didKeyPassphrase string) (string, error) {
+// create the DID signature by using DID private key from the key store, or the key store directory if the key store is nil
+func createSign(signingDid, signingDidKey, targetDid, sigSeq string, didKeyStore types.DIDKeyStore, didKeyPath, didKeyPassphrase string) (string, error) {
 	verificationMethodID, err := didtypes.ParseVerificationMethodID(signingDidKey, signingDid)
 	if err != nil {
 		return "", err
 	}
 
-	didPrivKey, err := getPrivKeyFromKeyStore(didKeyPath, didKeyPassphrase, verificationMethodID)
+	didPrivKey, err := getPrivKeyFromKeyStore(didKeyStore, didKeyPath, didKeyPassphrase, verificationMethodID)
 	if err != nil {
 		return "", err
 	}
@@ -263,16 +261,6 @@ func createSign(signingDid, signingDidKey, targetDid, sigSeq, didKeyPath, didKey
 	return base64.StdEncoding.EncodeToString(proof), nil
 }
 
-func getPrivKeyFromKeyStore(didKeyPath, didPassphrase string, verificationMethodID string) (secp256k1.PrivKey, error) {
-	ks, err := didcrypto.NewKeyStore(didKeyPath)
-	if err != nil {
-		return nil, util.LogErr(errors.ErrInvalidRequest, err)
-	}
-
-	didPrivKeyBytes, err := ks.LoadByAddress(string(verificationMethodID), didPassphrase)
-	if err != nil {
-		return nil, util.LogErr(errors.ErrInvalidRequest, err)
-	}
-
-	return xcrypto.PrivKeyFromBytes(didPrivKeyBytes)
+func getPrivKeyFromKeyStore(keyStore types.DIDKeyStore, didKeyPath, didPassphrase string, verificationMethodID string) (secp256k1.PrivKey, error) {
+	return key.LoadDIDPrivKey(keyStore, didKeyPath, didPassphrase, verificationMethodID)
 }
@@ -0,0 +1,521 @@
+package private
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Moonyongjung/xpriv.go/provider"
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	didtypes "github.com/Moonyongjung/xpla-private-chain/x/did/types"
+	privtypes "github.com/Moonyongjung/xpla-private-chain/x/private/types"
+)
+
+const (
+	DefaultOnboardingTxTimeout    = 30 * time.Second
+	DefaultOnboardingPollInterval = 3 * time.Second
+
+	// The verification method ID of the DID which is created by CreateDID.
+	onboardingDIDKeyID = "key1"
+)
+
+// Steps of the participant onboarding.
+type OnboardingStep string
+
+const (
+	OnboardingStepCreateDID   OnboardingStep = "create-did"
+	OnboardingStepParticipate OnboardingStep = "participate"
+	OnboardingStepAccept      OnboardingStep = "accept"
+	OnboardingStepIssueVC     OnboardingStep = "issue-vc"
+	OnboardingStepGetVP       OnboardingStep = "get-vp"
+	OnboardingStepDone        OnboardingStep = "done"
+)
+
+// Participate state of the DID which is read from the participate state query.
+type ParticipateState string
+
+const (
+	ParticipateStateUnknown     ParticipateState = "unknown"
+	ParticipateStateNotFound    ParticipateState = "not-found"
+	ParticipateStateUnderReview ParticipateState = "under-review"
+	ParticipateStateAccepted    ParticipateState = "accepted"
+	ParticipateStateDenied      ParticipateState = "denied"
+	ParticipateStateExiled      ParticipateState = "exiled"
+	ParticipateStateQuit        ParticipateState = "quit"
+)
+
+// Options of the participant onboarding.
+type OnboardingOptions struct {
+	// DID key of the participant, e.g. "did:xpla:...#key1".
+	// If it is empty, a new DID is created by the DID mnemonic.
+	DIDKey string
+	// Mnemonic and moniker for creating a new DID.
+	DIDMnemonic string
+	Moniker     string
+	// Passphrase and directory of the DID key store.
+	DIDPassphrase string
+	DIDKeyPath    string
+	// If it is set, DID keys are saved to and loaded from it instead of the DID key path.
+	DIDKeyStore types.DIDKeyStore
+
+	// If admin is set, the participant is accepted by the admin in the onboarding.
+	// If not, the onboarding waits until an admin of the private chain accepts the participant.
+	Admin *OnboardingAdmin
+
+	// File path for saving the onboarding progress. The onboarding is resumed from the saved progress.
+	// If it is empty, the progress is not saved.
+	ProgressPath string
+	// File path for saving the obtained VP. It is mandatory because the VP of the returned xpla client is loaded from it.
+	VPPath string
+
+	// Timeout of waiting each transaction is included in a block. Default value is 30 seconds.
+	TxTimeout time.Duration
+	// Interval of checking the participate state while waiting the acceptance. Default value is 3 seconds.
+	PollInterval time.Duration
+}
+
+// The admin who accepts the participant.
+type OnboardingAdmin struct {
+	// Xpla client which has the private key of the admin account.
+	Xplac         provider.XplaClient
+	DIDKey        string
+	DIDPassphrase string
+	DIDKeyPath    string
+	DIDKeyStore   types.DIDKeyStore
+}
+
+// Progress of the participant onboarding which is saved as JSON.
+type OnboardingProgress struct {
+	Step                OnboardingStep `json:"step"`
+	DID                 string         `json:"did"`
+	DIDKey              string         `json:"did_key"`
+	ParticipateSequence string         `json:"participate_sequence,omitempty"`
+	VC                  string         `json:"vc,omitempty"`
+	UpdatedAt           time.Time      `json:"updated_at"`
+}
+
+// Onboard the participant to the private chain.
+// The onboarding is progressed as create DID, participate, accept, issue VC and get VP, and the participate state
+// and the participate sequence are checked between them. The participate sequence which is recorded at the
+// participate step must not be changed until the VC is issued. The progress is saved after each step, so the onboarding
+// is resumed from the saved progress when it is called again after the failure or the timeout of the context.
+// It returns the xpla client which uses the obtained VP by the VP provider of the VP file.
+func Onboard(ctx context.Context, xplac provider.XplaClient, options OnboardingOptions) (provider.XplaClient, *OnboardingProgress, error) {
+	if options.VPPath == "" {
+		return nil, nil, util.LogErr(errors.ErrInsufficientParams, "need VP path to save the VP")
+	}
+	if options.DIDKeyPath == "" && options.DIDKeyStore == nil {
+		return nil, nil, util.LogErr(errors.ErrInsufficientParams, "need DID key path or DID key store")
+	}
+	if options.TxTimeout <= 0 {
+		options.TxTimeout = DefaultOnboardingTxTimeout
+	}
+	if options.PollInterval <= 0 {
+		options.PollInterval = DefaultOnboardingPollInterval
+	}
+
+	progress, err := LoadOnboardingProgress(options.ProgressPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	xplac = xplac.WithContext(ctx)
+	for progress.Step != OnboardingStepDone {
+		if err := ctx.Err(); err != nil {
+			return nil, progress, util.LogErr(errors.ErrTimeout, "onboarding is stopped at", progress.Step, ":", err)
+		}
+
+		var next OnboardingStep
+		switch progress.Step {
+		case OnboardingStepCreateDID:
+			next, err = onboardCreateDID(xplac, options, progress)
+		case OnboardingStepParticipate:
+			next, err = onboardParticipate(xplac, options, progress)
+		case OnboardingStepAccept:
+			next, err = onboardAccept(ctx, xplac, options, progress)
+		case OnboardingStepIssueVC:
+			next, err = onboardIssueVC(xplac, options, progress)
+		case OnboardingStepGetVP:
+			next, err = onboardGetVP(xplac, options, progress)
+		default:
+			err = util.LogErr(errors.ErrInvalidRequest, "invalid onboarding step:", progress.Step)
+		}
+		if err != nil {
+			return nil, progress, err
+		}
+
+		progress.Step = next
+		if err := SaveOnboardingProgress(options.ProgressPath, progress); err != nil {
+			return nil, progress, err
+		}
+	}
+
+	// The VP which is set to the given client may be stale, and the VP of the VP provider takes precedence over it.
+	vpProvider := util.NewFileVPProvider(options.VPPath)
+	if _, err := vpProvider.VP(ctx); err != nil {
+		return nil, progress, err
+	}
+
+	return xplac.WithContext(context.Background()).WithVPProvider(vpProvider), progress, nil
+}
+
+// Load the onboarding progress from the file.
+// The initial progress is returned if the path is empty or the file does not exist.
+func LoadOnboardingProgress(path string) (*OnboardingProgress, error) {
+	progress := &OnboardingProgress{Step: OnboardingStepCreateDID}
+	if path == "" {
+		return progress, nil
+	}
+
+	bytes, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return progress, nil
+	}
+	if err != nil {
+		return nil, util.LogErr(errors.ErrInvalidRequest, err)
+	}
+
+	if err := json.Unmarshal(bytes, progress); err != nil {
+		return nil, util.LogErr(errors.ErrFailedToUnmarshal, err)
+	}
+
+	return progress, nil
+}
+
+// Save the onboarding progress to the file. Nothing is saved if the path is empty.
+func SaveOnboardingProgress(path string, progress *OnboardingProgress) error {
+	if path == "" {
+		return nil
+	}
+
+	progress.UpdatedAt = time.Now().UTC()
+	bytes, err := util.JsonMarshalDataIndent(progress)
+	if err != nil {
+		return util.LogErr(errors.ErrFailedToMarshal, err)
+	}
+
+	if err := os.WriteFile(path, bytes, 0600); err != nil {
+		return util.LogErr(errors.ErrInvalidRequest, err)
+	}
+
+	return nil
+}
+
+// Query the participate state of the DID.
+// It returns the not found state without the error if the DID has not participated yet.
+func QueryParticipateState(xplac provider.XplaClient, did string) (ParticipateState, error) {
+	var res privtypes.QueryParticipateStateResponse
+	err := xplac.ParticipateState(types.ParticipateStateMsg{DID: did}).QueryTyped(&res)
+	if util.IsNotFoundErr(err) {
+		return ParticipateStateNotFound, nil
+	}
+	if err != nil {
+		return ParticipateStateUnknown, err
+	}
+
+	return participateStateOf(res.State), nil
+}
+
+// Query the participate sequence of the DID.
+func QueryParticipateSequence(xplac provider.XplaClient, did string) (uint64, error) {
+	var res privtypes.QueryParticipateSequenceResponse
+	err := xplac.ParticipateSequence(types.ParticipateSequenceMsg{DID: did}).QueryTyped(&res)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.Sequence, nil
+}
+
+// Create the DID of the participant, or use the DID key of the options.
+// The created DID is saved to the progress before the transaction is broadcasted, so the DID is not created again
+// when the onboarding is resumed after the DID is registered.
+func onboardCreateDID(xplac provider.XplaClient, options OnboardingOptions, progress *OnboardingProgress) (OnboardingStep, error) {
+	if options.DIDKey != "" {
+		did, _, err := splitOnboardingDIDKey(options.DIDKey)
+		if err != nil {
+			return "", err
+		}
+		progress.DID = did
+		progress.DIDKey = options.DIDKey
+
+	} else {
+		if progress.DID != "" {
+			registered, err := isDIDRegistered(xplac, progress.DID)
+			if err != nil {
+				return "", err
+			}
+			if registered {
+				return OnboardingStepParticipate, nil
+			}
+		}
+
+		if options.DIDMnemonic == "" {
+			return "", util.LogErr(errors.ErrInsufficientParams, "need DID key or DID mnemonic")
+		}
+
+		createXplac := xplac.CreateDID(types.CreateDIDMsg{
+			DIDMnemonic:    options.DIDMnemonic,
+			DIDPassphrase:  options.DIDPassphrase,
+			SaveDIDKeyPath: options.DIDKeyPath,
+			Moniker:        options.Moniker,
+			DIDKeyStore:    options.DIDKeyStore,
+		})
+		if createXplac.GetErr() != nil {
+			return "", createXplac.GetErr()
+		}
+		createMsg, ok := createXplac.GetMsg().(didtypes.MsgCreateDID)
+		if !ok {
+			return "", util.LogErr(errors.ErrInvalidMsgType, "invalid create DID msg")
+		}
+
+		progress.DID = createMsg.Did
+		progress.DIDKey = createMsg.Did + "#" + onboardingDIDKeyID
+		if err := SaveOnboardingProgress(options.ProgressPath, progress); err != nil {
+			return "", err
+		}
+
+		if err := broadcastOnboardingTx(createXplac, options); err != nil {
+			return "", err
+		}
+	}
+
+	// The DID must be registered on the chain before participating.
+	registered, err := isDIDRegistered(xplac, progress.DID)
+	if err != nil {
+		return "", err
+	}
+	if !registered {
+		return "", util.LogErr(errors.ErrNotFound, "DID is not registered:", progress.DID)
+	}
+
+	return OnboardingStepParticipate, nil
+}
+
+// Request to participate if the DID has not participated yet, and record the participate sequence.
+func onboardParticipate(xplac provider.XplaClient, options OnboardingOptions, progress *OnboardingProgress) (OnboardingStep, error) {
+	state, err := QueryParticipateState(xplac, progress.DID)
+	if err != nil {
+		return "", err
+	}
+
+	if state == ParticipateStateNotFound {
+		participateXplac := xplac.Participate(types.ParticipateMsg{
+			ParticipantDIDKey: progress.DIDKey,
+			DIDPassphrase:     options.DIDPassphrase,
+			DIDKeyPath:        options.DIDKeyPath,
+			DIDKeyStore:       options.DIDKeyStore,
+		})
+		if err := broadcastOnboardingTx(participateXplac, options); err != nil {
+			return "", err
+		}
+
+		state, err = QueryParticipateState(xplac, progress.DID)
+		if err != nil {
+			return "", err
+		}
+		if state == ParticipateStateNotFound {
+			return "", util.LogErr(errors.ErrNotFound, "participation is not found after participating, DID:", progress.DID)
+		}
+	}
+
+	if err := checkParticipateState(state); err != nil {
+		return "", err
+	}
+
+	sequence, err := QueryParticipateSequence(xplac, progress.DID)
+	if err != nil {
+		return "", err
+	}
+	progress.ParticipateSequence = util.FromUint64ToString(sequence)
+
+	return OnboardingStepAccept, nil
+}
+
+// Accept the participant by the admin, or wait until the participant is accepted.
+func onboardAccept(ctx context.Context, xplac provider.XplaClient, options OnboardingOptions, progress *OnboardingProgress) (OnboardingStep, error) {
+	if err := checkParticipateSequence(xplac, progress); err != nil {
+		return "", err
+	}
+
+	state, err := QueryParticipateState(xplac, progress.DID)
+	if err != nil {
+		return "", err
+	}
+
+	if state == ParticipateStateUnderReview && options.Admin != nil {
+		acceptXplac := options.Admin.Xplac.WithContext(ctx).Accept(types.AcceptMsg{
+			ParticipantDID:     progress.DID,
+			AdminDIDKey:        options.Admin.DIDKey,
+			AdminDIDPassphrase: options.Admin.DIDPassphrase,
+			AdminDIDKeyPath:    options.Admin.DIDKeyPath,
+			AdminDIDKeyStore:   options.Admin.DIDKeyStore,
+		})
+		if err := broadcastOnboardingTx(acceptXplac, options); err != nil {
+			return "", err
+		}
+	}
+
+	ticker := time.NewTicker(options.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		state, err = QueryParticipateState(xplac, progress.DID)
+		if err != nil {
+			return "", err
+		}
+		if state == ParticipateStateAccepted {
+			return OnboardingStepIssueVC, nil
+		}
+		if err := checkParticipateState(state); err != nil {
+			return "", err
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", util.LogErr(errors.ErrTimeout, "participant is not accepted yet:", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// Issue the VC of the accepted participant.
+func onboardIssueVC(xplac provider.XplaClient, options OnboardingOptions, progress *OnboardingProgress) (OnboardingStep, error) {
+	if err := checkParticipateSequence(xplac, progress); err != nil {
+		return "", err
+	}
+
+	state, err := QueryParticipateState(xplac, progress.DID)
+	if err != nil {
+		return "", err
+	}
+	if state != ParticipateStateAccepted {
+		return "", util.LogErr(errors.ErrInvalidRequest, "participant is not accepted, state:", state)
+	}
+
+	didSign, err := genDIDSign(xplac, onboardingGenDIDSignMsg(options, progress))
+	if err != nil {
+		return "", err
+	}
+
+	vc, err := xplac.IssueVC(types.IssueVCMsg{
+		DIDKey:        progress.DIDKey,
+		DIDSignBase64: didSign,
+	}).Query()
+	if err != nil {
+		return "", err
+	}
+	progress.VC = vc
+
+	return OnboardingStepGetVP, nil
+}
+
+// Get the VP of the participant and save it to the VP path.
+func onboardGetVP(xplac provider.XplaClient, options OnboardingOptions, progress *OnboardingProgress) (OnboardingStep, error) {
+	vp, err := queryVP(xplac, onboardingGenDIDSignMsg(options, progress))
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.WriteFile(options.VPPath, vp, 0600); err != nil {
+		return "", util.LogErr(errors.ErrInvalidRequest, err)
+	}
+
+	return OnboardingStepDone, nil
+}
+
+func onboardingGenDIDSignMsg(options OnboardingOptions, progress *OnboardingProgress) types.GenDIDSignMsg {
+	return types.GenDIDSignMsg{
+		DIDKey:        progress.DIDKey,
+		DIDPassphrase: options.DIDPassphrase,
+		DIDKeyPath:    options.DIDKeyPath,
+		DIDKeyStore:   options.DIDKeyStore,
+	}
+}
+
+// Broadcast the transaction of the onboarding and wait until it is included in a block.
+func broadcastOnboardingTx(xplac provider.XplaClient, options OnboardingOptions) error {
+	txbytes, err := xplac.CreateAndSignTx()
+	if err != nil {
+		return err
+	}
+
+	_, err = xplac.BroadcastAndWait(txbytes, options.TxTimeout)
+	return err
+}
+
+// Check the DID is registered on the chain.
+func isDIDRegistered(xplac provider.XplaClient, did string) (bool, error) {
+	_, err := xplac.GetDID(types.GetDIDMsg{DID: did}).Query()
+	if util.IsNotFoundErr(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// The participant cannot be onboarded if the participation is rejected.
+func checkParticipateState(state ParticipateState) error {
+	switch state {
+	case ParticipateStateDenied, ParticipateStateExiled, ParticipateStateQuit:
+		return util.LogErr(errors.ErrInvalidRequest, "participant cannot be onboarded, state:", state)
+	case ParticipateStateNotFound, ParticipateStateUnknown:
+		return util.LogErr(errors.ErrInvalidRequest, "participant has not participated, state:", state)
+	default:
+		return nil
+	}
+}
+
+// The participate sequence is changed if the participant participates again after the participate step,
+// e.g. the participant quits and participates again, so the progress is not valid anymore.
+func checkParticipateSequence(xplac provider.XplaClient, progress *OnboardingProgress) error {
+	if progress.ParticipateSequence == "" {
+		return util.LogErr(errors.ErrInvalidRequest, "participate sequence is not recorded, DID:", progress.DID)
+	}
+
+	sequence, err := QueryParticipateSequence(xplac, progress.DID)
+	if err != nil {
+		return err
+	}
+
+	if util.FromUint64ToString(sequence) != progress.ParticipateSequence {
+		return util.LogErr(errors.ErrInvalidRequest, "participate sequence is changed from", progress.ParticipateSequence, "to", sequence)
+	}
+
+	return nil
+}
+
+func splitOnboardingDIDKey(didKey string) (string, string, error) {
+	parts := strings.Split(didKey, "#")
+	if len(parts) != 2 || !strings.HasPrefix(parts[0], "did:") || parts[1] == "" {
+		return "", "", util.LogErr(errors.ErrParse, "invalid DID key:", didKey)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// Convert the participate state of the private module.
+func participateStateOf(state privtypes.ParticipateState) ParticipateState {
+	switch state {
+	case privtypes.ParticipateState_UNDER_REVIEW:
+		return ParticipateStateUnderReview
+	case privtypes.ParticipateState_ACCEPTED:
+		return ParticipateStateAccepted
+	case privtypes.ParticipateState_DENIED:
+		return ParticipateStateDenied
+	case privtypes.ParticipateState_EXILED:
+		return ParticipateStateExiled
+	case privtypes.ParticipateState_QUIT:
+		return ParticipateStateQuit
+	default:
+		return ParticipateStateUnknown
+	}
+}
@@ -0,0 +1,333 @@
+package private
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+	"github.com/Moonyongjung/xpriv.go/util/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	didtypes "github.com/Moonyongjung/xpla-private-chain/x/did/types"
+	privtypes "github.com/Moonyongjung/xpla-private-chain/x/private/types"
+)
+
+const (
+	testOnboardingDID = "did:xpla:onboarding"
+	testOnboardingVP  = `{"type":["VerifiablePresentation"]}`
+)
+
+// Chain state of the fake xpla client for the onboarding.
+type fakeOnboardingChain struct {
+	didRegistered bool
+	participated  bool
+	state         privtypes.ParticipateState
+	sequence      uint64
+	// The participant is accepted when the participate transaction is included.
+	autoAccept bool
+	// The sequence is changed after the participate step, e.g. the participant participates again.
+	changeSequence bool
+
+	stateErr      error
+	failBroadcast string
+	broadcasts    []string
+
+	// The VP which is returned by the get VP query. Default is the test onboarding VP.
+	vp          string
+	vpRequested int
+}
+
+// Make the fake xpla client which handles requests of the onboarding by the chain state.
+func newFakeOnboardingClient(t *testing.T, chain *fakeOnboardingChain) *testutil.FakeXplaClient {
+	return testutil.NewFakeXplaClient(t, testutil.FakeXplaClientHandlers{
+		Msg: func(method string, msg interface{}) interface{} {
+			switch method {
+			case "CreateDID":
+				return didtypes.MsgCreateDID{Did: testOnboardingDID}
+			case "GenDIDSign":
+				return "sign"
+			}
+			return msg
+		},
+		Query: func(c *testutil.FakeXplaClient) (string, error) {
+			switch c.Method {
+			case "GetDID":
+				if !chain.didRegistered {
+					return "", testNotFoundErr()
+				}
+				return `{"did_document":{}}`, nil
+			case "IssueVC":
+				return `{"type":["VerifiableCredential"]}`, nil
+			default:
+				return "", c.Unexpected()
+			}
+		},
+		QueryTyped: func(c *testutil.FakeXplaClient, res interface{}) error {
+			switch c.Method {
+			case "ParticipateState":
+				if chain.stateErr != nil {
+					return chain.stateErr
+				}
+				if !chain.participated {
+					return testNotFoundErr()
+				}
+				res.(*privtypes.QueryParticipateStateResponse).State = chain.state
+			case "ParticipateSequence":
+				if !chain.participated {
+					return testNotFoundErr()
+				}
+				res.(*privtypes.QueryParticipateSequenceResponse).Sequence = chain.sequence
+			case "GetVP":
+				chain.vpRequested++
+				res.(*privtypes.QueryGetVPResponse).Vp = testOnboardingVP
+				if chain.vp != "" {
+					res.(*privtypes.QueryGetVPResponse).Vp = chain.vp
+				}
+			default:
+				return c.Unexpected()
+			}
+			return nil
+		},
+		CreateAndSignTx: func(c *testutil.FakeXplaClient) ([]byte, error) {
+			return []byte(c.Method), nil
+		},
+		BroadcastAndWait: func(c *testutil.FakeXplaClient, txbytes []byte, timeout time.Duration) (*types.TxRes, error) {
+			method := string(txbytes)
+			if method == chain.failBroadcast {
+				return nil, util.LogErr(errors.ErrTimeout, "tx is not included:", method)
+			}
+			chain.broadcasts = append(chain.broadcasts, method)
+
+			switch method {
+			case "CreateDID":
+				chain.didRegistered = true
+			case "Participate":
+				chain.participated = true
+				chain.sequence++
+				chain.state = privtypes.ParticipateState_UNDER_REVIEW
+				if chain.autoAccept {
+					chain.state = privtypes.ParticipateState_ACCEPTED
+				}
+			case "Accept":
+				chain.state = privtypes.ParticipateState_ACCEPTED
+				if chain.changeSequence {
+					chain.sequence++
+				}
+			default:
+				return nil, c.Unexpected()
+			}
+			return &types.TxRes{}, nil
+		},
+	})
+}
+
+func testNotFoundErr() error {
+	return util.LogErr(errors.ErrGrpcRequest, status.Error(codes.NotFound, "not found"))
+}
+
+func TestOnboard(t *testing.T) {
+	testCases := []struct {
+		name     string
+		chain    fakeOnboardingChain
+		progress *OnboardingProgress
+		didKey   string
+		noAdmin  bool
+
+		expectBroadcasts []string
+		expectErr        bool
+		expectStep       OnboardingStep
+	}{
+		{
+			name:             "onboard from the beginning",
+			expectBroadcasts: []string{"CreateDID", "Participate", "Accept"},
+			expectStep:       OnboardingStepDone,
+		},
+		{
+			name:             "use the DID key of the options",
+			chain:            fakeOnboardingChain{didRegistered: true},
+			didKey:           testOnboardingDID + "#key1",
+			expectBroadcasts: []string{"Participate", "Accept"},
+			expectStep:       OnboardingStepDone,
+		},
+		{
+			name:      "DID key of the options is not DID",
+			chain:     fakeOnboardingChain{didRegistered: true},
+			didKey:    "moniker#key1",
+			expectErr: true,
+		},
+		{
+			name:      "DID key of the options is not registered",
+			didKey:    testOnboardingDID + "#key1",
+			expectErr: true,
+		},
+		{
+			name:             "resume create DID after the DID is registered",
+			chain:            fakeOnboardingChain{didRegistered: true},
+			progress:         &OnboardingProgress{Step: OnboardingStepCreateDID, DID: testOnboardingDID, DIDKey: testOnboardingDID + "#key1"},
+			expectBroadcasts: []string{"Participate", "Accept"},
+			expectStep:       OnboardingStepDone,
+		},
+		{
+			name:             "resume create DID before the DID is registered",
+			progress:         &OnboardingProgress{Step: OnboardingStepCreateDID, DID: testOnboardingDID, DIDKey: testOnboardingDID + "#key1"},
+			expectBroadcasts: []string{"CreateDID", "Participate", "Accept"},
+			expectStep:       OnboardingStepDone,
+		},
+		{
+			name:       "DID is saved even though create DID tx fails",
+			chain:      fakeOnboardingChain{failBroadcast: "CreateDID"},
+			expectErr:  true,
+			expectStep: OnboardingStepCreateDID,
+		},
+		{
+			name:             "resume participate after participating",
+			chain:            fakeOnboardingChain{didRegistered: true, participated: true, state: privtypes.ParticipateState_UNDER_REVIEW, sequence: 1},
+			progress:         &OnboardingProgress{Step: OnboardingStepParticipate, DID: testOnboardingDID, DIDKey: testOnboardingDID + "#key1"},
+			expectBroadcasts: []string{"Accept"},
+			expectStep:       OnboardingStepDone,
+		},
+		{
+			name:       "participate state cannot be queried",
+			chain:      fakeOnboardingChain{didRegistered: true, stateErr: util.LogErr(errors.ErrGrpcRequest, status.Error(codes.Unavailable, "unavailable"))},
+			progress:   &OnboardingProgress{Step: OnboardingStepParticipate, DID: testOnboardingDID, DIDKey: testOnboardingDID + "#key1"},
+			expectErr:  true,
+			expectStep: OnboardingStepParticipate,
+		},
+		{
+			name:       "denied participant",
+			chain:      fakeOnboardingChain{didRegistered: true, participated: true, state: privtypes.ParticipateState_DENIED, sequence: 1},
+			progress:   &OnboardingProgress{Step: OnboardingStepParticipate, DID: testOnboardingDID, DIDKey: testOnboardingDID + "#key1"},
+			expectErr:  true,
+			expectStep: OnboardingStepParticipate,
+		},
+		{
+			name:             "accepted by another admin",
+			chain:            fakeOnboardingChain{autoAccept: true},
+			noAdmin:          true,
+			expectBroadcasts: []string{"CreateDID", "Participate"},
+			expectStep:       OnboardingStepDone,
+		},
+		{
+			name:             "resume accept",
+			chain:            fakeOnboardingChain{didRegistered: true, participated: true, state: privtypes.ParticipateState_UNDER_REVIEW, sequence: 1},
+			progress:         &OnboardingProgress{Step: OnboardingStepAccept, DID: testOnboardingDID, DIDKey: testOnboardingDID + "#key1", ParticipateSequence: "1"},
+			expectBroadcasts: []string{"Accept"},
+			expectStep:       OnboardingStepDone,
+		},
+		{
+			name:             "participate sequence is changed",
+			chain:            fakeOnboardingChain{changeSequence: true},
+			expectBroadcasts: []string{"CreateDID", "Participate", "Accept"},
+			expectErr:        true,
+			expectStep:       OnboardingStepIssueVC,
+		},
+		{
+			name:       "participate sequence of the progress is not matched",
+			chain:      fakeOnboardingChain{didRegistered: true, participated: true, state: privtypes.ParticipateState_ACCEPTED, sequence: 2},
+			progress:   &OnboardingProgress{Step: OnboardingStepIssueVC, DID: testOnboardingDID, DIDKey: testOnboardingDID + "#key1", ParticipateSequence: "1"},
+			expectErr:  true,
+			expectStep: OnboardingStepIssueVC,
+		},
+		{
+			name:       "resume issue VC",
+			chain:      fakeOnboardingChain{didRegistered: true, participated: true, state: privtypes.ParticipateState_ACCEPTED, sequence: 1},
+			progress:   &OnboardingProgress{Step: OnboardingStepIssueVC, DID: testOnboardingDID, DIDKey: testOnboardingDID + "#key1", ParticipateSequence: "1"},
+			expectStep: OnboardingStepDone,
+		},
+		{
+			name:       "resume get VP",
+			chain:      fakeOnboardingChain{didRegistered: true, participated: true, state: privtypes.ParticipateState_ACCEPTED, sequence: 1},
+			progress:   &OnboardingProgress{Step: OnboardingStepGetVP, DID: testOnboardingDID, DIDKey: testOnboardingDID + "#key1", ParticipateSequence: "1"},
+			expectStep: OnboardingStepDone,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			progressPath := filepath.Join(dir, "onboarding.json")
+			vpPath := filepath.Join(dir, "vp.json")
+			if tc.progress != nil {
+				require.NoError(t, SaveOnboardingProgress(progressPath, tc.progress))
+			}
+
+			chain := tc.chain
+			xplac := newFakeOnboardingClient(t, &chain)
+			options := OnboardingOptions{
+				DIDKey:       tc.didKey,
+				DIDMnemonic:  "mnemonic",
+				DIDKeyPath:   dir,
+				ProgressPath: progressPath,
+				VPPath:       vpPath,
+				PollInterval: time.Millisecond,
+			}
+			if !tc.noAdmin {
+				options.Admin = &OnboardingAdmin{Xplac: xplac, DIDKey: "did:xpla:admin#key1"}
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			onboarded, _, err := Onboard(ctx, xplac, options)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+
+				vp, err := os.ReadFile(vpPath)
+				require.NoError(t, err)
+				require.Equal(t, testOnboardingVP, string(vp))
+
+				// the VP of the file is used instead of the VP which is set to the client
+				vp, err = onboarded.GetVPProvider().VP(ctx)
+				require.NoError(t, err)
+				require.Equal(t, testOnboardingVP, string(vp))
+			}
+			require.Equal(t, tc.expectBroadcasts, chain.broadcasts)
+
+			if tc.expectStep != "" {
+				saved, err := LoadOnboardingProgress(progressPath)
+				require.NoError(t, err)
+				require.Equal(t, tc.expectStep, saved.Step)
+				require.Equal(t, testOnboardingDID, saved.DID)
+			}
+		})
+	}
+}
+
+func TestQueryParticipateState(t *testing.T) {
+	testCases := []struct {
+		name        string
+		chain       fakeOnboardingChain
+		expectState ParticipateState
+		expectErr   bool
+	}{
+		{"not participated", fakeOnboardingChain{}, ParticipateStateNotFound, false},
+		{"under review", fakeOnboardingChain{participated: true, state: privtypes.ParticipateState_UNDER_REVIEW}, ParticipateStateUnderReview, false},
+		{"accepted", fakeOnboardingChain{participated: true, state: privtypes.ParticipateState_ACCEPTED}, ParticipateStateAccepted, false},
+		{"denied", fakeOnboardingChain{participated: true, state: privtypes.ParticipateState_DENIED}, ParticipateStateDenied, false},
+		{"exiled", fakeOnboardingChain{participated: true, state: privtypes.ParticipateState_EXILED}, ParticipateStateExiled, false},
+		{"quit", fakeOnboardingChain{participated: true, state: privtypes.ParticipateState_QUIT}, ParticipateStateQuit, false},
+		{"request error", fakeOnboardingChain{stateErr: util.LogErr(errors.ErrHttpRequest, "connection refused")}, ParticipateStateUnknown, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chain := tc.chain
+			state, err := QueryParticipateState(newFakeOnboardingClient(t, &chain), testOnboardingDID)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expectState, state)
+		})
+	}
+}
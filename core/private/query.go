@@ -9,12 +9,8 @@ import (
 	"github.com/gogo/protobuf/proto"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for private module.
-func QueryPrivate(i core.QueryClient) (string, error) {
+func QueryPrivate(i core.QueryClient) (*core.QueryResponse, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcPrivate(i)
 	} else {
@@ -22,7 +18,10 @@ func QueryPrivate(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcPrivate(i core.QueryClient) (string, error) {
+func queryByGrpcPrivate(i core.QueryClient) (*core.QueryResponse, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := privtypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -34,7 +33,7 @@ func queryByGrpcPrivate(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		// Particpate state of the DID
@@ -45,7 +44,7 @@ func queryByGrpcPrivate(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		// Particpate sequence of the DID
@@ -56,7 +55,7 @@ func queryByGrpcPrivate(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		// Gen DID signature
@@ -77,7 +76,7 @@ func queryByGrpcPrivate(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		// Get VP
@@ -89,7 +88,7 @@ func queryByGrpcPrivate(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		// All under reviews
@@ -100,7 +99,7 @@ func queryByGrpcPrivate(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		// All participants
@@ -111,19 +110,14 @@ func queryByGrpcPrivate(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", err
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	return string(out), nil
+	return core.ProtoQueryResponse(i, res), nil
 }
 
 const (
@@ -136,7 +130,7 @@ const (
 	privateAllParticipantsLabel     = "all_participants"
 )
 
-func queryByLcdPrivate(i core.QueryClient) (string, error) {
+func queryByLcdPrivate(i core.QueryClient) (*core.QueryResponse, error) {
 	url := "/xpla/private/v1beta1/"
 
 	switch {
@@ -160,7 +154,7 @@ func queryByLcdPrivate(i core.QueryClient) (string, error) {
 	case i.Ixplac.GetMsgType() == PrivateGenDIDSignMsgType:
 		convertMsg, _ := i.Ixplac.GetMsg().(string)
 
-		return convertMsg, nil
+		return core.RawQueryResponse(i, []byte(convertMsg)), nil
 
 		// Issue VC
 	case i.Ixplac.GetMsgType() == PrivateIssueVCMsgType:
@@ -170,15 +164,15 @@ func queryByLcdPrivate(i core.QueryClient) (string, error) {
 
 		bodyByte, err := i.Ixplac.GetEncoding().Marshaler.MarshalJSON(convertMsg.Body)
 		if err != nil {
-			return "", util.LogErr(errors.ErrParse, err)
+			return nil, util.LogErr(errors.ErrParse, err)
 		}
 
 		out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+url, bodyByte, i.Ixplac.GetContext())
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
-		return string(out), nil
+		return core.RawQueryResponse(i, out), nil
 
 		// Get VP
 	case i.Ixplac.GetMsgType() == PrivateGetVPMsgType:
@@ -188,15 +182,15 @@ func queryByLcdPrivate(i core.QueryClient) (string, error) {
 
 		bodyByte, err := i.Ixplac.GetEncoding().Marshaler.MarshalJSON(convertMsg.Body)
 		if err != nil {
-			return "", util.LogErr(errors.ErrParse, err)
+			return nil, util.LogErr(errors.ErrParse, err)
 		}
 
 		out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+url, bodyByte, i.Ixplac.GetContext())
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 
-		return string(out), nil
+		return core.RawQueryResponse(i, out), nil
 
 		// all under reviews
 	case i.Ixplac.GetMsgType() == PrivateAllUnderReviewsMsgType:
@@ -207,13 +201,13 @@ func queryByLcdPrivate(i core.QueryClient) (string, error) {
 		url = url + util.MakeQueryLabels(privateAllParticipantsLabel)
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+url, nil, i.Ixplac.GetContext())
+	out, err := util.CtxHttpClient("GET", i.Ixplac.GetLcdURL()+core.LcdPaginationURL(url, i.Ixplac.GetMsg()), nil, i.Ixplac.GetContext())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return string(out), nil
+	return core.RawQueryResponse(i, out), nil
 }
@@ -0,0 +1,283 @@
+package private
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/Moonyongjung/xpriv.go/core"
+	"github.com/Moonyongjung/xpriv.go/provider"
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	didtypes "github.com/Moonyongjung/xpla-private-chain/x/did/types"
+	privtypes "github.com/Moonyongjung/xpla-private-chain/x/private/types"
+)
+
+const DefaultReviewTxTimeout = 30 * time.Second
+
+type ReviewDecisionType string
+
+const (
+	ReviewDecisionAccept ReviewDecisionType = "accept"
+	ReviewDecisionDeny   ReviewDecisionType = "deny"
+)
+
+// Options of the review queue.
+type ReviewQueueOptions struct {
+	// DID key of the admin, e.g. "did:xpla:...#key1". The proof of acceptance is signed by it.
+	AdminDIDKey        string
+	AdminDIDPassphrase string
+	AdminDIDKeyPath    string
+	// If it is set, the DID key of the admin is loaded from it instead of the DID key path.
+	AdminDIDKeyStore types.DIDKeyStore
+
+	// File path of the audit log which is appended as JSON lines. If it is empty, decisions are not logged.
+	AuditLogPath string
+	// Timeout of waiting the transaction of decisions is included in a block. Default value is 30 seconds.
+	TxTimeout time.Duration
+}
+
+// The participant under review with the DID document.
+type UnderReview struct {
+	DID      string                `json:"did"`
+	Document *didtypes.DIDDocument `json:"document,omitempty"`
+	Sequence uint64                `json:"sequence"`
+	// Error of resolving the DID document.
+	ResolveErr string `json:"resolve_err,omitempty"`
+}
+
+// Decision of the admin for the participant under review.
+type ReviewDecision struct {
+	ParticipantDID string
+	Decision       ReviewDecisionType
+	// Reason is only recorded to the audit log.
+	Reason string
+}
+
+// Entry of the audit log.
+type ReviewAuditEntry struct {
+	Time           time.Time          `json:"time"`
+	AdminDIDKey    string             `json:"admin_did_key"`
+	ParticipantDID string             `json:"participant_did"`
+	Decision       ReviewDecisionType `json:"decision"`
+	Reason         string             `json:"reason,omitempty"`
+	// Base64 proof of the admin DID which is included in the accept msg.
+	AdminProof string `json:"admin_proof,omitempty"`
+	TxHash     string `json:"tx_hash,omitempty"`
+	Code       uint32 `json:"code"`
+	Err        string `json:"err,omitempty"`
+}
+
+// Review queue of participants under review for admins of the private chain.
+// Decisions are sent in one multi-message transaction by the admin account of the xpla client.
+type ReviewQueue struct {
+	xplac   provider.XplaClient
+	options ReviewQueueOptions
+}
+
+// Make the review queue of the admin.
+func NewReviewQueue(xplac provider.XplaClient, options ReviewQueueOptions) (*ReviewQueue, error) {
+	if options.AdminDIDKey == "" {
+		return nil, util.LogErr(errors.ErrInsufficientParams, "need admin DID key")
+	}
+	if options.AdminDIDKeyPath == "" && options.AdminDIDKeyStore == nil {
+		return nil, util.LogErr(errors.ErrInsufficientParams, "need admin DID key path or DID key store")
+	}
+	if options.TxTimeout <= 0 {
+		options.TxTimeout = DefaultReviewTxTimeout
+	}
+
+	return &ReviewQueue{xplac: xplac, options: options}, nil
+}
+
+// List all participants under review with their DID documents, by following pages of under reviews.
+// The participant is listed even if the DID document cannot be resolved, and the error is recorded in it.
+func (q *ReviewQueue) List(ctx context.Context) ([]UnderReview, error) {
+	xplac := q.xplac.WithContext(ctx)
+
+	dids, err := core.QueryAll(ctx, xplac.AllUnderReviews(), func(res *privtypes.QueryAllUnderReviewsResponse) []string {
+		var dids []string
+		for _, underReview := range res.UnderReviews {
+			dids = append(dids, underReview.Did)
+		}
+		return dids
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var underReviews []UnderReview
+	seen := make(map[string]bool)
+	for _, did := range dids {
+		if seen[did] {
+			continue
+		}
+		seen[did] = true
+
+		underReview := UnderReview{DID: did}
+		docWithSeq, err := queryDIDDocument(xplac, did)
+		if err != nil {
+			underReview.ResolveErr = err.Error()
+		} else {
+			underReview.Document = docWithSeq.Document
+			underReview.Sequence = docWithSeq.Sequence
+		}
+		underReviews = append(underReviews, underReview)
+	}
+
+	return underReviews, nil
+}
+
+// Accept or deny participants in one multi-message transaction, and wait until it is included in a block.
+// Every decision is recorded to the audit log with the result of the transaction.
+func (q *ReviewQueue) Decide(ctx context.Context, decisions []ReviewDecision) (*types.TxRes, error) {
+	if len(decisions) == 0 {
+		return nil, util.LogErr(errors.ErrInsufficientParams, "no decision")
+	}
+
+	adminDID, adminDIDKey, err := privtypes.ParseDIDKey(q.options.AdminDIDKey)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrParse, err)
+	}
+
+	fromAddress, err := util.GetAddrBySigner(q.xplac.GetSigner())
+	if err != nil {
+		return nil, err
+	}
+
+	xplac := q.xplac.WithContext(ctx)
+	var entries []ReviewAuditEntry
+	var msgXplacs []provider.XplaClient
+	for _, decision := range decisions {
+		participantDID, err := didtypes.ParseDID(decision.ParticipantDID)
+		if err != nil {
+			return nil, util.LogErr(errors.ErrParse, err)
+		}
+
+		entry := ReviewAuditEntry{
+			AdminDIDKey:    q.options.AdminDIDKey,
+			ParticipantDID: participantDID,
+			Decision:       decision.Decision,
+			Reason:         decision.Reason,
+		}
+
+		switch decision.Decision {
+		case ReviewDecisionAccept:
+			// The proof is made here in order to record it to the audit log.
+			entry.AdminProof, err = makeBase64Proof(adminDID, adminDIDKey, participantDID, q.options.AdminDIDKeyStore, q.options.AdminDIDKeyPath, q.options.AdminDIDPassphrase)
+			if err != nil {
+				return nil, util.LogErr(errors.ErrParse, err)
+			}
+			msg := privtypes.NewMsgAccept(participantDID, adminDIDKey, entry.AdminProof, fromAddress.String())
+			msgXplacs = append(msgXplacs, xplac.WithModule(PrivateModule).WithMsgType(PrivateAcceptMsgType).WithMsg(msg))
+
+		case ReviewDecisionDeny:
+			msgXplacs = append(msgXplacs, xplac.Deny(types.DenyMsg{
+				ParticipantDID: participantDID,
+				AdminDID:       adminDID,
+			}))
+
+		default:
+			return nil, util.LogErr(errors.ErrInvalidRequest, "invalid decision:", decision.Decision)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	res, err := q.broadcastDecisions(xplac, msgXplacs)
+	for i := range entries {
+		entries[i].Time = time.Now().UTC()
+		if res != nil && res.Response != nil {
+			entries[i].TxHash = res.Response.TxHash
+			entries[i].Code = res.Response.Code
+		}
+		if err != nil {
+			entries[i].Err = err.Error()
+		}
+	}
+	if logErr := q.appendAuditLog(entries); logErr != nil && err == nil {
+		err = logErr
+	}
+
+	return res, err
+}
+
+// Query the DID document which is active.
+func queryDIDDocument(xplac provider.XplaClient, did string) (didtypes.DIDDocumentWithSeq, error) {
+	var res didtypes.QueryDIDResponse
+	if err := xplac.GetDID(types.GetDIDMsg{DID: did}).QueryTyped(&res); err != nil {
+		return didtypes.DIDDocumentWithSeq{}, err
+	}
+	if res.DidDocumentWithSeq.Empty() {
+		return didtypes.DIDDocumentWithSeq{}, util.LogErr(errors.ErrNotFound, "DID is empty:", did)
+	}
+	if res.DidDocumentWithSeq.Deactivated() {
+		return didtypes.DIDDocumentWithSeq{}, util.LogErr(errors.ErrInvalidRequest, "DID is deactivated:", did)
+	}
+
+	return res.DidDocumentWithSeq, nil
+}
+
+func (q *ReviewQueue) broadcastDecisions(xplac provider.XplaClient, msgXplacs []provider.XplaClient) (*types.TxRes, error) {
+	txbytes, err := provider.ResetModuleAndMsgXplac(xplac).Batch(msgXplacs...).CreateAndSignTx()
+	if err != nil {
+		return nil, err
+	}
+
+	return xplac.BroadcastAndWait(txbytes, q.options.TxTimeout)
+}
+
+func (q *ReviewQueue) appendAuditLog(entries []ReviewAuditEntry) error {
+	if q.options.AuditLogPath == "" {
+		return nil
+	}
+
+	file, err := os.OpenFile(q.options.AuditLogPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return util.LogErr(errors.ErrParse, err)
+	}
+	defer file.Close()
+
+	for _, entry := range entries {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return util.LogErr(errors.ErrFailedToMarshal, err)
+		}
+		if _, err := file.Write(append(line, '\n')); err != nil {
+			return util.LogErr(errors.ErrParse, err)
+		}
+	}
+
+	return nil
+}
+
+// Read entries of the audit log.
+func ReadReviewAuditLog(path string) ([]ReviewAuditEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrParse, err)
+	}
+	defer file.Close()
+
+	var entries []ReviewAuditEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+		var entry ReviewAuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, util.LogErr(errors.ErrFailedToUnmarshal, err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, util.LogErr(errors.ErrParse, err)
+	}
+
+	return entries, nil
+}
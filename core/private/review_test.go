@@ -0,0 +1,302 @@
+package private
+
+import (
+	"context"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/Moonyongjung/xpriv.go/key"
+	"github.com/Moonyongjung/xpriv.go/provider"
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+	"github.com/Moonyongjung/xpriv.go/util/testutil"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	xcrypto "github.com/Moonyongjung/xpla-private-chain/crypto"
+	didtypes "github.com/Moonyongjung/xpla-private-chain/x/did/types"
+	privtypes "github.com/Moonyongjung/xpla-private-chain/x/private/types"
+)
+
+const (
+	testReviewTxHash        = "6B8F1E4D2C0A9E7F5D3B1A0C8E6F4D2B0A9C7E5F3D1B0A8C6E4F2D0B9A7C5E3F"
+	testReviewDIDPassphrase = "passphrase"
+)
+
+// Chain state of the fake xpla client for the review queue.
+type fakeReviewChain struct {
+	signer types.Signer
+	// DIDs under review for each page.
+	underReviewPages [][]string
+	documents        map[string]didtypes.DIDDocument
+
+	// Msgs of the signed transactions.
+	signedMsgs   [][]interface{}
+	broadcastErr error
+	code         uint32
+}
+
+// Make the fake xpla client which handles requests of the review queue by the chain state.
+func newFakeReviewClient(t *testing.T, chain *fakeReviewChain) provider.XplaClient {
+	return testutil.NewFakeXplaClient(t, testutil.FakeXplaClientHandlers{
+		Msg: func(method string, msg interface{}) interface{} {
+			if method == "AllUnderReviews" {
+				return privtypes.QueryAllUnderReviewsRequest{}
+			}
+			return msg
+		},
+		QueryTyped: func(c *testutil.FakeXplaClient, res interface{}) error {
+			switch c.Method {
+			case "AllUnderReviews":
+				// The page key is the index of the page.
+				var page int
+				if pageReq := c.GetMsg().(privtypes.QueryAllUnderReviewsRequest).Pagination; pageReq != nil && len(pageReq.Key) > 0 {
+					page, _ = strconv.Atoi(string(pageReq.Key))
+				}
+				allRes := res.(*privtypes.QueryAllUnderReviewsResponse)
+				for _, did := range chain.underReviewPages[page] {
+					allRes.UnderReviews = append(allRes.UnderReviews, &privtypes.UnderReview{Did: did})
+				}
+				allRes.Pagination = &query.PageResponse{}
+				if page+1 < len(chain.underReviewPages) {
+					allRes.Pagination.NextKey = []byte(strconv.Itoa(page + 1))
+				}
+			case "GetDID":
+				doc, ok := chain.documents[c.GetMsg().(types.GetDIDMsg).DID]
+				if !ok {
+					return testNotFoundErr()
+				}
+				res.(*didtypes.QueryDIDResponse).DidDocumentWithSeq = didtypes.DIDDocumentWithSeq{Document: &doc, Sequence: 1}
+			default:
+				return c.Unexpected()
+			}
+			return nil
+		},
+		CreateAndSignTx: func(c *testutil.FakeXplaClient) ([]byte, error) {
+			if c.Method != "Batch" {
+				return nil, c.Unexpected()
+			}
+			var msgs []interface{}
+			for _, batchMsg := range c.GetBatchMsgs() {
+				msgs = append(msgs, batchMsg.Msg)
+			}
+			chain.signedMsgs = append(chain.signedMsgs, msgs)
+			return []byte("batch"), nil
+		},
+		BroadcastAndWait: func(c *testutil.FakeXplaClient, txbytes []byte, timeout time.Duration) (*types.TxRes, error) {
+			if chain.broadcastErr != nil {
+				return nil, chain.broadcastErr
+			}
+			return &types.TxRes{Response: &sdk.TxResponse{TxHash: testReviewTxHash, Code: chain.code}}, nil
+		},
+	}).WithSigner(chain.signer)
+}
+
+// Make the DID which has the DID key "key1".
+func newTestReviewDID(t *testing.T) (string, secp256k1.PrivKey, didtypes.DIDDocument) {
+	privKey := secp256k1.GenPrivKey()
+	pubKey := xcrypto.PubKeyBytes(xcrypto.DerivePubKey(privKey))
+	did := didtypes.NewDID(pubKey)
+
+	key1ID := didtypes.NewVerificationMethodID(did, "key1")
+	key1 := didtypes.NewVerificationMethod(key1ID, didtypes.ES256K_2019, did, pubKey)
+	doc := didtypes.NewDIDDocument(did,
+		didtypes.WithVerificationMethods([]*didtypes.VerificationMethod{&key1}),
+		didtypes.WithAuthentications([]didtypes.VerificationRelationship{didtypes.NewVerificationRelationship(key1ID)}),
+	)
+	require.True(t, doc.Valid())
+
+	return did, privKey, doc
+}
+
+func newTestReviewQueue(t *testing.T, chain *fakeReviewChain, auditLogPath string) (*ReviewQueue, string) {
+	mnemonic, err := key.NewMnemonic()
+	require.NoError(t, err)
+	privKey, err := key.NewPrivKey(mnemonic)
+	require.NoError(t, err)
+	chain.signer = key.NewPrivKeySigner(privKey)
+
+	adminDID, adminDIDPrivKey, _ := newTestReviewDID(t)
+	adminDIDKey := adminDID + "#key1"
+	ks := key.NewMemDIDKeyStore()
+	require.NoError(t, key.ImportDIDKey(ks, adminDIDKey, adminDIDPrivKey, testReviewDIDPassphrase))
+
+	queue, err := NewReviewQueue(newFakeReviewClient(t, chain), ReviewQueueOptions{
+		AdminDIDKey:        adminDIDKey,
+		AdminDIDPassphrase: testReviewDIDPassphrase,
+		AdminDIDKeyStore:   ks,
+		AuditLogPath:       auditLogPath,
+	})
+	require.NoError(t, err)
+
+	return queue, adminDID
+}
+
+func TestReviewQueueList(t *testing.T) {
+	did1, _, doc1 := newTestReviewDID(t)
+	did2, _, doc2 := newTestReviewDID(t)
+	did3, _, _ := newTestReviewDID(t)
+
+	chain := &fakeReviewChain{
+		underReviewPages: [][]string{{did1, did2}, {did2}, {did3}},
+		documents: map[string]didtypes.DIDDocument{
+			did1: doc1,
+			did2: doc2,
+		},
+	}
+	queue, _ := newTestReviewQueue(t, chain, "")
+
+	underReviews, err := queue.List(context.Background())
+	require.NoError(t, err)
+
+	// all pages are listed, and the duplicated DID is listed once
+	require.Len(t, underReviews, 3)
+	require.Equal(t, did1, underReviews[0].DID)
+	require.Equal(t, did1, underReviews[0].Document.Id)
+	require.Equal(t, uint64(1), underReviews[0].Sequence)
+	require.Empty(t, underReviews[0].ResolveErr)
+	require.Equal(t, did2, underReviews[1].DID)
+	require.Equal(t, did2, underReviews[1].Document.Id)
+
+	// the participant is listed even if the DID document cannot be resolved
+	require.Equal(t, did3, underReviews[2].DID)
+	require.Nil(t, underReviews[2].Document)
+	require.NotEmpty(t, underReviews[2].ResolveErr)
+}
+
+func TestReviewQueueDecide(t *testing.T) {
+	participant1, _, _ := newTestReviewDID(t)
+	participant2, _, _ := newTestReviewDID(t)
+
+	testCases := []struct {
+		name      string
+		chain     fakeReviewChain
+		decisions []ReviewDecision
+
+		expectErr bool
+		// The transaction is not signed and the audit log is not written if the decision is invalid.
+		expectSigned bool
+		expectCode   uint32
+	}{
+		{
+			name: "accept and deny in one transaction",
+			decisions: []ReviewDecision{
+				{ParticipantDID: participant1, Decision: ReviewDecisionAccept, Reason: "verified"},
+				{ParticipantDID: participant2, Decision: ReviewDecisionDeny, Reason: "unknown organization"},
+			},
+			expectSigned: true,
+		},
+		{
+			name:  "transaction is failed",
+			chain: fakeReviewChain{code: 5},
+			decisions: []ReviewDecision{
+				{ParticipantDID: participant1, Decision: ReviewDecisionAccept},
+				{ParticipantDID: participant2, Decision: ReviewDecisionDeny},
+			},
+			expectSigned: true,
+			expectCode:   5,
+		},
+		{
+			name:  "transaction is not included",
+			chain: fakeReviewChain{broadcastErr: util.LogErr(errors.ErrTimeout, "tx is not included")},
+			decisions: []ReviewDecision{
+				{ParticipantDID: participant1, Decision: ReviewDecisionAccept},
+				{ParticipantDID: participant2, Decision: ReviewDecisionDeny},
+			},
+			expectErr:    true,
+			expectSigned: true,
+		},
+		{
+			name:      "no decision",
+			expectErr: true,
+		},
+		{
+			name: "invalid decision",
+			decisions: []ReviewDecision{
+				{ParticipantDID: participant1, Decision: ReviewDecisionAccept},
+				{ParticipantDID: participant2, Decision: "hold"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid participant DID",
+			decisions: []ReviewDecision{
+				{ParticipantDID: "did:invalid", Decision: ReviewDecisionDeny},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chain := tc.chain
+			auditLogPath := filepath.Join(t.TempDir(), "audit.log")
+			queue, adminDID := newTestReviewQueue(t, &chain, auditLogPath)
+
+			res, err := queue.Decide(context.Background(), tc.decisions)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, testReviewTxHash, res.Response.TxHash)
+			}
+
+			if !tc.expectSigned {
+				require.Empty(t, chain.signedMsgs)
+				require.NoFileExists(t, auditLogPath)
+				return
+			}
+
+			// decisions are sent in one transaction
+			require.Len(t, chain.signedMsgs, 1)
+			require.Len(t, chain.signedMsgs[0], 2)
+			_, ok := chain.signedMsgs[0][0].(privtypes.MsgAccept)
+			require.True(t, ok)
+			require.Equal(t, types.DenyMsg{ParticipantDID: participant2, AdminDID: adminDID}, chain.signedMsgs[0][1])
+
+			// every decision is recorded to the audit log with the result of the transaction
+			entries, err := ReadReviewAuditLog(auditLogPath)
+			require.NoError(t, err)
+			require.Len(t, entries, 2)
+			for i, entry := range entries {
+				require.Equal(t, tc.decisions[i].ParticipantDID, entry.ParticipantDID)
+				require.Equal(t, tc.decisions[i].Decision, entry.Decision)
+				require.Equal(t, tc.decisions[i].Reason, entry.Reason)
+				require.Equal(t, adminDID+"#key1", entry.AdminDIDKey)
+				require.Equal(t, tc.expectCode, entry.Code)
+				if tc.expectErr {
+					require.Empty(t, entry.TxHash)
+					require.NotEmpty(t, entry.Err)
+				} else {
+					require.Equal(t, testReviewTxHash, entry.TxHash)
+					require.Empty(t, entry.Err)
+				}
+			}
+			require.NotEmpty(t, entries[0].AdminProof)
+			require.Empty(t, entries[1].AdminProof)
+		})
+	}
+}
+
+func TestReviewAuditLogIsAppended(t *testing.T) {
+	participant, _, _ := newTestReviewDID(t)
+	auditLogPath := filepath.Join(t.TempDir(), "audit.log")
+	queue, _ := newTestReviewQueue(t, &fakeReviewChain{}, auditLogPath)
+
+	for i := 0; i < 2; i++ {
+		_, err := queue.Decide(context.Background(), []ReviewDecision{
+			{ParticipantDID: participant, Decision: ReviewDecisionDeny},
+		})
+		require.NoError(t, err)
+	}
+
+	entries, err := ReadReviewAuditLog(auditLogPath)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+}
@@ -0,0 +1,346 @@
+package private
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/Moonyongjung/xpriv.go/provider"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+	"github.com/btcsuite/btcutil/base58"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	didtypes "github.com/Moonyongjung/xpla-private-chain/x/did/types"
+)
+
+// Verifiable credential which is issued by the private chain.
+type VerifiableCredential struct {
+	Context           []string        `json:"@context,omitempty"`
+	ID                string          `json:"id,omitempty"`
+	Type              []string        `json:"type,omitempty"`
+	Issuer            string          `json:"issuer"`
+	IssuanceDate      string          `json:"issuanceDate,omitempty"`
+	ExpirationDate    string          `json:"expirationDate,omitempty"`
+	CredentialSubject json.RawMessage `json:"credentialSubject,omitempty"`
+	Proof             *Proof          `json:"proof"`
+
+	raw json.RawMessage
+}
+
+// Verifiable presentation which includes verifiable credentials of the holder.
+type VerifiablePresentation struct {
+	Context              []string                `json:"@context,omitempty"`
+	ID                   string                  `json:"id,omitempty"`
+	Type                 []string                `json:"type,omitempty"`
+	Holder               string                  `json:"holder"`
+	IssuanceDate         string                  `json:"issuanceDate,omitempty"`
+	ExpirationDate       string                  `json:"expirationDate,omitempty"`
+	VerifiableCredential []*VerifiableCredential `json:"verifiableCredential"`
+	Proof                *Proof                  `json:"proof"`
+
+	raw json.RawMessage
+}
+
+// Proof of the verifiable credential or presentation.
+// The signature is the detached JWS or the base64 encoded signature value.
+type Proof struct {
+	Type               string `json:"type"`
+	Created            string `json:"created,omitempty"`
+	ProofPurpose       string `json:"proofPurpose,omitempty"`
+	VerificationMethod string `json:"verificationMethod"`
+	JWS                string `json:"jws,omitempty"`
+	ProofValue         string `json:"proofValue,omitempty"`
+}
+
+// Resolve the DID document of the DID.
+type DIDResolver func(did string) (*didtypes.DIDDocument, error)
+
+// Verifier of verifiable credentials and presentations.
+// Proofs are verified locally by the public keys of the verification methods in the DID documents
+// which are resolved by the DID resolver.
+type Verifier struct {
+	Resolver DIDResolver
+	// Current time for checking the expiry. Default is time.Now.
+	Now func() time.Time
+}
+
+// Make the verifier which resolves DID documents by querying the private chain with the xpla client.
+func NewVerifier(xplac provider.XplaClient) *Verifier {
+	return &Verifier{Resolver: NewChainDIDResolver(xplac)}
+}
+
+// Make the DID resolver which queries DID documents by using gRPC or LCD of the xpla client.
+// The deactivated DID cannot be resolved.
+func NewChainDIDResolver(xplac provider.XplaClient) DIDResolver {
+	return func(did string) (*didtypes.DIDDocument, error) {
+		docWithSeq, err := util.GetDIDDocByQueryClient(did, xplac.GetLcdURL(), xplac.GetGrpcUrl(), xplac.GetGrpcClient(), xplac.GetContext())
+		if err != nil {
+			return nil, util.LogErr(errors.ErrNotFound, "failed to resolve DID", did, ":", err)
+		}
+		if docWithSeq.Document == nil {
+			return nil, util.LogErr(errors.ErrNotFound, "DID document is empty:", did)
+		}
+
+		return docWithSeq.Document, nil
+	}
+}
+
+// Make the DID resolver which uses given DID documents without querying.
+func NewStaticDIDResolver(docs ...*didtypes.DIDDocument) DIDResolver {
+	docMap := make(map[string]*didtypes.DIDDocument)
+	for _, doc := range docs {
+		docMap[doc.Id] = doc
+	}
+
+	return func(did string) (*didtypes.DIDDocument, error) {
+		doc, ok := docMap[did]
+		if !ok {
+			return nil, util.LogErr(errors.ErrNotFound, "DID document is not found:", did)
+		}
+		return doc, nil
+	}
+}
+
+// Parse the verifiable credential JSON.
+func ParseVC(vcJson []byte) (*VerifiableCredential, error) {
+	var vc VerifiableCredential
+	if err := json.Unmarshal(vcJson, &vc); err != nil {
+		return nil, util.LogErr(errors.ErrFailedToUnmarshal, err)
+	}
+	vc.raw = append(json.RawMessage(nil), vcJson...)
+
+	return &vc, nil
+}
+
+// Parse the verifiable presentation JSON.
+func ParseVP(vpJson []byte) (*VerifiablePresentation, error) {
+	var vp VerifiablePresentation
+	if err := json.Unmarshal(vpJson, &vp); err != nil {
+		return nil, util.LogErr(errors.ErrFailedToUnmarshal, err)
+	}
+	vp.raw = append(json.RawMessage(nil), vpJson...)
+
+	// Keep raw JSON of each credential in order to verify proofs of credentials.
+	var rawVP struct {
+		VerifiableCredential []json.RawMessage `json:"verifiableCredential"`
+	}
+	if err := json.Unmarshal(vpJson, &rawVP); err != nil {
+		return nil, util.LogErr(errors.ErrFailedToUnmarshal, err)
+	}
+	for i, rawVC := range rawVP.VerifiableCredential {
+		if i < len(vp.VerifiableCredential) && vp.VerifiableCredential[i] != nil {
+			vp.VerifiableCredential[i].raw = append(json.RawMessage(nil), rawVC...)
+		}
+	}
+
+	return &vp, nil
+}
+
+// Parse and verify the verifiable credential JSON.
+func (v *Verifier) VerifyVCJson(vcJson []byte) (*VerifiableCredential, error) {
+	vc, err := ParseVC(vcJson)
+	if err != nil {
+		return nil, err
+	}
+
+	return vc, v.VerifyVC(vc)
+}
+
+// Parse and verify the verifiable presentation JSON.
+func (v *Verifier) VerifyVPJson(vpJson []byte) (*VerifiablePresentation, error) {
+	vp, err := ParseVP(vpJson)
+	if err != nil {
+		return nil, err
+	}
+
+	return vp, v.VerifyVP(vp)
+}
+
+// Verify the proof and the expiry of the verifiable credential.
+// The proof must be signed by the verification method of the issuer.
+func (v *Verifier) VerifyVC(vc *VerifiableCredential) error {
+	if vc == nil {
+		return util.LogErr(errors.ErrInvalidRequest, "VC is empty")
+	}
+	if vc.Issuer == "" {
+		return util.LogErr(errors.ErrInvalidRequest, "issuer of the VC is empty")
+	}
+
+	if err := v.checkValidity(vc.IssuanceDate, vc.ExpirationDate); err != nil {
+		return err
+	}
+
+	return v.verifyProof(vc.raw, vc.Proof, vc.Issuer)
+}
+
+// Verify the proof and the expiry of the verifiable presentation and all credentials in it.
+// The proof must be signed by the verification method of the holder.
+func (v *Verifier) VerifyVP(vp *VerifiablePresentation) error {
+	if vp == nil {
+		return util.LogErr(errors.ErrInvalidRequest, "VP is empty")
+	}
+	if vp.Holder == "" {
+		return util.LogErr(errors.ErrInvalidRequest, "holder of the VP is empty")
+	}
+	if len(vp.VerifiableCredential) == 0 {
+		return util.LogErr(errors.ErrInvalidRequest, "VP has no VC")
+	}
+
+	if err := v.checkValidity(vp.IssuanceDate, vp.ExpirationDate); err != nil {
+		return err
+	}
+
+	if err := v.verifyProof(vp.raw, vp.Proof, vp.Holder); err != nil {
+		return err
+	}
+
+	for _, vc := range vp.VerifiableCredential {
+		if err := v.VerifyVC(vc); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Check the issuance date and the expiration date by the current time.
+func (v *Verifier) checkValidity(issuanceDate, expirationDate string) error {
+	now := time.Now()
+	if v.Now != nil {
+		now = v.Now()
+	}
+
+	if issuanceDate != "" {
+		issuedAt, err := time.Parse(time.RFC3339, issuanceDate)
+		if err != nil {
+			return util.LogErr(errors.ErrParse, "invalid issuance date:", err)
+		}
+		if now.Before(issuedAt) {
+			return util.LogErr(errors.ErrInvalidRequest, "not valid yet, issuance date:", issuanceDate)
+		}
+	}
+
+	if expirationDate != "" {
+		expiredAt, err := time.Parse(time.RFC3339, expirationDate)
+		if err != nil {
+			return util.LogErr(errors.ErrParse, "invalid expiration date:", err)
+		}
+		if !now.Before(expiredAt) {
+			return util.LogErr(errors.ErrInvalidRequest, "expired at", expirationDate)
+		}
+	}
+
+	return nil
+}
+
+// Verify the proof by the public key of the verification method.
+// The signed data is the JSON of the document without the proof, which is marshaled with sorted keys.
+func (v *Verifier) verifyProof(raw json.RawMessage, proof *Proof, signer string) error {
+	if proof == nil {
+		return util.LogErr(errors.ErrInvalidRequest, "proof is empty")
+	}
+	if len(raw) == 0 {
+		return util.LogErr(errors.ErrInvalidRequest, "raw JSON of the document is empty, use ParseVC or ParseVP")
+	}
+	if v.Resolver == nil {
+		return util.LogErr(errors.ErrNotSatisfiedOptions, "need DID resolver")
+	}
+
+	vmDID := strings.Split(proof.VerificationMethod, "#")[0]
+	if vmDID != signer {
+		return util.LogErr(errors.ErrInvalidRequest, "verification method", proof.VerificationMethod, "is not the method of", signer)
+	}
+
+	doc, err := v.Resolver(signer)
+	if err != nil {
+		return err
+	}
+
+	pubKey, err := verificationMethodPubKey(doc, proof.VerificationMethod)
+	if err != nil {
+		return err
+	}
+
+	payload, err := unsignedDocument(raw)
+	if err != nil {
+		return err
+	}
+
+	signingInput, sig, err := proofSignature(proof, payload)
+	if err != nil {
+		return err
+	}
+
+	if !pubKey.VerifySignature(signingInput, sig) {
+		return util.LogErr(errors.ErrInvalidRequest, "invalid proof signature of", signer)
+	}
+
+	return nil
+}
+
+// Get the secp256k1 public key of the verification method in the DID document.
+func verificationMethodPubKey(doc *didtypes.DIDDocument, verificationMethodID string) (secp256k1.PubKey, error) {
+	for _, vm := range doc.VerificationMethods {
+		if vm == nil || vm.Id != verificationMethodID {
+			continue
+		}
+		if vm.Type != didtypes.ES256K_2019 {
+			return nil, util.LogErr(errors.ErrNotSupport, "verification method type", vm.Type)
+		}
+
+		pubKey := base58.Decode(vm.PublicKeyBase58)
+		if len(pubKey) != secp256k1.PubKeySize {
+			return nil, util.LogErr(errors.ErrParse, "invalid public key of the verification method", verificationMethodID)
+		}
+		return secp256k1.PubKey(pubKey), nil
+	}
+
+	return nil, util.LogErr(errors.ErrNotFound, "verification method", verificationMethodID, "is not in the DID document")
+}
+
+// Remove the proof of the document and marshal it with sorted keys.
+func unsignedDocument(raw json.RawMessage) ([]byte, error) {
+	var doc map[string]interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, util.LogErr(errors.ErrFailedToUnmarshal, err)
+	}
+	delete(doc, "proof")
+
+	out, err := json.Marshal(doc)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrFailedToMarshal, err)
+	}
+
+	return out, nil
+}
+
+// Get the signing input and the signature of the proof.
+// The detached JWS signs "header.base64url(payload)", and the proof value signs the payload.
+func proofSignature(proof *Proof, payload []byte) ([]byte, []byte, error) {
+	if proof.JWS != "" {
+		parts := strings.Split(proof.JWS, ".")
+		if len(parts) != 3 || parts[1] != "" {
+			return nil, nil, util.LogErr(errors.ErrParse, "invalid detached JWS")
+		}
+
+		sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+		if err != nil {
+			return nil, nil, util.LogErr(errors.ErrParse, err)
+		}
+
+		signingInput := parts[0] + "." + base64.RawURLEncoding.EncodeToString(payload)
+		return []byte(signingInput), sig, nil
+	}
+
+	if proof.ProofValue != "" {
+		sig, err := base64.StdEncoding.DecodeString(proof.ProofValue)
+		if err != nil {
+			return nil, nil, util.LogErr(errors.ErrParse, err)
+		}
+		return payload, sig, nil
+	}
+
+	return nil, nil, util.LogErr(errors.ErrInvalidRequest, "signature of the proof is empty")
+}
@@ -0,0 +1,91 @@
+package private_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/Moonyongjung/xpriv.go/core/private"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	didtypes "github.com/Moonyongjung/xpla-private-chain/x/did/types"
+)
+
+func newTestDID(t *testing.T) (secp256k1.PrivKey, *didtypes.DIDDocument) {
+	privKey := secp256k1.GenPrivKey()
+	pubKey := privKey.PubKey().Bytes()
+
+	did := didtypes.NewDID(pubKey)
+	verificationMethodID := didtypes.NewVerificationMethodID(did, "key1")
+	verificationMethod := didtypes.NewVerificationMethod(verificationMethodID, didtypes.ES256K_2019, did, pubKey)
+	doc := didtypes.NewDIDDocument(did, didtypes.WithVerificationMethods([]*didtypes.VerificationMethod{&verificationMethod}))
+
+	return privKey, &doc
+}
+
+func signTestDocument(t *testing.T, doc map[string]interface{}, privKey secp256k1.PrivKey, verificationMethod string) map[string]interface{} {
+	payload, err := json.Marshal(doc)
+	require.NoError(t, err)
+
+	sig, err := privKey.Sign(payload)
+	require.NoError(t, err)
+
+	doc["proof"] = map[string]interface{}{
+		"type":               "EcdsaSecp256k1Signature2019",
+		"verificationMethod": verificationMethod,
+		"proofValue":         base64.StdEncoding.EncodeToString(sig),
+	}
+	return doc
+}
+
+// Documents are signed in the test, and the VC issued by the chain is verified in the client test suite.
+func TestVerifyVP(t *testing.T) {
+	issuerKey, issuerDoc := newTestDID(t)
+	holderKey, holderDoc := newTestDID(t)
+
+	now := time.Now().UTC()
+	vc := signTestDocument(t, map[string]interface{}{
+		"type":              []string{"VerifiableCredential"},
+		"issuer":            issuerDoc.Id,
+		"issuanceDate":      now.Add(-time.Hour).Format(time.RFC3339),
+		"expirationDate":    now.Add(time.Hour).Format(time.RFC3339),
+		"credentialSubject": map[string]interface{}{"id": holderDoc.Id},
+	}, issuerKey, issuerDoc.Id+"#key1")
+
+	vp := signTestDocument(t, map[string]interface{}{
+		"type":                 []string{"VerifiablePresentation"},
+		"holder":               holderDoc.Id,
+		"verifiableCredential": []interface{}{vc},
+	}, holderKey, holderDoc.Id+"#key1")
+
+	vpJson, err := json.Marshal(vp)
+	require.NoError(t, err)
+
+	verifier := &private.Verifier{Resolver: private.NewStaticDIDResolver(issuerDoc, holderDoc)}
+
+	parsed, err := verifier.VerifyVPJson(vpJson)
+	require.NoError(t, err)
+	require.Equal(t, holderDoc.Id, parsed.Holder)
+	require.Len(t, parsed.VerifiableCredential, 1)
+	require.Equal(t, issuerDoc.Id, parsed.VerifiableCredential[0].Issuer)
+
+	// expired VC
+	verifier.Now = func() time.Time { return now.Add(2 * time.Hour) }
+	_, err = verifier.VerifyVPJson(vpJson)
+	require.Error(t, err)
+	verifier.Now = nil
+
+	// tampered VP
+	vp["holder"] = issuerDoc.Id
+	tampered, err := json.Marshal(vp)
+	require.NoError(t, err)
+	_, err = verifier.VerifyVPJson(tampered)
+	require.Error(t, err)
+
+	// unknown DID
+	verifier.Resolver = private.NewStaticDIDResolver(holderDoc)
+	_, err = verifier.VerifyVPJson(vpJson)
+	require.Error(t, err)
+}
@@ -0,0 +1,146 @@
+package private
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Moonyongjung/xpriv.go/provider"
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	privtypes "github.com/Moonyongjung/xpla-private-chain/x/private/types"
+)
+
+const DefaultVPRenewBefore = 5 * time.Minute
+
+var _ types.VPProvider = &RenewingVPProvider{}
+
+// VP provider which gets the VP of the participant from the private chain by GenDIDSign and GetVP,
+// and renews the VP before it is expired. The expiry is the earliest expiration date of the VP and VCs in it.
+// It is safe for concurrent use.
+type RenewingVPProvider struct {
+	mu            sync.Mutex
+	xplac         provider.XplaClient
+	genDIDSignMsg types.GenDIDSignMsg
+	renewBefore   time.Duration
+	vp            []byte
+	expiresAt     time.Time
+}
+
+// Make new VP provider which renews the VP of the DID key in the message.
+// The VP is renewed when the remaining time until the expiry is less than renewBefore (default 5 minutes).
+// The xpla client is used for getting the VP, so it does not use any VP provider.
+func NewRenewingVPProvider(xplac provider.XplaClient, genDIDSignMsg types.GenDIDSignMsg, renewBefore time.Duration) *RenewingVPProvider {
+	if renewBefore <= 0 {
+		renewBefore = DefaultVPRenewBefore
+	}
+
+	return &RenewingVPProvider{
+		xplac:         xplac.WithVPProvider(nil),
+		genDIDSignMsg: genDIDSignMsg,
+		renewBefore:   renewBefore,
+	}
+}
+
+// Get the VP. It is renewed if it is not obtained yet or it will be expired soon.
+func (p *RenewingVPProvider) VP(ctx context.Context) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.vp == nil || (!p.expiresAt.IsZero() && time.Now().Add(p.renewBefore).After(p.expiresAt)) {
+		return p.renew(ctx)
+	}
+	return p.vp, nil
+}
+
+// Renew the VP.
+func (p *RenewingVPProvider) Refresh(ctx context.Context) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.renew(ctx)
+}
+
+// Get the expiry of the current VP. It is zero if the VP has no expiration date.
+func (p *RenewingVPProvider) ExpiresAt() time.Time {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.expiresAt
+}
+
+func (p *RenewingVPProvider) renew(ctx context.Context) ([]byte, error) {
+	vp, err := queryVP(p.xplac.WithContext(ctx), p.genDIDSignMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	p.vp = vp
+	p.expiresAt = vpExpiresAt(vp)
+	return vp, nil
+}
+
+// Get the VP of the DID key by using the DID signature.
+func queryVP(xplac provider.XplaClient, genDIDSignMsg types.GenDIDSignMsg) ([]byte, error) {
+	didSign, err := genDIDSign(xplac, genDIDSignMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	var res privtypes.QueryGetVPResponse
+	err = xplac.GetVP(types.GetVPMsg{
+		DIDKey:        genDIDSignMsg.DIDKey,
+		DIDSignBase64: didSign,
+	}).QueryTyped(&res)
+	if err != nil {
+		return nil, err
+	}
+	if res.Vp == "" {
+		return nil, util.LogErr(errors.ErrNotFound, "VP is empty, DID key:", genDIDSignMsg.DIDKey)
+	}
+
+	return []byte(res.Vp), nil
+}
+
+// Generate the DID signature with the current DID sequence.
+// The message of GenDIDSign is the signature, so it is not needed to query.
+func genDIDSign(xplac provider.XplaClient, genDIDSignMsg types.GenDIDSignMsg) (string, error) {
+	signXplac := xplac.GenDIDSign(genDIDSignMsg)
+	if signXplac.GetErr() != nil {
+		return "", signXplac.GetErr()
+	}
+
+	didSign, _ := signXplac.GetMsg().(string)
+	return didSign, nil
+}
+
+// Get the earliest expiration date of the VP and VCs in it.
+// It is zero if the VP cannot be parsed or it has no expiration date.
+func vpExpiresAt(vpJson []byte) time.Time {
+	vp, err := ParseVP(vpJson)
+	if err != nil {
+		return time.Time{}
+	}
+
+	dates := []string{vp.ExpirationDate}
+	for _, vc := range vp.VerifiableCredential {
+		if vc != nil {
+			dates = append(dates, vc.ExpirationDate)
+		}
+	}
+
+	var expiresAt time.Time
+	for _, date := range dates {
+		t, err := time.Parse(time.RFC3339, date)
+		if err != nil {
+			continue
+		}
+		if expiresAt.IsZero() || t.Before(expiresAt) {
+			expiresAt = t
+		}
+	}
+
+	return expiresAt
+}
@@ -0,0 +1,90 @@
+package private
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testVPWithExpiry(vpExpiresAt, vcExpiresAt time.Time) string {
+	return fmt.Sprintf(
+		`{"holder":"%s","expirationDate":"%s","verifiableCredential":[{"issuer":"did:xpla:issuer","expirationDate":"%s"}]}`,
+		testOnboardingDID,
+		vpExpiresAt.UTC().Format(time.RFC3339),
+		vcExpiresAt.UTC().Format(time.RFC3339),
+	)
+}
+
+func TestRenewingVPProvider(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name            string
+		vp              string
+		expectExpiresAt time.Time
+		expectRenewed   bool
+	}{
+		{
+			name:            "VP is not expired soon",
+			vp:              testVPWithExpiry(now.Add(time.Hour), now.Add(2*time.Hour)),
+			expectExpiresAt: now.Add(time.Hour),
+			expectRenewed:   false,
+		},
+		{
+			name:            "VP is expired soon",
+			vp:              testVPWithExpiry(now.Add(time.Minute), now.Add(2*time.Hour)),
+			expectExpiresAt: now.Add(time.Minute),
+			expectRenewed:   true,
+		},
+		{
+			name:            "VC in the VP is expired soon",
+			vp:              testVPWithExpiry(now.Add(time.Hour), now.Add(time.Minute)),
+			expectExpiresAt: now.Add(time.Minute),
+			expectRenewed:   true,
+		},
+		{
+			name:            "VP is already expired",
+			vp:              testVPWithExpiry(now.Add(-time.Minute), now.Add(time.Hour)),
+			expectExpiresAt: now.Add(-time.Minute),
+			expectRenewed:   true,
+		},
+		{
+			name:          "VP has no expiration date",
+			vp:            `{"holder":"did:xpla:onboarding"}`,
+			expectRenewed: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chain := &fakeOnboardingChain{vp: tc.vp}
+			vpProvider := NewRenewingVPProvider(newFakeOnboardingClient(t, chain), types.GenDIDSignMsg{DIDKey: testOnboardingDID + "#key1"}, 5*time.Minute)
+
+			// the VP is requested at first
+			vp, err := vpProvider.VP(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tc.vp, string(vp))
+			require.Equal(t, 1, chain.vpRequested)
+			require.Equal(t, tc.expectExpiresAt.Unix(), vpProvider.ExpiresAt().Unix())
+
+			// the VP is renewed before it is expired
+			_, err = vpProvider.VP(context.Background())
+			require.NoError(t, err)
+			if tc.expectRenewed {
+				require.Equal(t, 2, chain.vpRequested)
+			} else {
+				require.Equal(t, 1, chain.vpRequested)
+			}
+
+			// the VP is always renewed by refresh
+			requested := chain.vpRequested
+			_, err = vpProvider.Refresh(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, requested+1, chain.vpRequested)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package core
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+const (
+	printProto = iota
+	printLegacy
+	printJson
+	printJsonIndent
+	printRaw
+)
+
+// Response of the routed query.
+// The response is kept as the type which is received from the chain, and it is
+// converted to string or decoded to the typed response only when it is requested.
+type QueryResponse struct {
+	i         QueryClient
+	response  interface{}
+	raw       []byte
+	printType int
+}
+
+// Make query response of protobuf message which is received by using gRPC.
+func ProtoQueryResponse(i QueryClient, res proto.Message) *QueryResponse {
+	return &QueryResponse{i: i, response: res, printType: printProto}
+}
+
+// Make query response of object which is printed by cosmos sdk legacy amino.
+func LegacyQueryResponse(i QueryClient, res interface{}) *QueryResponse {
+	return &QueryResponse{i: i, response: res, printType: printLegacy}
+}
+
+// Make query response of object which is printed by JSON.
+func JsonQueryResponse(i QueryClient, res interface{}) *QueryResponse {
+	return &QueryResponse{i: i, response: res, printType: printJson}
+}
+
+// Make query response of object which is printed by JSON with indent.
+func JsonIndentQueryResponse(i QueryClient, res interface{}) *QueryResponse {
+	return &QueryResponse{i: i, response: res, printType: printJsonIndent}
+}
+
+// Make query response of raw bytes, as the response body of LCD.
+func RawQueryResponse(i QueryClient, out []byte) *QueryResponse {
+	return &QueryResponse{i: i, raw: out, printType: printRaw}
+}
+
+// Get the response as it is received. It is nil when the response is raw bytes.
+func (r *QueryResponse) Response() interface{} {
+	return r.response
+}
+
+// Get the response bytes.
+func (r *QueryResponse) Bytes() ([]byte, error) {
+	switch r.printType {
+	case printProto:
+		return PrintProto(r.i, r.response.(proto.Message))
+	case printLegacy:
+		return PrintObjectLegacy(r.i, r.response)
+	case printJson:
+		out, err := util.JsonMarshalData(r.response)
+		if err != nil {
+			return nil, util.LogErr(errors.ErrFailedToMarshal, err)
+		}
+		return out, nil
+	case printJsonIndent:
+		out, err := util.JsonMarshalDataIndent(r.response)
+		if err != nil {
+			return nil, util.LogErr(errors.ErrFailedToMarshal, err)
+		}
+		return out, nil
+	default:
+		return r.raw, nil
+	}
+}
+
+// Get the response as string type.
+func (r *QueryResponse) String() (string, error) {
+	out, err := r.Bytes()
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+// Decode the response to the target which must be a pointer of the response type.
+// If the response has same type as the target, it is assigned without marshaling.
+func (r *QueryResponse) Decode(target interface{}) error {
+	targetValue := reflect.ValueOf(target)
+	if target == nil || targetValue.Kind() != reflect.Ptr || targetValue.IsNil() {
+		return util.LogErr(errors.ErrInvalidRequest, "decode target must be a non-nil pointer")
+	}
+
+	if r.response != nil {
+		resValue := reflect.ValueOf(r.response)
+		switch {
+		case resValue.Type() == targetValue.Type():
+			targetValue.Elem().Set(resValue.Elem())
+			return nil
+		case resValue.Type() == targetValue.Elem().Type():
+			targetValue.Elem().Set(resValue)
+			return nil
+		}
+	}
+
+	if s, ok := target.(*string); ok && r.printType == printRaw {
+		*s = string(r.raw)
+		return nil
+	}
+
+	out, err := r.Bytes()
+	if err != nil {
+		return err
+	}
+
+	if protoTarget, ok := target.(proto.Message); ok {
+		if r.printType == printLegacy {
+			err = r.i.Ixplac.GetEncoding().Amino.UnmarshalJSON(out, target)
+		} else {
+			err = r.i.Ixplac.GetEncoding().Marshaler.UnmarshalJSON(out, protoTarget)
+		}
+	} else {
+		err = json.Unmarshal(out, target)
+	}
+	if err != nil {
+		return util.LogErr(errors.ErrFailedToUnmarshal, err)
+	}
+
+	return nil
+}
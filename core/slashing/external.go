@@ -20,11 +20,11 @@ func NewSlashingExternal(xplac provider.XplaClient) (e SlashingExternal) {
 
 // Unjail validator previously jailed for downtime.
 func (e SlashingExternal) Unjail() provider.XplaClient {
-	msg, err := MakeUnjailMsg(e.Xplac.GetPrivateKey())
+	msg, err := MakeUnjailMsg(e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(SlashingModule).
+	e.Xplac = e.Xplac.WithModule(SlashingModule).
 		WithMsgType(SlahsingUnjailMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -38,7 +38,7 @@ func (e SlashingExternal) SlashingParams() provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(SlashingModule).
+	e.Xplac = e.Xplac.WithModule(SlashingModule).
 		WithMsgType(SlashingQuerySlashingParamsMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -47,11 +47,11 @@ func (e SlashingExternal) SlashingParams() provider.XplaClient {
 // Query a validator's signing information or signing information of all validators.
 func (e SlashingExternal) SigningInfos(signingInfoMsg ...types.SigningInfoMsg) provider.XplaClient {
 	if len(signingInfoMsg) == 0 {
-		msg, err := MakeQuerySigningInfosMsg()
+		msg, err := MakeQuerySigningInfosMsg(e.Xplac.GetPagination())
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(SlashingModule).
+		e.Xplac = e.Xplac.WithModule(SlashingModule).
 			WithMsgType(SlashingQuerySigningInfosMsgType).
 			WithMsg(msg)
 	} else if len(signingInfoMsg) == 1 {
@@ -59,11 +59,11 @@ func (e SlashingExternal) SigningInfos(signingInfoMsg ...types.SigningInfoMsg) p
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(SlashingModule).
+		e.Xplac = e.Xplac.WithModule(SlashingModule).
 			WithMsgType(SlashingQuerySigningInfoMsgType).
 			WithMsg(msg)
 	} else {
-		provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
+		e.Xplac = provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
 	}
 	return e.Xplac
 }
@@ -13,11 +13,11 @@ func (s *IntegrationTestSuite) TestSlashingTx() {
 	r := rand.New(src)
 	accounts := testutil.RandomAccounts(r, 2)
 
-	s.xplac.WithPrivateKey(accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(accounts[0].PrivKey)
 	// unjail
-	s.xplac.Unjail()
+	s.xplac = s.xplac.Unjail()
 
-	makeUnjailMsg, err := mslashing.MakeUnjailMsg(s.xplac.GetPrivateKey())
+	makeUnjailMsg, err := mslashing.MakeUnjailMsg(s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeUnjailMsg, s.xplac.GetMsg())
@@ -34,7 +34,7 @@ func (s *IntegrationTestSuite) TestSlashingTx() {
 
 func (s *IntegrationTestSuite) TestSlashing() {
 	// slashing params
-	s.xplac.SlashingParams()
+	s.xplac = s.xplac.SlashingParams()
 
 	makeQuerySlashingParamsMsg, err := mslashing.MakeQuerySlashingParamsMsg()
 	s.Require().NoError(err)
@@ -44,9 +44,9 @@ func (s *IntegrationTestSuite) TestSlashing() {
 	s.Require().Equal(mslashing.SlashingQuerySlashingParamsMsgType, s.xplac.GetMsgType())
 
 	// signing infos
-	s.xplac.SigningInfos()
+	s.xplac = s.xplac.SigningInfos()
 
-	makeQuerySigningInfosMsg, err := mslashing.MakeQuerySigningInfosMsg()
+	makeQuerySigningInfosMsg, err := mslashing.MakeQuerySigningInfosMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQuerySigningInfosMsg, s.xplac.GetMsg())
@@ -57,7 +57,7 @@ func (s *IntegrationTestSuite) TestSlashing() {
 	signingInfoMsg := types.SigningInfoMsg{
 		ConsPubKey: `{"@type": "/cosmos.crypto.ed25519.PubKey","key": "6RBPm24ckoWhRt8mArcSCnEKvt0FMGvcaMwchfZ3ue8="}`,
 	}
-	s.xplac.SigningInfos(signingInfoMsg)
+	s.xplac = s.xplac.SigningInfos(signingInfoMsg)
 
 	makeQuerySigningInfoMsg, err := mslashing.MakeQuerySigningInfoMsg(signingInfoMsg, s.xplac.GetEncoding())
 	s.Require().NoError(err)
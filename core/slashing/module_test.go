@@ -12,7 +12,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 	src := rand.NewSource(1)
 	r := rand.New(src)
 	accounts := testutil.RandomAccounts(r, 2)
-	s.xplac.WithPrivateKey(accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(accounts[0].PrivKey)
 
 	c := slashing.NewCoreModule()
 
@@ -24,9 +24,9 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 	txBuilder := s.xplac.GetEncoding().TxConfig.NewTxBuilder()
 
 	// unjail
-	s.xplac.Unjail()
+	s.xplac = s.xplac.Unjail()
 
-	makeUnjailMsg, err := slashing.MakeUnjailMsg(s.xplac.GetPrivateKey())
+	makeUnjailMsg, err := slashing.MakeUnjailMsg(s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	testMsg = makeUnjailMsg
@@ -1,17 +1,16 @@
 package slashing
 
 import (
-	"github.com/Moonyongjung/xpriv.go/core"
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 
 	"github.com/Moonyongjung/xpla-private-chain/app/params"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
 // (Tx) make msg - unjail
-func MakeUnjailMsg(privKey key.PrivateKey) (slashingtypes.MsgUnjail, error) {
-	return parseUnjailArgs(privKey)
+func MakeUnjailMsg(signer types.Signer) (slashingtypes.MsgUnjail, error) {
+	return parseUnjailArgs(signer)
 }
 
 // (Query) make msg - slahsing params
@@ -20,9 +19,9 @@ func MakeQuerySlashingParamsMsg() (slashingtypes.QueryParamsRequest, error) {
 }
 
 // (Query) make msg - signing infos
-func MakeQuerySigningInfosMsg() (slashingtypes.QuerySigningInfosRequest, error) {
+func MakeQuerySigningInfosMsg(pageReq *query.PageRequest) (slashingtypes.QuerySigningInfosRequest, error) {
 	return slashingtypes.QuerySigningInfosRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageReq,
 	}, nil
 }
 
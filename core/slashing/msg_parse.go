@@ -1,7 +1,6 @@
 package slashing
 
 import (
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
@@ -13,10 +12,10 @@ import (
 )
 
 // Parsing - unjail
-func parseUnjailArgs(privKey key.PrivateKey) (slashingtypes.MsgUnjail, error) {
-	addr, err := util.GetAddrByPrivKey(privKey)
+func parseUnjailArgs(signer types.Signer) (slashingtypes.MsgUnjail, error) {
+	addr, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return slashingtypes.MsgUnjail{}, util.LogErr(errors.ErrParse, err)
+		return slashingtypes.MsgUnjail{}, err
 	}
 
 	msg := slashingtypes.NewMsgUnjail(sdk.ValAddress(addr))
@@ -11,12 +11,8 @@ import (
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for slashing module.
-func QuerySlashing(i core.QueryClient) (string, error) {
+func QuerySlashing(i core.QueryClient) (*core.QueryResponse, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcSlashing(i)
 	} else {
@@ -24,7 +20,10 @@ func QuerySlashing(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcSlashing(i core.QueryClient) (string, error) {
+func queryByGrpcSlashing(i core.QueryClient) (*core.QueryResponse, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := slashingtypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -36,7 +35,7 @@ func queryByGrpcSlashing(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Slashing signing information
@@ -47,7 +46,7 @@ func queryByGrpcSlashing(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Slashing signing information
@@ -58,19 +57,14 @@ func queryByGrpcSlashing(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", err
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	return string(out), nil
+	return core.ProtoQueryResponse(i, res), nil
 }
 
 const (
@@ -78,7 +72,7 @@ const (
 	slashingSigningInfosLabel = "signing_infos"
 )
 
-func queryByLcdSlashing(i core.QueryClient) (string, error) {
+func queryByLcdSlashing(i core.QueryClient) (*core.QueryResponse, error) {
 	url := util.MakeQueryLcdUrl(slashingv1beta1.Query_ServiceDesc.Metadata.(string))
 	switch {
 	// Slashing parameters
@@ -96,13 +90,13 @@ func queryByLcdSlashing(i core.QueryClient) (string, error) {
 		url = url + util.MakeQueryLabels(slashingSigningInfosLabel, convertMsg.ConsAddress)
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+url, i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
+	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+core.LcdPaginationURL(url, i.Ixplac.GetMsg()), i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return string(out), nil
+	return core.RawQueryResponse(i, out), nil
 }
@@ -20,11 +20,11 @@ func NewStakingExternal(xplac provider.XplaClient) (e StakingExternal) {
 
 // Create new validator initialized with a self-delegation to it.
 func (e StakingExternal) CreateValidator(createValidatorMsg types.CreateValidatorMsg) provider.XplaClient {
-	msg, err := MakeCreateValidatorMsg(createValidatorMsg, e.Xplac.GetPrivateKey(), e.Xplac.GetOutputDocument())
+	msg, err := MakeCreateValidatorMsg(createValidatorMsg, e.Xplac.GetSigner(), e.Xplac.GetOutputDocument())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(StakingModule).
+	e.Xplac = e.Xplac.WithModule(StakingModule).
 		WithMsgType(StakingCreateValidatorMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -32,11 +32,11 @@ func (e StakingExternal) CreateValidator(createValidatorMsg types.CreateValidato
 
 // Edit an existing validator account.
 func (e StakingExternal) EditValidator(editValidatorMsg types.EditValidatorMsg) provider.XplaClient {
-	msg, err := MakeEditValidatorMsg(editValidatorMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeEditValidatorMsg(editValidatorMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(StakingModule).
+	e.Xplac = e.Xplac.WithModule(StakingModule).
 		WithMsgType(StakingEditValidatorMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -44,11 +44,11 @@ func (e StakingExternal) EditValidator(editValidatorMsg types.EditValidatorMsg)
 
 // Delegate liquid tokens to a validator.
 func (e StakingExternal) Delegate(delegateMsg types.DelegateMsg) provider.XplaClient {
-	msg, err := MakeDelegateMsg(delegateMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeDelegateMsg(delegateMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(StakingModule).
+	e.Xplac = e.Xplac.WithModule(StakingModule).
 		WithMsgType(StakingDelegateMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -56,11 +56,11 @@ func (e StakingExternal) Delegate(delegateMsg types.DelegateMsg) provider.XplaCl
 
 // Unbond shares from a validator.
 func (e StakingExternal) Unbond(unbondMsg types.UnbondMsg) provider.XplaClient {
-	msg, err := MakeUnbondMsg(unbondMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeUnbondMsg(unbondMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(StakingModule).
+	e.Xplac = e.Xplac.WithModule(StakingModule).
 		WithMsgType(StakingUnbondMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -68,11 +68,11 @@ func (e StakingExternal) Unbond(unbondMsg types.UnbondMsg) provider.XplaClient {
 
 // Redelegate illiquid tokens from one validator to another.
 func (e StakingExternal) Redelegate(redelegateMsg types.RedelegateMsg) provider.XplaClient {
-	msg, err := MakeRedelegateMsg(redelegateMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeRedelegateMsg(redelegateMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(StakingModule).
+	e.Xplac = e.Xplac.WithModule(StakingModule).
 		WithMsgType(StakingRedelegateMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -83,11 +83,11 @@ func (e StakingExternal) Redelegate(redelegateMsg types.RedelegateMsg) provider.
 // Query a validator or for all validators.
 func (e StakingExternal) QueryValidators(queryValidatorMsg ...types.QueryValidatorMsg) provider.XplaClient {
 	if len(queryValidatorMsg) == 0 {
-		msg, err := MakeQueryValidatorsMsg()
+		msg, err := MakeQueryValidatorsMsg(e.Xplac.GetPagination())
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(StakingModule).
+		e.Xplac = e.Xplac.WithModule(StakingModule).
 			WithMsgType(StakingQueryValidatorsMsgType).
 			WithMsg(msg)
 	} else if len(queryValidatorMsg) == 1 {
@@ -95,11 +95,11 @@ func (e StakingExternal) QueryValidators(queryValidatorMsg ...types.QueryValidat
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(StakingModule).
+		e.Xplac = e.Xplac.WithModule(StakingModule).
 			WithMsgType(StakingQueryValidatorMsgType).
 			WithMsg(msg)
 	} else {
-		provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
+		e.Xplac = provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
 	}
 	return e.Xplac
 }
@@ -111,27 +111,27 @@ func (e StakingExternal) QueryDelegation(queryDelegationMsg types.QueryDelegatio
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(StakingModule).
+		e.Xplac = e.Xplac.WithModule(StakingModule).
 			WithMsgType(StakingQueryDelegationMsgType).
 			WithMsg(msg)
 	} else if queryDelegationMsg.DelegatorAddr != "" {
-		msg, err := MakeQueryDelegationsMsg(queryDelegationMsg)
+		msg, err := MakeQueryDelegationsMsg(queryDelegationMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(StakingModule).
+		e.Xplac = e.Xplac.WithModule(StakingModule).
 			WithMsgType(StakingQueryDelegationsMsgType).
 			WithMsg(msg)
 	} else if queryDelegationMsg.ValidatorAddr != "" {
-		msg, err := MakeQueryDelegationsToMsg(queryDelegationMsg)
+		msg, err := MakeQueryDelegationsToMsg(queryDelegationMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(StakingModule).
+		e.Xplac = e.Xplac.WithModule(StakingModule).
 			WithMsgType(StakingQueryDelegationsToMsgType).
 			WithMsg(msg)
 	} else {
-		provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "wrong delegation message"))
+		e.Xplac = provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "wrong delegation message"))
 	}
 	return e.Xplac
 }
@@ -143,27 +143,27 @@ func (e StakingExternal) QueryUnbondingDelegation(queryUnbondingDelegationMsg ty
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(StakingModule).
+		e.Xplac = e.Xplac.WithModule(StakingModule).
 			WithMsgType(StakingQueryUnbondingDelegationMsgType).
 			WithMsg(msg)
 	} else if queryUnbondingDelegationMsg.DelegatorAddr != "" {
-		msg, err := MakeQueryUnbondingDelegationsMsg(queryUnbondingDelegationMsg)
+		msg, err := MakeQueryUnbondingDelegationsMsg(queryUnbondingDelegationMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(StakingModule).
+		e.Xplac = e.Xplac.WithModule(StakingModule).
 			WithMsgType(StakingQueryUnbondingDelegationsMsgType).
 			WithMsg(msg)
 	} else if queryUnbondingDelegationMsg.ValidatorAddr != "" {
-		msg, err := MakeQueryUnbondingDelegationsFromMsg(queryUnbondingDelegationMsg)
+		msg, err := MakeQueryUnbondingDelegationsFromMsg(queryUnbondingDelegationMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(StakingModule).
+		e.Xplac = e.Xplac.WithModule(StakingModule).
 			WithMsgType(StakingQueryUnbondingDelegationsFromMsgType).
 			WithMsg(msg)
 	} else {
-		provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "wrong unbonding delegation message"))
+		e.Xplac = provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "wrong unbonding delegation message"))
 	}
 	return e.Xplac
 }
@@ -178,27 +178,27 @@ func (e StakingExternal) QueryRedelegation(queryRedelegationMsg types.QueryRedel
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(StakingModule).
+		e.Xplac = e.Xplac.WithModule(StakingModule).
 			WithMsgType(StakingQueryRedelegationMsgType).
 			WithMsg(msg)
 	} else if queryRedelegationMsg.DelegatorAddr != "" {
-		msg, err := MakeQueryRedelegationsMsg(queryRedelegationMsg)
+		msg, err := MakeQueryRedelegationsMsg(queryRedelegationMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(StakingModule).
+		e.Xplac = e.Xplac.WithModule(StakingModule).
 			WithMsgType(StakingQueryRedelegationsMsgType).
 			WithMsg(msg)
 	} else if queryRedelegationMsg.SrcValidatorAddr != "" {
-		msg, err := MakeQueryRedelegationsFromMsg(queryRedelegationMsg)
+		msg, err := MakeQueryRedelegationsFromMsg(queryRedelegationMsg, e.Xplac.GetPagination())
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(StakingModule).
+		e.Xplac = e.Xplac.WithModule(StakingModule).
 			WithMsgType(StakingQueryRedelegationsFromMsgType).
 			WithMsg(msg)
 	} else {
-		provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "wrong redelegation message"))
+		e.Xplac = provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "wrong redelegation message"))
 	}
 	return e.Xplac
 }
@@ -209,7 +209,7 @@ func (e StakingExternal) HistoricalInfo(historicalInfoMsg types.HistoricalInfoMs
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(StakingModule).
+	e.Xplac = e.Xplac.WithModule(StakingModule).
 		WithMsgType(StakingHistoricalInfoMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -221,7 +221,7 @@ func (e StakingExternal) StakingPool() provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(StakingModule).
+	e.Xplac = e.Xplac.WithModule(StakingModule).
 		WithMsgType(StakingQueryStakingPoolMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -233,7 +233,7 @@ func (e StakingExternal) StakingParams() provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(StakingModule).
+	e.Xplac = e.Xplac.WithModule(StakingModule).
 		WithMsgType(StakingQueryStakingParamsMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -8,7 +8,7 @@ import (
 )
 
 func (s *IntegrationTestSuite) TestStakingTx() {
-	s.xplac.WithPrivateKey(s.accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(s.accounts[0].PrivKey)
 	tmpVal := sdk.ValAddress(s.accounts[0].Address)
 
 	// create validator
@@ -27,9 +27,9 @@ func (s *IntegrationTestSuite) TestStakingTx() {
 		CommissionMaxChangeRate: "",
 		MinSelfDelegation:       "",
 	}
-	s.xplac.CreateValidator(createValidatorMsg)
+	s.xplac = s.xplac.CreateValidator(createValidatorMsg)
 
-	makeCreateValidatorMsg, err := mstaking.MakeCreateValidatorMsg(createValidatorMsg, s.xplac.GetPrivateKey(), s.xplac.GetOutputDocument())
+	makeCreateValidatorMsg, err := mstaking.MakeCreateValidatorMsg(createValidatorMsg, s.xplac.GetSigner(), s.xplac.GetOutputDocument())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeCreateValidatorMsg, s.xplac.GetMsg())
@@ -49,9 +49,9 @@ func (s *IntegrationTestSuite) TestStakingTx() {
 		CommissionRate:    "",
 		MinSelfDelegation: "",
 	}
-	s.xplac.EditValidator(editValidatorMsg)
+	s.xplac = s.xplac.EditValidator(editValidatorMsg)
 
-	makeEditValidatorMsg, err := mstaking.MakeEditValidatorMsg(editValidatorMsg, s.xplac.GetPrivateKey())
+	makeEditValidatorMsg, err := mstaking.MakeEditValidatorMsg(editValidatorMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeEditValidatorMsg, s.xplac.GetMsg())
@@ -70,9 +70,9 @@ func (s *IntegrationTestSuite) TestStakingTx() {
 		Amount:  "1000",
 		ValAddr: sdk.ValAddress(s.accounts[0].Address).String(),
 	}
-	s.xplac.Delegate(delegateMsg)
+	s.xplac = s.xplac.Delegate(delegateMsg)
 
-	makeDelegateMsg, err := mstaking.MakeDelegateMsg(delegateMsg, s.xplac.GetPrivateKey())
+	makeDelegateMsg, err := mstaking.MakeDelegateMsg(delegateMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeDelegateMsg, s.xplac.GetMsg())
@@ -91,9 +91,9 @@ func (s *IntegrationTestSuite) TestStakingTx() {
 		Amount:  "1000",
 		ValAddr: sdk.ValAddress(s.accounts[0].Address).String(),
 	}
-	s.xplac.Unbond(unbondMsg)
+	s.xplac = s.xplac.Unbond(unbondMsg)
 
-	makeUnbondMsg, err := mstaking.MakeUnbondMsg(unbondMsg, s.xplac.GetPrivateKey())
+	makeUnbondMsg, err := mstaking.MakeUnbondMsg(unbondMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeUnbondMsg, s.xplac.GetMsg())
@@ -113,9 +113,9 @@ func (s *IntegrationTestSuite) TestStakingTx() {
 		ValSrcAddr: sdk.ValAddress(s.accounts[0].Address).String(),
 		ValDstAddr: sdk.ValAddress(s.accounts[1].Address).String(),
 	}
-	s.xplac.Redelegate(redelegateMsg)
+	s.xplac = s.xplac.Redelegate(redelegateMsg)
 
-	makeRedelegateMsg, err := mstaking.MakeRedelegateMsg(redelegateMsg, s.xplac.GetPrivateKey())
+	makeRedelegateMsg, err := mstaking.MakeRedelegateMsg(redelegateMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeRedelegateMsg, s.xplac.GetMsg())
@@ -134,9 +134,9 @@ func (s *IntegrationTestSuite) TestStaking() {
 	val := s.network.Validators[0].ValAddress.String()
 	val2 := s.network.Validators[1].ValAddress.String()
 	// query validators
-	s.xplac.QueryValidators()
+	s.xplac = s.xplac.QueryValidators()
 
-	makeQueryValidatorsMsg, err := mstaking.MakeQueryValidatorsMsg()
+	makeQueryValidatorsMsg, err := mstaking.MakeQueryValidatorsMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryValidatorsMsg, s.xplac.GetMsg())
@@ -148,7 +148,7 @@ func (s *IntegrationTestSuite) TestStaking() {
 		ValidatorAddr: val,
 	}
 
-	s.xplac.QueryValidators(queryValidatorMsg)
+	s.xplac = s.xplac.QueryValidators(queryValidatorMsg)
 
 	makeQueryValidatorMsg, err := mstaking.MakeQueryValidatorMsg(queryValidatorMsg)
 	s.Require().NoError(err)
@@ -163,7 +163,7 @@ func (s *IntegrationTestSuite) TestStaking() {
 		ValidatorAddr: val,
 	}
 
-	s.xplac.QueryDelegation(queryDelegationMsg)
+	s.xplac = s.xplac.QueryDelegation(queryDelegationMsg)
 
 	makeQueryDelegationMsg, err := mstaking.MakeQueryDelegationMsg(queryDelegationMsg)
 	s.Require().NoError(err)
@@ -177,9 +177,9 @@ func (s *IntegrationTestSuite) TestStaking() {
 		DelegatorAddr: s.accounts[0].Address.String(),
 	}
 
-	s.xplac.QueryDelegation(queryDelegationMsg)
+	s.xplac = s.xplac.QueryDelegation(queryDelegationMsg)
 
-	makeQueryDelegationsMsg, err := mstaking.MakeQueryDelegationsMsg(queryDelegationMsg)
+	makeQueryDelegationsMsg, err := mstaking.MakeQueryDelegationsMsg(queryDelegationMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryDelegationsMsg, s.xplac.GetMsg())
@@ -191,9 +191,9 @@ func (s *IntegrationTestSuite) TestStaking() {
 		ValidatorAddr: val,
 	}
 
-	s.xplac.QueryDelegation(queryDelegationMsg)
+	s.xplac = s.xplac.QueryDelegation(queryDelegationMsg)
 
-	makeQueryDelegationsToMsg, err := mstaking.MakeQueryDelegationsToMsg(queryDelegationMsg)
+	makeQueryDelegationsToMsg, err := mstaking.MakeQueryDelegationsToMsg(queryDelegationMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryDelegationsToMsg, s.xplac.GetMsg())
@@ -206,7 +206,7 @@ func (s *IntegrationTestSuite) TestStaking() {
 		ValidatorAddr: val,
 	}
 
-	s.xplac.QueryUnbondingDelegation(queryUnbondingDelegationMsg)
+	s.xplac = s.xplac.QueryUnbondingDelegation(queryUnbondingDelegationMsg)
 
 	makeQueryUnbondingDelegationMsg, err := mstaking.MakeQueryUnbondingDelegationMsg(queryUnbondingDelegationMsg)
 	s.Require().NoError(err)
@@ -220,9 +220,9 @@ func (s *IntegrationTestSuite) TestStaking() {
 		DelegatorAddr: s.accounts[0].Address.String(),
 	}
 
-	s.xplac.QueryUnbondingDelegation(queryUnbondingDelegationMsg)
+	s.xplac = s.xplac.QueryUnbondingDelegation(queryUnbondingDelegationMsg)
 
-	makeQueryUnbondingDelegationsMsg, err := mstaking.MakeQueryUnbondingDelegationsMsg(queryUnbondingDelegationMsg)
+	makeQueryUnbondingDelegationsMsg, err := mstaking.MakeQueryUnbondingDelegationsMsg(queryUnbondingDelegationMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryUnbondingDelegationsMsg, s.xplac.GetMsg())
@@ -234,9 +234,9 @@ func (s *IntegrationTestSuite) TestStaking() {
 		ValidatorAddr: val,
 	}
 
-	s.xplac.QueryUnbondingDelegation(queryUnbondingDelegationMsg)
+	s.xplac = s.xplac.QueryUnbondingDelegation(queryUnbondingDelegationMsg)
 
-	makeQueryUnbondingDelegationsFromMsg, err := mstaking.MakeQueryUnbondingDelegationsFromMsg(queryUnbondingDelegationMsg)
+	makeQueryUnbondingDelegationsFromMsg, err := mstaking.MakeQueryUnbondingDelegationsFromMsg(queryUnbondingDelegationMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryUnbondingDelegationsFromMsg, s.xplac.GetMsg())
@@ -250,7 +250,7 @@ func (s *IntegrationTestSuite) TestStaking() {
 		DstValidatorAddr: val2,
 	}
 
-	s.xplac.QueryRedelegation(queryRedelegationMsg)
+	s.xplac = s.xplac.QueryRedelegation(queryRedelegationMsg)
 
 	makeQueryRedelegationMsg, err := mstaking.MakeQueryRedelegationMsg(queryRedelegationMsg)
 	s.Require().NoError(err)
@@ -264,9 +264,9 @@ func (s *IntegrationTestSuite) TestStaking() {
 		DelegatorAddr: s.accounts[0].Address.String(),
 	}
 
-	s.xplac.QueryRedelegation(queryRedelegationMsg)
+	s.xplac = s.xplac.QueryRedelegation(queryRedelegationMsg)
 
-	makeQueryRedelegationsMsg, err := mstaking.MakeQueryRedelegationsMsg(queryRedelegationMsg)
+	makeQueryRedelegationsMsg, err := mstaking.MakeQueryRedelegationsMsg(queryRedelegationMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryRedelegationsMsg, s.xplac.GetMsg())
@@ -278,9 +278,9 @@ func (s *IntegrationTestSuite) TestStaking() {
 		SrcValidatorAddr: val,
 	}
 
-	s.xplac.QueryRedelegation(queryRedelegationMsg)
+	s.xplac = s.xplac.QueryRedelegation(queryRedelegationMsg)
 
-	makeQueryRedelegationsFromMsg, err := mstaking.MakeQueryRedelegationsFromMsg(queryRedelegationMsg)
+	makeQueryRedelegationsFromMsg, err := mstaking.MakeQueryRedelegationsFromMsg(queryRedelegationMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeQueryRedelegationsFromMsg, s.xplac.GetMsg())
@@ -292,7 +292,7 @@ func (s *IntegrationTestSuite) TestStaking() {
 		Height: "1",
 	}
 
-	s.xplac.HistoricalInfo(historicalInfoMsg)
+	s.xplac = s.xplac.HistoricalInfo(historicalInfoMsg)
 
 	makeHistoricalInfoMsg, err := mstaking.MakeHistoricalInfoMsg(historicalInfoMsg)
 	s.Require().NoError(err)
@@ -302,7 +302,7 @@ func (s *IntegrationTestSuite) TestStaking() {
 	s.Require().Equal(mstaking.StakingHistoricalInfoMsgType, s.xplac.GetMsgType())
 
 	// staking pool
-	s.xplac.StakingPool()
+	s.xplac = s.xplac.StakingPool()
 
 	makeQueryStakingPoolMsg, err := mstaking.MakeQueryStakingPoolMsg()
 	s.Require().NoError(err)
@@ -312,7 +312,7 @@ func (s *IntegrationTestSuite) TestStaking() {
 	s.Require().Equal(mstaking.StakingQueryStakingPoolMsgType, s.xplac.GetMsgType())
 
 	// staking params
-	s.xplac.StakingParams()
+	s.xplac = s.xplac.StakingParams()
 
 	makeQueryStakingParamsMsg, err := mstaking.MakeQueryStakingParamsMsg()
 	s.Require().NoError(err)
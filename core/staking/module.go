@@ -6,7 +6,6 @@ import (
 	"github.com/Moonyongjung/xpriv.go/util"
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
@@ -23,8 +22,9 @@ func (c *coreModule) Name() string {
 func (c *coreModule) NewTxRouter(builder cmclient.TxBuilder, msgType string, msg interface{}) (cmclient.TxBuilder, error) {
 	switch {
 	case msgType == StakingCreateValidatorMsgType:
-		convertMsg := msg.(sdk.Msg)
-		builder.SetMsgs(convertMsg)
+		convertMsg := msg.(CreateValidatorParseMsg)
+		builder.SetMsgs(convertMsg.Msg)
+		builder.SetMemo(convertMsg.Memo)
 
 	case msgType == StakingEditValidatorMsgType:
 		convertMsg := msg.(stakingtypes.MsgEditValidator)
@@ -49,6 +49,6 @@ func (c *coreModule) NewTxRouter(builder cmclient.TxBuilder, msgType string, msg
 	return builder, nil
 }
 
-func (c *coreModule) NewQueryRouter(q core.QueryClient) (string, error) {
+func (c *coreModule) NewQueryRouter(q core.QueryClient) (*core.QueryResponse, error) {
 	return QueryStaking(q)
 }
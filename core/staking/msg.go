@@ -1,36 +1,34 @@
 package staking
 
 import (
-	"github.com/Moonyongjung/xpriv.go/core"
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // (Tx) make msg - create validator
-func MakeCreateValidatorMsg(createValidatorMsg types.CreateValidatorMsg, privKey key.PrivateKey, output string) (sdk.Msg, error) {
-	return parseCreateValidatorArgs(createValidatorMsg, privKey, output)
+func MakeCreateValidatorMsg(createValidatorMsg types.CreateValidatorMsg, signer types.Signer, output string) (CreateValidatorParseMsg, error) {
+	return parseCreateValidatorArgs(createValidatorMsg, signer, output)
 }
 
 // (Tx) make msg - edit validator
-func MakeEditValidatorMsg(editValidatorMsg types.EditValidatorMsg, privKey key.PrivateKey) (stakingtypes.MsgEditValidator, error) {
-	return parseEditValidatorArgs(editValidatorMsg, privKey)
+func MakeEditValidatorMsg(editValidatorMsg types.EditValidatorMsg, signer types.Signer) (stakingtypes.MsgEditValidator, error) {
+	return parseEditValidatorArgs(editValidatorMsg, signer)
 }
 
 // (Tx) make msg - delegate
-func MakeDelegateMsg(delegateMsg types.DelegateMsg, privKey key.PrivateKey) (stakingtypes.MsgDelegate, error) {
-	return parseDelegateArgs(delegateMsg, privKey)
+func MakeDelegateMsg(delegateMsg types.DelegateMsg, signer types.Signer) (stakingtypes.MsgDelegate, error) {
+	return parseDelegateArgs(delegateMsg, signer)
 }
 
 // (Tx) make msg - unbond
-func MakeUnbondMsg(unbondMsg types.UnbondMsg, privKey key.PrivateKey) (stakingtypes.MsgUndelegate, error) {
-	return parseUnbondArgs(unbondMsg, privKey)
+func MakeUnbondMsg(unbondMsg types.UnbondMsg, signer types.Signer) (stakingtypes.MsgUndelegate, error) {
+	return parseUnbondArgs(unbondMsg, signer)
 }
 
 // (Tx) make msg - redelegate
-func MakeRedelegateMsg(redelegateMsg types.RedelegateMsg, privKey key.PrivateKey) (stakingtypes.MsgBeginRedelegate, error) {
-	return parseRedelegateArgs(redelegateMsg, privKey)
+func MakeRedelegateMsg(redelegateMsg types.RedelegateMsg, signer types.Signer) (stakingtypes.MsgBeginRedelegate, error) {
+	return parseRedelegateArgs(redelegateMsg, signer)
 }
 
 // (Query) make msg - validator
@@ -41,9 +39,9 @@ func MakeQueryValidatorMsg(queryValidatorMsg types.QueryValidatorMsg) (stakingty
 }
 
 // (Query) make msg - validators
-func MakeQueryValidatorsMsg() (stakingtypes.QueryValidatorsRequest, error) {
+func MakeQueryValidatorsMsg(pageReq *query.PageRequest) (stakingtypes.QueryValidatorsRequest, error) {
 	return stakingtypes.QueryValidatorsRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageReq,
 	}, nil
 }
 
@@ -56,18 +54,18 @@ func MakeQueryDelegationMsg(queryDelegationMsg types.QueryDelegationMsg) (stakin
 }
 
 // (Query) make msg - query delegations
-func MakeQueryDelegationsMsg(queryDelegationMsg types.QueryDelegationMsg) (stakingtypes.QueryDelegatorDelegationsRequest, error) {
+func MakeQueryDelegationsMsg(queryDelegationMsg types.QueryDelegationMsg, pageReq *query.PageRequest) (stakingtypes.QueryDelegatorDelegationsRequest, error) {
 	return stakingtypes.QueryDelegatorDelegationsRequest{
 		DelegatorAddr: queryDelegationMsg.DelegatorAddr,
-		Pagination:    core.PageRequest,
+		Pagination:    pageReq,
 	}, nil
 }
 
 // (Query) make msg - query delegations to
-func MakeQueryDelegationsToMsg(queryDelegationMsg types.QueryDelegationMsg) (stakingtypes.QueryValidatorDelegationsRequest, error) {
+func MakeQueryDelegationsToMsg(queryDelegationMsg types.QueryDelegationMsg, pageReq *query.PageRequest) (stakingtypes.QueryValidatorDelegationsRequest, error) {
 	return stakingtypes.QueryValidatorDelegationsRequest{
 		ValidatorAddr: queryDelegationMsg.ValidatorAddr,
-		Pagination:    core.PageRequest,
+		Pagination:    pageReq,
 	}, nil
 }
 
@@ -80,18 +78,18 @@ func MakeQueryUnbondingDelegationMsg(queryUnbondingDelegationMsg types.QueryUnbo
 }
 
 // (Query) make msg - query unbonding delegations
-func MakeQueryUnbondingDelegationsMsg(queryUnbondingDelegationMsg types.QueryUnbondingDelegationMsg) (stakingtypes.QueryDelegatorUnbondingDelegationsRequest, error) {
+func MakeQueryUnbondingDelegationsMsg(queryUnbondingDelegationMsg types.QueryUnbondingDelegationMsg, pageReq *query.PageRequest) (stakingtypes.QueryDelegatorUnbondingDelegationsRequest, error) {
 	return stakingtypes.QueryDelegatorUnbondingDelegationsRequest{
 		DelegatorAddr: queryUnbondingDelegationMsg.DelegatorAddr,
-		Pagination:    core.PageRequest,
+		Pagination:    pageReq,
 	}, nil
 }
 
 // (Query) make msg - query unbonding delegations from
-func MakeQueryUnbondingDelegationsFromMsg(queryUnbondingDelegationMsg types.QueryUnbondingDelegationMsg) (stakingtypes.QueryValidatorUnbondingDelegationsRequest, error) {
+func MakeQueryUnbondingDelegationsFromMsg(queryUnbondingDelegationMsg types.QueryUnbondingDelegationMsg, pageReq *query.PageRequest) (stakingtypes.QueryValidatorUnbondingDelegationsRequest, error) {
 	return stakingtypes.QueryValidatorUnbondingDelegationsRequest{
 		ValidatorAddr: queryUnbondingDelegationMsg.ValidatorAddr,
-		Pagination:    core.PageRequest,
+		Pagination:    pageReq,
 	}, nil
 }
 
@@ -109,18 +107,18 @@ func MakeQueryRedelegationMsg(queryRedelegationMsg types.QueryRedelegationMsg) (
 }
 
 // (Query) make msg - query redelegations
-func MakeQueryRedelegationsMsg(queryRedelegationMsg types.QueryRedelegationMsg) (stakingtypes.QueryRedelegationsRequest, error) {
+func MakeQueryRedelegationsMsg(queryRedelegationMsg types.QueryRedelegationMsg, pageReq *query.PageRequest) (stakingtypes.QueryRedelegationsRequest, error) {
 	return stakingtypes.QueryRedelegationsRequest{
 		DelegatorAddr: queryRedelegationMsg.DelegatorAddr,
-		Pagination:    core.PageRequest,
+		Pagination:    pageReq,
 	}, nil
 }
 
 // (Query) make msg - query redelegations from
-func MakeQueryRedelegationsFromMsg(queryRedelegationMsg types.QueryRedelegationMsg) (stakingtypes.QueryRedelegationsRequest, error) {
+func MakeQueryRedelegationsFromMsg(queryRedelegationMsg types.QueryRedelegationMsg, pageReq *query.PageRequest) (stakingtypes.QueryRedelegationsRequest, error) {
 	return stakingtypes.QueryRedelegationsRequest{
 		SrcValidatorAddr: queryRedelegationMsg.SrcValidatorAddr,
-		Pagination:       core.PageRequest,
+		Pagination:       pageReq,
 	}, nil
 }
 
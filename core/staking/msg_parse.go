@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"net"
 
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
@@ -24,35 +23,35 @@ import (
 // Parsing - create validator
 func parseCreateValidatorArgs(
 	createValidatorMsg types.CreateValidatorMsg,
-	privKey key.PrivateKey,
+	signer types.Signer,
 	output string,
-) (sdk.Msg, error) {
+) (CreateValidatorParseMsg, error) {
 
 	var nodeId string
 	var valPubKey cryptotypes.PubKey
 	var err error
 
-	privKeyAddr, err := util.GetAddrByPrivKey(privKey)
+	signerAddr, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return nil, util.LogErr(errors.ErrParse, err)
+		return CreateValidatorParseMsg{}, err
 	}
 
-	privKeyValAddr := sdk.ValAddress(privKeyAddr)
+	signerValAddr := sdk.ValAddress(signerAddr)
 
 	addrStr := createValidatorMsg.ValidatorAddress
 	addr, err := sdk.ValAddressFromBech32(addrStr)
 	if err != nil {
-		return nil, util.LogErr(errors.ErrParse, err)
+		return CreateValidatorParseMsg{}, util.LogErr(errors.ErrParse, err)
 	}
 
-	if privKeyValAddr.String() != addr.String() {
-		return nil, util.LogErr(errors.ErrAccountNotMatch, "CreateValidatorMsg.ValidatorAddress and validator address generated by using private key are not same")
+	if signerValAddr.String() != addr.String() {
+		return CreateValidatorParseMsg{}, util.LogErr(errors.ErrAccountNotMatch, "CreateValidatorMsg.ValidatorAddress and validator address of the signer are not same")
 	}
 
 	if createValidatorMsg.NodeKey != "" && createValidatorMsg.PrivValidatorKey != "" {
 		nodeId, valPubKey, err = initializedNodeValidatorString(createValidatorMsg.NodeKey, createValidatorMsg.PrivValidatorKey)
 		if err != nil {
-			return nil, err
+			return CreateValidatorParseMsg{}, err
 		}
 	} else {
 		serverCtx := server.NewDefaultContext()
@@ -61,16 +60,16 @@ func parseCreateValidatorArgs(
 
 		nodeId, valPubKey, err = genutil.InitializeNodeValidatorFiles(serverCtx.Config)
 		if err != nil {
-			return nil, util.LogErr(errors.ErrParse, err)
+			return CreateValidatorParseMsg{}, util.LogErr(errors.ErrParse, err)
 		}
 	}
 
 	ip, err := getIP(createValidatorMsg.ServerIp)
 	if err != nil {
-		return nil, err
+		return CreateValidatorParseMsg{}, err
 	}
 
-	types.Memo = fmt.Sprintf("%s@%s:26656", nodeId, ip)
+	memo := fmt.Sprintf("%s@%s:26656", nodeId, ip)
 
 	website := createValidatorMsg.Website
 	securityContact := createValidatorMsg.SecurityContact
@@ -118,28 +117,31 @@ func parseCreateValidatorArgs(
 
 	amountCoins, err := sdk.ParseCoinNormalized(amount)
 	if err != nil {
-		return nil, util.LogErr(errors.ErrParse, err)
+		return CreateValidatorParseMsg{}, util.LogErr(errors.ErrParse, err)
 	}
 
 	buildCRates, err := buildCommissionRates(commissionRate, commissionMaxRate, commissionMaxChangeRate)
 	if err != nil {
-		return nil, err
+		return CreateValidatorParseMsg{}, err
 	}
 
 	intMinSelfDelegation, ok := sdk.NewIntFromString(minSelfDelegation)
 	if !ok {
-		return nil, util.LogErr(errors.ErrInvalidRequest, "wrong minSelfDelegation")
+		return CreateValidatorParseMsg{}, util.LogErr(errors.ErrInvalidRequest, "wrong minSelfDelegation")
 	}
 
 	msg, err := stakingtypes.NewMsgCreateValidator(addr, valPubKey, amountCoins, description, buildCRates, intMinSelfDelegation)
 	if err != nil {
-		return nil, util.LogErr(errors.ErrParse, err)
+		return CreateValidatorParseMsg{}, util.LogErr(errors.ErrParse, err)
 	}
-	return msg, nil
+	return CreateValidatorParseMsg{
+		Msg:  msg,
+		Memo: memo,
+	}, nil
 }
 
 // Parsing - edit validator
-func parseEditValidatorArgs(editValidatorMsg types.EditValidatorMsg, privKey key.PrivateKey) (stakingtypes.MsgEditValidator, error) {
+func parseEditValidatorArgs(editValidatorMsg types.EditValidatorMsg, signer types.Signer) (stakingtypes.MsgEditValidator, error) {
 	moniker := editValidatorMsg.Moniker
 	identity := editValidatorMsg.Identity
 	website := editValidatorMsg.Website
@@ -169,9 +171,9 @@ func parseEditValidatorArgs(editValidatorMsg types.EditValidatorMsg, privKey key
 		newMinSelfDelegation = &msb
 	}
 
-	addr, err := util.GetAddrByPrivKey(privKey)
+	addr, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return stakingtypes.MsgEditValidator{}, util.LogErr(errors.ErrParse, err)
+		return stakingtypes.MsgEditValidator{}, err
 	}
 
 	msg := stakingtypes.NewMsgEditValidator(sdk.ValAddress(addr), description, newRate, newMinSelfDelegation)
@@ -180,14 +182,14 @@ func parseEditValidatorArgs(editValidatorMsg types.EditValidatorMsg, privKey key
 }
 
 // Parsing - delegate
-func parseDelegateArgs(delegateMsg types.DelegateMsg, privKey key.PrivateKey) (stakingtypes.MsgDelegate, error) {
+func parseDelegateArgs(delegateMsg types.DelegateMsg, signer types.Signer) (stakingtypes.MsgDelegate, error) {
 	amount, err := sdk.ParseCoinNormalized(util.DenomAdd(delegateMsg.Amount))
 	if err != nil {
 		return stakingtypes.MsgDelegate{}, util.LogErr(errors.ErrParse, err)
 	}
-	delAddr, err := util.GetAddrByPrivKey(privKey)
+	delAddr, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return stakingtypes.MsgDelegate{}, util.LogErr(errors.ErrParse, err)
+		return stakingtypes.MsgDelegate{}, err
 	}
 
 	valAddr, err := sdk.ValAddressFromBech32(delegateMsg.ValAddr)
@@ -201,14 +203,14 @@ func parseDelegateArgs(delegateMsg types.DelegateMsg, privKey key.PrivateKey) (s
 }
 
 // Parsing - unbond
-func parseUnbondArgs(unbondMsg types.UnbondMsg, privKey key.PrivateKey) (stakingtypes.MsgUndelegate, error) {
+func parseUnbondArgs(unbondMsg types.UnbondMsg, signer types.Signer) (stakingtypes.MsgUndelegate, error) {
 	amount, err := sdk.ParseCoinNormalized(util.DenomAdd(unbondMsg.Amount))
 	if err != nil {
 		return stakingtypes.MsgUndelegate{}, util.LogErr(errors.ErrParse, err)
 	}
-	delAddr, err := util.GetAddrByPrivKey(privKey)
+	delAddr, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return stakingtypes.MsgUndelegate{}, util.LogErr(errors.ErrParse, err)
+		return stakingtypes.MsgUndelegate{}, err
 	}
 
 	valAddr, err := sdk.ValAddressFromBech32(unbondMsg.ValAddr)
@@ -222,14 +224,14 @@ func parseUnbondArgs(unbondMsg types.UnbondMsg, privKey key.PrivateKey) (staking
 }
 
 // Parsing - redelegate
-func parseRedelegateArgs(redelegateMsg types.RedelegateMsg, privKey key.PrivateKey) (stakingtypes.MsgBeginRedelegate, error) {
+func parseRedelegateArgs(redelegateMsg types.RedelegateMsg, signer types.Signer) (stakingtypes.MsgBeginRedelegate, error) {
 	amount, err := sdk.ParseCoinNormalized(util.DenomAdd(redelegateMsg.Amount))
 	if err != nil {
 		return stakingtypes.MsgBeginRedelegate{}, util.LogErr(errors.ErrParse, err)
 	}
-	delAddr, err := util.GetAddrByPrivKey(privKey)
+	delAddr, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return stakingtypes.MsgBeginRedelegate{}, util.LogErr(errors.ErrParse, err)
+		return stakingtypes.MsgBeginRedelegate{}, err
 	}
 
 	valSrcAddr, err := sdk.ValAddressFromBech32(redelegateMsg.ValSrcAddr)
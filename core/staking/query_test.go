@@ -104,9 +104,9 @@ func (s *IntegrationTestSuite) TestQueryValidators() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		// query a validator
@@ -151,7 +151,7 @@ func (s *IntegrationTestSuite) TestDelegation() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 
 			res, err := s.xplac.QueryDelegation(queryDelegationMsg).Query()
 			s.Require().NoError(err)
@@ -162,7 +162,7 @@ func (s *IntegrationTestSuite) TestDelegation() {
 			s.Require().Equal(val2.ValAddress.String(), queryValidatorResponse.Validator.OperatorAddress)
 
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 
 			res, err := s.xplac.QueryDelegation(queryDelegationMsg).Query()
 			s.Require().NoError(err)
@@ -189,7 +189,7 @@ func (s *IntegrationTestSuite) TestDelegationDelegator() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 
 			res, err := s.xplac.QueryDelegation(queryDelegationMsg).Query()
 			s.Require().NoError(err)
@@ -200,7 +200,7 @@ func (s *IntegrationTestSuite) TestDelegationDelegator() {
 			s.Require().Equal(2, len(queryValidatorsResponse.Validators))
 
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 
 			res, err := s.xplac.QueryDelegation(queryDelegationMsg).Query()
 			s.Require().NoError(err)
@@ -225,9 +225,9 @@ func (s *IntegrationTestSuite) TestDelegationValidator() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.QueryDelegation(queryDelegationMsg).Query()
@@ -251,9 +251,9 @@ func (s *IntegrationTestSuite) TestUnbonding() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.QueryUnbondingDelegation(queryUnbondingDelegationMsg).Query()
@@ -277,9 +277,9 @@ func (s *IntegrationTestSuite) TestUnbondingDelegator() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.QueryUnbondingDelegation(queryUnbondingDelegationMsg).Query()
@@ -303,9 +303,9 @@ func (s *IntegrationTestSuite) TestUnbondingValidator() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.QueryUnbondingDelegation(queryUnbondingDelegationMsg).Query()
@@ -332,14 +332,14 @@ func (s *IntegrationTestSuite) TestRedelegation() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 
 			// LCD unsupported
 			_, err := s.xplac.QueryRedelegation(queryRedelegationMsg).Query()
 			s.Require().Error(err)
 
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 
 			res, err := s.xplac.QueryRedelegation(queryRedelegationMsg).Query()
 			s.Require().NoError(err)
@@ -378,9 +378,9 @@ func (s *IntegrationTestSuite) TestRedelegationDelegator() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.QueryRedelegation(queryRedelegationMsg).Query()
@@ -420,14 +420,14 @@ func (s *IntegrationTestSuite) TestRedelegationSrcValidator() {
 
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 
 			// LCD unsupported
 			_, err := s.xplac.QueryRedelegation(queryRedelegationMsg).Query()
 			s.Require().Error(err)
 
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 
 			res, err := s.xplac.QueryRedelegation(queryRedelegationMsg).Query()
 			s.Require().NoError(err)
@@ -460,9 +460,9 @@ func (s *IntegrationTestSuite) TestRedelegationSrcValidator() {
 func (s *IntegrationTestSuite) TestHistoricalInfo() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		historicalInfoMsg := types.HistoricalInfoMsg{
@@ -482,9 +482,9 @@ func (s *IntegrationTestSuite) TestHistoricalInfo() {
 func (s *IntegrationTestSuite) TestStakingPool() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.StakingPool().Query()
@@ -502,9 +502,9 @@ func (s *IntegrationTestSuite) TestStakingPool() {
 func (s *IntegrationTestSuite) TestParams() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.StakingParams().Query()
@@ -20,11 +20,11 @@ func NewUpgradeExternal(xplac provider.XplaClient) (e UpgradeExternal) {
 
 // Submit a software upgrade proposal.
 func (e UpgradeExternal) SoftwareUpgrade(softwareUpgradeMsg types.SoftwareUpgradeMsg) provider.XplaClient {
-	msg, err := MakeProposalSoftwareUpgradeMsg(softwareUpgradeMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeProposalSoftwareUpgradeMsg(softwareUpgradeMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(UpgradeModule).
+	e.Xplac = e.Xplac.WithModule(UpgradeModule).
 		WithMsgType(UpgradeProposalSoftwareUpgradeMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -32,11 +32,11 @@ func (e UpgradeExternal) SoftwareUpgrade(softwareUpgradeMsg types.SoftwareUpgrad
 
 // Cancel the current software upgrade proposal.
 func (e UpgradeExternal) CancelSoftwareUpgrade(cancelSoftwareUpgradeMsg types.CancelSoftwareUpgradeMsg) provider.XplaClient {
-	msg, err := MakeCancelSoftwareUpgradeMsg(cancelSoftwareUpgradeMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeCancelSoftwareUpgradeMsg(cancelSoftwareUpgradeMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(UpgradeModule).
+	e.Xplac = e.Xplac.WithModule(UpgradeModule).
 		WithMsgType(UpgradeCancelSoftwareUpgradeMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -50,7 +50,7 @@ func (e UpgradeExternal) UpgradeApplied(appliedMsg types.AppliedMsg) provider.Xp
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(UpgradeModule).
+	e.Xplac = e.Xplac.WithModule(UpgradeModule).
 		WithMsgType(UpgradeAppliedMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -63,7 +63,7 @@ func (e UpgradeExternal) ModulesVersion(queryModulesVersionMsg ...types.QueryMod
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(UpgradeModule).
+		e.Xplac = e.Xplac.WithModule(UpgradeModule).
 			WithMsgType(UpgradeQueryAllModuleVersionsMsgType).
 			WithMsg(msg)
 	} else if len(queryModulesVersionMsg) == 1 {
@@ -71,11 +71,11 @@ func (e UpgradeExternal) ModulesVersion(queryModulesVersionMsg ...types.QueryMod
 		if err != nil {
 			return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 		}
-		e.Xplac.WithModule(UpgradeModule).
+		e.Xplac = e.Xplac.WithModule(UpgradeModule).
 			WithMsgType(UpgradeQueryModuleVersionsMsgType).
 			WithMsg(msg)
 	} else {
-		provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
+		e.Xplac = provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(util.LogErr(errors.ErrInvalidRequest, "need only one parameter"))
 	}
 	return e.Xplac
 }
@@ -86,7 +86,7 @@ func (e UpgradeExternal) Plan() provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(UpgradeModule).
+	e.Xplac = e.Xplac.WithModule(UpgradeModule).
 		WithMsgType(UpgradePlanMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -13,7 +13,7 @@ func (s *IntegrationTestSuite) TestUpgradeTx() {
 	r := rand.New(src)
 	accounts := testutil.RandomAccounts(r, 2)
 
-	s.xplac.WithPrivateKey(accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(accounts[0].PrivKey)
 	// software upgrade
 	softwareUpgradeMsg := types.SoftwareUpgradeMsg{
 		UpgradeName:   "Upgrade Name",
@@ -23,9 +23,9 @@ func (s *IntegrationTestSuite) TestUpgradeTx() {
 		UpgradeInfo:   `{"upgrade_info":"INFO"}`,
 		Deposit:       "1000",
 	}
-	s.xplac.SoftwareUpgrade(softwareUpgradeMsg)
+	s.xplac = s.xplac.SoftwareUpgrade(softwareUpgradeMsg)
 
-	makeProposalSoftwareUpgradeMsg, err := mupgrade.MakeProposalSoftwareUpgradeMsg(softwareUpgradeMsg, s.xplac.GetPrivateKey())
+	makeProposalSoftwareUpgradeMsg, err := mupgrade.MakeProposalSoftwareUpgradeMsg(softwareUpgradeMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeProposalSoftwareUpgradeMsg, s.xplac.GetMsg())
@@ -45,9 +45,9 @@ func (s *IntegrationTestSuite) TestUpgradeTx() {
 		Description: "Cancel software upgrade description",
 		Deposit:     "1000",
 	}
-	s.xplac.CancelSoftwareUpgrade(cancelSoftwareUpgradeMsg)
+	s.xplac = s.xplac.CancelSoftwareUpgrade(cancelSoftwareUpgradeMsg)
 
-	makeCancelSoftwareUpgradeMsg, err := mupgrade.MakeCancelSoftwareUpgradeMsg(cancelSoftwareUpgradeMsg, s.xplac.GetPrivateKey())
+	makeCancelSoftwareUpgradeMsg, err := mupgrade.MakeCancelSoftwareUpgradeMsg(cancelSoftwareUpgradeMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeCancelSoftwareUpgradeMsg, s.xplac.GetMsg())
@@ -68,7 +68,7 @@ func (s *IntegrationTestSuite) TestUpgrade() {
 	appliedMsg := types.AppliedMsg{
 		UpgradeName: "upgrade name",
 	}
-	s.xplac.UpgradeApplied(appliedMsg)
+	s.xplac = s.xplac.UpgradeApplied(appliedMsg)
 
 	makeAppliedMsg, err := mupgrade.MakeAppliedMsg(appliedMsg)
 	s.Require().NoError(err)
@@ -78,7 +78,7 @@ func (s *IntegrationTestSuite) TestUpgrade() {
 	s.Require().Equal(mupgrade.UpgradeAppliedMsgType, s.xplac.GetMsgType())
 
 	// modules version
-	s.xplac.ModulesVersion()
+	s.xplac = s.xplac.ModulesVersion()
 
 	makeQueryAllModuleVersionMsg, err := mupgrade.MakeQueryAllModuleVersionMsg()
 	s.Require().NoError(err)
@@ -91,7 +91,7 @@ func (s *IntegrationTestSuite) TestUpgrade() {
 	queryModulesVersionMsg := types.QueryModulesVersionMsg{
 		ModuleName: "staking",
 	}
-	s.xplac.ModulesVersion(queryModulesVersionMsg)
+	s.xplac = s.xplac.ModulesVersion(queryModulesVersionMsg)
 
 	makeQueryModuleVersionMsg, err := mupgrade.MakeQueryModuleVersionMsg(queryModulesVersionMsg)
 	s.Require().NoError(err)
@@ -101,7 +101,7 @@ func (s *IntegrationTestSuite) TestUpgrade() {
 	s.Require().Equal(mupgrade.UpgradeQueryModuleVersionsMsgType, s.xplac.GetMsgType())
 
 	// plan
-	s.xplac.Plan()
+	s.xplac = s.xplac.Plan()
 
 	makePlanMsg, err := mupgrade.MakePlanMsg()
 	s.Require().NoError(err)
@@ -13,7 +13,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 	src := rand.NewSource(1)
 	r := rand.New(src)
 	accounts := testutil.RandomAccounts(r, 2)
-	s.xplac.WithPrivateKey(accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(accounts[0].PrivKey)
 
 	c := upgrade.NewCoreModule()
 
@@ -34,7 +34,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 		Deposit:       "1000",
 	}
 
-	makeProposalSoftwareUpgradeMsg, err := upgrade.MakeProposalSoftwareUpgradeMsg(softwareUpgradeMsg, s.xplac.GetPrivateKey())
+	makeProposalSoftwareUpgradeMsg, err := upgrade.MakeProposalSoftwareUpgradeMsg(softwareUpgradeMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	testMsg = makeProposalSoftwareUpgradeMsg
@@ -49,7 +49,7 @@ func (s *IntegrationTestSuite) TestCoreModule() {
 		Deposit:     "1000",
 	}
 
-	makeCancelSoftwareUpgradeMsg, err := upgrade.MakeCancelSoftwareUpgradeMsg(cancelSoftwareUpgradeMsg, s.xplac.GetPrivateKey())
+	makeCancelSoftwareUpgradeMsg, err := upgrade.MakeCancelSoftwareUpgradeMsg(cancelSoftwareUpgradeMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	testMsg = makeCancelSoftwareUpgradeMsg
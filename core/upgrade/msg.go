@@ -1,7 +1,6 @@
 package upgrade
 
 import (
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -9,13 +8,13 @@ import (
 )
 
 // (Tx) make msg - software upgrade
-func MakeProposalSoftwareUpgradeMsg(softwareUpgradeMsg types.SoftwareUpgradeMsg, privKey key.PrivateKey) (govtypes.MsgSubmitProposal, error) {
-	return parseProposalSoftwareUpgradeArgs(softwareUpgradeMsg, privKey)
+func MakeProposalSoftwareUpgradeMsg(softwareUpgradeMsg types.SoftwareUpgradeMsg, signer types.Signer) (govtypes.MsgSubmitProposal, error) {
+	return parseProposalSoftwareUpgradeArgs(softwareUpgradeMsg, signer)
 }
 
 // (Tx) make msg - cancel software upgrade
-func MakeCancelSoftwareUpgradeMsg(cancelSoftwareUpgradeMsg types.CancelSoftwareUpgradeMsg, privKey key.PrivateKey) (govtypes.MsgSubmitProposal, error) {
-	return parseCancelSoftwareUpgradeArgs(cancelSoftwareUpgradeMsg, privKey)
+func MakeCancelSoftwareUpgradeMsg(cancelSoftwareUpgradeMsg types.CancelSoftwareUpgradeMsg, signer types.Signer) (govtypes.MsgSubmitProposal, error) {
+	return parseCancelSoftwareUpgradeArgs(cancelSoftwareUpgradeMsg, signer)
 }
 
 // (Query) make msg - applied
@@ -1,7 +1,6 @@
 package upgrade
 
 import (
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
@@ -12,7 +11,7 @@ import (
 )
 
 // Parsing - software upgrade
-func parseProposalSoftwareUpgradeArgs(softwareUpgradeMsg types.SoftwareUpgradeMsg, privKey key.PrivateKey) (govtypes.MsgSubmitProposal, error) {
+func parseProposalSoftwareUpgradeArgs(softwareUpgradeMsg types.SoftwareUpgradeMsg, signer types.Signer) (govtypes.MsgSubmitProposal, error) {
 	heightI64, err := util.FromStringToInt64(softwareUpgradeMsg.UpgradeHeight)
 	if err != nil {
 		return govtypes.MsgSubmitProposal{}, util.LogErr(errors.ErrParse, err)
@@ -27,9 +26,9 @@ func parseProposalSoftwareUpgradeArgs(softwareUpgradeMsg types.SoftwareUpgradeMs
 		softwareUpgradeMsg.Description,
 		plan,
 	)
-	from, err := util.GetAddrByPrivKey(privKey)
+	from, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return govtypes.MsgSubmitProposal{}, util.LogErr(errors.ErrParse, err)
+		return govtypes.MsgSubmitProposal{}, err
 	}
 
 	deposit, err := sdk.ParseCoinsNormalized(util.DenomAdd(softwareUpgradeMsg.Deposit))
@@ -46,10 +45,10 @@ func parseProposalSoftwareUpgradeArgs(softwareUpgradeMsg types.SoftwareUpgradeMs
 }
 
 // Parsing - cancel software upgrade
-func parseCancelSoftwareUpgradeArgs(cancelSoftwareUpgradeMsg types.CancelSoftwareUpgradeMsg, privKey key.PrivateKey) (govtypes.MsgSubmitProposal, error) {
-	from, err := util.GetAddrByPrivKey(privKey)
+func parseCancelSoftwareUpgradeArgs(cancelSoftwareUpgradeMsg types.CancelSoftwareUpgradeMsg, signer types.Signer) (govtypes.MsgSubmitProposal, error) {
+	from, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return govtypes.MsgSubmitProposal{}, util.LogErr(errors.ErrParse, err)
+		return govtypes.MsgSubmitProposal{}, err
 	}
 
 	deposit, err := sdk.ParseCoinsNormalized(util.DenomAdd(cancelSoftwareUpgradeMsg.Deposit))
@@ -14,12 +14,8 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for upgrade module.
-func QueryUpgrade(i core.QueryClient) (string, error) {
+func QueryUpgrade(i core.QueryClient) (*core.QueryResponse, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcUpgrade(i)
 	} else {
@@ -27,7 +23,10 @@ func QueryUpgrade(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcUpgrade(i core.QueryClient) (string, error) {
+func queryByGrpcUpgrade(i core.QueryClient) (*core.QueryResponse, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := upgradetypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -39,17 +38,17 @@ func queryByGrpcUpgrade(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 		if appliedPlanRes.Height == 0 {
-			return "", util.LogErr(errors.ErrParse, "applied plan height is 0")
+			return nil, util.LogErr(errors.ErrParse, "applied plan height is 0")
 		}
 		headerData, err := appliedReturnBlockheader(appliedPlanRes, i.Ixplac.GetRpc(), i.Ixplac.GetContext())
 		if err != nil {
-			return "", err
+			return nil, err
 		}
-		return string(headerData), nil
+		return core.RawQueryResponse(i, headerData), nil
 
 	// Upgrade all module versions
 	case i.Ixplac.GetMsgType() == UpgradeQueryAllModuleVersionsMsgType ||
@@ -60,7 +59,7 @@ func queryByGrpcUpgrade(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Upgrade plan
@@ -71,19 +70,14 @@ func queryByGrpcUpgrade(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", err
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	return string(out), nil
+	return core.ProtoQueryResponse(i, res), nil
 }
 
 const (
@@ -92,7 +86,7 @@ const (
 	upgradeCurrentPlanLabel    = "current_plan"
 )
 
-func queryByLcdUpgrade(i core.QueryClient) (string, error) {
+func queryByLcdUpgrade(i core.QueryClient) (*core.QueryResponse, error) {
 	url := util.MakeQueryLcdUrl(upgradev1beta1.Query_ServiceDesc.Metadata.(string))
 
 	switch {
@@ -113,16 +107,16 @@ func queryByLcdUpgrade(i core.QueryClient) (string, error) {
 		url = url + upgradeCurrentPlanLabel
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 
 	}
 
 	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+url, i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return string(out), nil
+	return core.RawQueryResponse(i, out), nil
 }
 
 func appliedReturnBlockheader(res *upgradetypes.QueryAppliedPlanResponse, rpcUrl string, ctx context.Context) ([]byte, error) {
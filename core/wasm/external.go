@@ -19,7 +19,7 @@ func NewWasmExternal(xplac provider.XplaClient) (e WasmExternal) {
 
 // Upload a wasm binary.
 func (e WasmExternal) StoreCode(storeMsg types.StoreMsg) provider.XplaClient {
-	addr, err := util.GetAddrByPrivKey(e.Xplac.GetPrivateKey())
+	addr, err := util.GetAddrBySigner(e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
@@ -27,7 +27,7 @@ func (e WasmExternal) StoreCode(storeMsg types.StoreMsg) provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(WasmModule).
+	e.Xplac = e.Xplac.WithModule(WasmModule).
 		WithMsgType(WasmStoreMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -35,7 +35,7 @@ func (e WasmExternal) StoreCode(storeMsg types.StoreMsg) provider.XplaClient {
 
 // Instantiate a wasm contract.
 func (e WasmExternal) InstantiateContract(instantiageMsg types.InstantiateMsg) provider.XplaClient {
-	addr, err := util.GetAddrByPrivKey(e.Xplac.GetPrivateKey())
+	addr, err := util.GetAddrBySigner(e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
@@ -43,7 +43,7 @@ func (e WasmExternal) InstantiateContract(instantiageMsg types.InstantiateMsg) p
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(WasmModule).
+	e.Xplac = e.Xplac.WithModule(WasmModule).
 		WithMsgType(WasmInstantiateMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -51,7 +51,7 @@ func (e WasmExternal) InstantiateContract(instantiageMsg types.InstantiateMsg) p
 
 // Execute a wasm contract.
 func (e WasmExternal) ExecuteContract(executeMsg types.ExecuteMsg) provider.XplaClient {
-	addr, err := util.GetAddrByPrivKey(e.Xplac.GetPrivateKey())
+	addr, err := util.GetAddrBySigner(e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
@@ -59,7 +59,7 @@ func (e WasmExternal) ExecuteContract(executeMsg types.ExecuteMsg) provider.Xpla
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(WasmModule).
+	e.Xplac = e.Xplac.WithModule(WasmModule).
 		WithMsgType(WasmExecuteMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -67,11 +67,11 @@ func (e WasmExternal) ExecuteContract(executeMsg types.ExecuteMsg) provider.Xpla
 
 // Clears admin for a contract to prevent further migrations.
 func (e WasmExternal) ClearContractAdmin(clearContractAdminMsg types.ClearContractAdminMsg) provider.XplaClient {
-	msg, err := MakeClearContractAdminMsg(clearContractAdminMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeClearContractAdminMsg(clearContractAdminMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(WasmModule).
+	e.Xplac = e.Xplac.WithModule(WasmModule).
 		WithMsgType(WasmClearContractAdminMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -79,11 +79,11 @@ func (e WasmExternal) ClearContractAdmin(clearContractAdminMsg types.ClearContra
 
 // Set new admin for a contract.
 func (e WasmExternal) SetContractAdmin(setContractAdminMsg types.SetContractAdminMsg) provider.XplaClient {
-	msg, err := MakeSetContractAdmintMsg(setContractAdminMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeSetContractAdmintMsg(setContractAdminMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(WasmModule).
+	e.Xplac = e.Xplac.WithModule(WasmModule).
 		WithMsgType(WasmSetContractAdminMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -91,11 +91,11 @@ func (e WasmExternal) SetContractAdmin(setContractAdminMsg types.SetContractAdmi
 
 // Migrate a wasm contract to a new code version.
 func (e WasmExternal) Migrate(migrateMsg types.MigrateMsg) provider.XplaClient {
-	msg, err := MakeMigrateMsg(migrateMsg, e.Xplac.GetPrivateKey())
+	msg, err := MakeMigrateMsg(migrateMsg, e.Xplac.GetSigner())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(WasmModule).
+	e.Xplac = e.Xplac.WithModule(WasmModule).
 		WithMsgType(WasmMigrateMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -109,7 +109,7 @@ func (e WasmExternal) QueryContract(queryMsg types.QueryMsg) provider.XplaClient
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(WasmModule).
+	e.Xplac = e.Xplac.WithModule(WasmModule).
 		WithMsgType(WasmQueryContractMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -117,11 +117,11 @@ func (e WasmExternal) QueryContract(queryMsg types.QueryMsg) provider.XplaClient
 
 // Query list all wasm bytecode on the chain.
 func (e WasmExternal) ListCode() provider.XplaClient {
-	msg, err := MakeListcodeMsg()
+	msg, err := MakeListcodeMsg(e.Xplac.GetPagination())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(WasmModule).
+	e.Xplac = e.Xplac.WithModule(WasmModule).
 		WithMsgType(WasmListCodeMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -129,11 +129,11 @@ func (e WasmExternal) ListCode() provider.XplaClient {
 
 // Query list wasm all bytecode on the chain for given code ID.
 func (e WasmExternal) ListContractByCode(listContractByCodeMsg types.ListContractByCodeMsg) provider.XplaClient {
-	msg, err := MakeListContractByCodeMsg(listContractByCodeMsg)
+	msg, err := MakeListContractByCodeMsg(listContractByCodeMsg, e.Xplac.GetPagination())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(WasmModule).
+	e.Xplac = e.Xplac.WithModule(WasmModule).
 		WithMsgType(WasmListContractByCodeMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -145,7 +145,7 @@ func (e WasmExternal) Download(downloadMsg types.DownloadMsg) provider.XplaClien
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(WasmModule).
+	e.Xplac = e.Xplac.WithModule(WasmModule).
 		WithMsgType(WasmDownloadMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -157,7 +157,7 @@ func (e WasmExternal) CodeInfo(codeInfoMsg types.CodeInfoMsg) provider.XplaClien
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(WasmModule).
+	e.Xplac = e.Xplac.WithModule(WasmModule).
 		WithMsgType(WasmCodeInfoMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -169,7 +169,7 @@ func (e WasmExternal) ContractInfo(contractInfoMsg types.ContractInfoMsg) provid
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(WasmModule).
+	e.Xplac = e.Xplac.WithModule(WasmModule).
 		WithMsgType(WasmContractInfoMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -177,11 +177,11 @@ func (e WasmExternal) ContractInfo(contractInfoMsg types.ContractInfoMsg) provid
 
 // Prints out all internal state of a contract given its address.
 func (e WasmExternal) ContractStateAll(contractStateAllMsg types.ContractStateAllMsg) provider.XplaClient {
-	msg, err := MakeContractStateAllMsg(contractStateAllMsg)
+	msg, err := MakeContractStateAllMsg(contractStateAllMsg, e.Xplac.GetPagination())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(WasmModule).
+	e.Xplac = e.Xplac.WithModule(WasmModule).
 		WithMsgType(WasmContractStateAllMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -189,11 +189,11 @@ func (e WasmExternal) ContractStateAll(contractStateAllMsg types.ContractStateAl
 
 // Prints out the code history for a contract given its address.
 func (e WasmExternal) ContractHistory(contractHistoryMsg types.ContractHistoryMsg) provider.XplaClient {
-	msg, err := MakeContractHistoryMsg(contractHistoryMsg)
+	msg, err := MakeContractHistoryMsg(contractHistoryMsg, e.Xplac.GetPagination())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(WasmModule).
+	e.Xplac = e.Xplac.WithModule(WasmModule).
 		WithMsgType(WasmContractHistoryMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -201,11 +201,11 @@ func (e WasmExternal) ContractHistory(contractHistoryMsg types.ContractHistoryMs
 
 // Query list all pinned code IDs.
 func (e WasmExternal) Pinned() provider.XplaClient {
-	msg, err := MakePinnedMsg()
+	msg, err := MakePinnedMsg(e.Xplac.GetPagination())
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(WasmModule).
+	e.Xplac = e.Xplac.WithModule(WasmModule).
 		WithMsgType(WasmPinnedMsgType).
 		WithMsg(msg)
 	return e.Xplac
@@ -217,7 +217,7 @@ func (e WasmExternal) LibwasmvmVersion() provider.XplaClient {
 	if err != nil {
 		return provider.ResetModuleAndMsgXplac(e.Xplac).WithErr(err)
 	}
-	e.Xplac.WithModule(WasmModule).
+	e.Xplac = e.Xplac.WithModule(WasmModule).
 		WithMsgType(WasmLibwasmvmVersionMsgType).
 		WithMsg(msg)
 	return e.Xplac
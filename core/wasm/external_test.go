@@ -11,13 +11,13 @@ var (
 )
 
 func (s *IntegrationTestSuite) TestWasmTx() {
-	s.xplac.WithPrivateKey(s.accounts[0].PrivKey)
+	s.xplac = s.xplac.WithPrivateKey(s.accounts[0].PrivKey)
 	// store code
 	storeMsg := types.StoreMsg{
 		FilePath:              testWasmFilePath,
 		InstantiatePermission: "instantiate-only-sender",
 	}
-	s.xplac.StoreCode(storeMsg)
+	s.xplac = s.xplac.StoreCode(storeMsg)
 
 	makeStoreCodeMsg, err := mwasm.MakeStoreCodeMsg(storeMsg, s.accounts[0].Address)
 	s.Require().NoError(err)
@@ -37,7 +37,7 @@ func (s *IntegrationTestSuite) TestWasmTx() {
 		InitMsg: `{"owner":"` + s.accounts[0].Address.String() + `"}`,
 		Admin:   s.accounts[0].Address.String(),
 	}
-	s.xplac.InstantiateContract(instantiateMsg)
+	s.xplac = s.xplac.InstantiateContract(instantiateMsg)
 
 	makeInstantiateMsg, err := mwasm.MakeInstantiateMsg(instantiateMsg, s.accounts[0].Address)
 	s.Require().NoError(err)
@@ -59,7 +59,7 @@ func (s *IntegrationTestSuite) TestWasmTx() {
 		Amount:          "0",
 		ExecMsg:         `{"execute_method":{"execute_key":"execute_test","execute_value":"execute_val"}}`,
 	}
-	s.xplac.ExecuteContract(executeMsg)
+	s.xplac = s.xplac.ExecuteContract(executeMsg)
 
 	makeExecuteMsg, err := mwasm.MakeExecuteMsg(executeMsg, s.accounts[0].Address)
 	s.Require().NoError(err)
@@ -79,9 +79,9 @@ func (s *IntegrationTestSuite) TestWasmTx() {
 	clearContractAdminMsg := types.ClearContractAdminMsg{
 		ContractAddress: testCWContractAddress,
 	}
-	s.xplac.ClearContractAdmin(clearContractAdminMsg)
+	s.xplac = s.xplac.ClearContractAdmin(clearContractAdminMsg)
 
-	makeClearContractAdminMsg, err := mwasm.MakeClearContractAdminMsg(clearContractAdminMsg, s.xplac.GetPrivateKey())
+	makeClearContractAdminMsg, err := mwasm.MakeClearContractAdminMsg(clearContractAdminMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeClearContractAdminMsg, s.xplac.GetMsg())
@@ -99,9 +99,9 @@ func (s *IntegrationTestSuite) TestWasmTx() {
 	setContractAdminMsg := types.SetContractAdminMsg{
 		ContractAddress: testCWContractAddress,
 	}
-	s.xplac.SetContractAdmin(setContractAdminMsg)
+	s.xplac = s.xplac.SetContractAdmin(setContractAdminMsg)
 
-	makeSetContractAdmintMsg, err := mwasm.MakeSetContractAdmintMsg(setContractAdminMsg, s.xplac.GetPrivateKey())
+	makeSetContractAdmintMsg, err := mwasm.MakeSetContractAdmintMsg(setContractAdminMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeSetContractAdmintMsg, s.xplac.GetMsg())
@@ -121,9 +121,9 @@ func (s *IntegrationTestSuite) TestWasmTx() {
 		CodeId:          "2",
 		MigrateMsg:      `{}`,
 	}
-	s.xplac.Migrate(migrateMsg)
+	s.xplac = s.xplac.Migrate(migrateMsg)
 
-	makeMigrateMsg, err := mwasm.MakeMigrateMsg(migrateMsg, s.xplac.GetPrivateKey())
+	makeMigrateMsg, err := mwasm.MakeMigrateMsg(migrateMsg, s.xplac.GetSigner())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeMigrateMsg, s.xplac.GetMsg())
@@ -144,7 +144,7 @@ func (s *IntegrationTestSuite) TestWasm() {
 		ContractAddress: testCWContractAddress,
 		QueryMsg:        `{"query_method":{"query":"query_test"}}`,
 	}
-	s.xplac.QueryContract(queryMsg)
+	s.xplac = s.xplac.QueryContract(queryMsg)
 
 	makeQueryMsg, err := mwasm.MakeQueryMsg(queryMsg)
 	s.Require().NoError(err)
@@ -154,9 +154,9 @@ func (s *IntegrationTestSuite) TestWasm() {
 	s.Require().Equal(mwasm.WasmQueryContractMsgType, s.xplac.GetMsgType())
 
 	// list code
-	s.xplac.ListCode()
+	s.xplac = s.xplac.ListCode()
 
-	makeListcodeMsg, err := mwasm.MakeListcodeMsg()
+	makeListcodeMsg, err := mwasm.MakeListcodeMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeListcodeMsg, s.xplac.GetMsg())
@@ -167,9 +167,9 @@ func (s *IntegrationTestSuite) TestWasm() {
 	listContractByCodeMsg := types.ListContractByCodeMsg{
 		CodeId: "1",
 	}
-	s.xplac.ListContractByCode(listContractByCodeMsg)
+	s.xplac = s.xplac.ListContractByCode(listContractByCodeMsg)
 
-	makeListContractByCodeMsg, err := mwasm.MakeListContractByCodeMsg(listContractByCodeMsg)
+	makeListContractByCodeMsg, err := mwasm.MakeListContractByCodeMsg(listContractByCodeMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeListContractByCodeMsg, s.xplac.GetMsg())
@@ -181,7 +181,7 @@ func (s *IntegrationTestSuite) TestWasm() {
 		CodeId:           "1",
 		DownloadFileName: "./example.json",
 	}
-	s.xplac.Download(downloadMsg)
+	s.xplac = s.xplac.Download(downloadMsg)
 
 	makeDownloadMsg, err := mwasm.MakeDownloadMsg(downloadMsg)
 	s.Require().NoError(err)
@@ -194,7 +194,7 @@ func (s *IntegrationTestSuite) TestWasm() {
 	codeInfoMsg := types.CodeInfoMsg{
 		CodeId: "1",
 	}
-	s.xplac.CodeInfo(codeInfoMsg)
+	s.xplac = s.xplac.CodeInfo(codeInfoMsg)
 
 	makeCodeInfoMsg, err := mwasm.MakeCodeInfoMsg(codeInfoMsg)
 	s.Require().NoError(err)
@@ -207,7 +207,7 @@ func (s *IntegrationTestSuite) TestWasm() {
 	contractInfoMsg := types.ContractInfoMsg{
 		ContractAddress: testCWContractAddress,
 	}
-	s.xplac.ContractInfo(contractInfoMsg)
+	s.xplac = s.xplac.ContractInfo(contractInfoMsg)
 
 	makeContractInfoMsg, err := mwasm.MakeContractInfoMsg(contractInfoMsg)
 	s.Require().NoError(err)
@@ -220,9 +220,9 @@ func (s *IntegrationTestSuite) TestWasm() {
 	contractStateAllMsg := types.ContractStateAllMsg{
 		ContractAddress: testCWContractAddress,
 	}
-	s.xplac.ContractStateAll(contractStateAllMsg)
+	s.xplac = s.xplac.ContractStateAll(contractStateAllMsg)
 
-	makeContractStateAllMsg, err := mwasm.MakeContractStateAllMsg(contractStateAllMsg)
+	makeContractStateAllMsg, err := mwasm.MakeContractStateAllMsg(contractStateAllMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeContractStateAllMsg, s.xplac.GetMsg())
@@ -233,9 +233,9 @@ func (s *IntegrationTestSuite) TestWasm() {
 	contractHistoryMsg := types.ContractHistoryMsg{
 		ContractAddress: testCWContractAddress,
 	}
-	s.xplac.ContractHistory(contractHistoryMsg)
+	s.xplac = s.xplac.ContractHistory(contractHistoryMsg)
 
-	makeContractHistoryMsg, err := mwasm.MakeContractHistoryMsg(contractHistoryMsg)
+	makeContractHistoryMsg, err := mwasm.MakeContractHistoryMsg(contractHistoryMsg, s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makeContractHistoryMsg, s.xplac.GetMsg())
@@ -243,9 +243,9 @@ func (s *IntegrationTestSuite) TestWasm() {
 	s.Require().Equal(mwasm.WasmContractHistoryMsgType, s.xplac.GetMsgType())
 
 	// pinned
-	s.xplac.Pinned()
+	s.xplac = s.xplac.Pinned()
 
-	makePinnedMsg, err := mwasm.MakePinnedMsg()
+	makePinnedMsg, err := mwasm.MakePinnedMsg(s.xplac.GetPagination())
 	s.Require().NoError(err)
 
 	s.Require().Equal(makePinnedMsg, s.xplac.GetMsg())
@@ -253,7 +253,7 @@ func (s *IntegrationTestSuite) TestWasm() {
 	s.Require().Equal(mwasm.WasmPinnedMsgType, s.xplac.GetMsgType())
 
 	// libwasmvm version
-	s.xplac.LibwasmvmVersion()
+	s.xplac = s.xplac.LibwasmvmVersion()
 
 	makeLibwasmvmVersionMsg, err := mwasm.MakeLibwasmvmVersionMsg()
 	s.Require().NoError(err)
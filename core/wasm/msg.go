@@ -5,12 +5,11 @@ import (
 	"encoding/hex"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
-	"github.com/Moonyongjung/xpriv.go/core"
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 // (Tx) make msg - store code
@@ -71,18 +70,18 @@ func MakeExecuteMsg(executeMsg types.ExecuteMsg, addr sdk.AccAddress) (wasmtypes
 }
 
 // (Tx) make msg - clear contract admin
-func MakeClearContractAdminMsg(clearContractAdminMsg types.ClearContractAdminMsg, privKey key.PrivateKey) (wasmtypes.MsgClearAdmin, error) {
-	return parseClearContractAdminArgs(clearContractAdminMsg, privKey)
+func MakeClearContractAdminMsg(clearContractAdminMsg types.ClearContractAdminMsg, signer types.Signer) (wasmtypes.MsgClearAdmin, error) {
+	return parseClearContractAdminArgs(clearContractAdminMsg, signer)
 }
 
 // (Tx) make msg - set contract admin
-func MakeSetContractAdmintMsg(setContractAdminMsg types.SetContractAdminMsg, privKey key.PrivateKey) (wasmtypes.MsgUpdateAdmin, error) {
-	return parseSetContractAdmintArgs(setContractAdminMsg, privKey)
+func MakeSetContractAdmintMsg(setContractAdminMsg types.SetContractAdminMsg, signer types.Signer) (wasmtypes.MsgUpdateAdmin, error) {
+	return parseSetContractAdmintArgs(setContractAdminMsg, signer)
 }
 
 // (Tx) make msg - migrate
-func MakeMigrateMsg(migrateMsg types.MigrateMsg, privKey key.PrivateKey) (wasmtypes.MsgMigrateContract, error) {
-	return parseMigrateArgs(migrateMsg, privKey)
+func MakeMigrateMsg(migrateMsg types.MigrateMsg, signer types.Signer) (wasmtypes.MsgMigrateContract, error) {
+	return parseMigrateArgs(migrateMsg, signer)
 }
 
 // (Query) make msg - query contract
@@ -95,14 +94,14 @@ func MakeQueryMsg(queryMsg types.QueryMsg) (wasmtypes.QuerySmartContractStateReq
 }
 
 // (Query) make msg - list code
-func MakeListcodeMsg() (wasmtypes.QueryCodesRequest, error) {
+func MakeListcodeMsg(pageReq *query.PageRequest) (wasmtypes.QueryCodesRequest, error) {
 	return wasmtypes.QueryCodesRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageReq,
 	}, nil
 }
 
 // (Query) make msg - list contract by code
-func MakeListContractByCodeMsg(listContractByCodeMsg types.ListContractByCodeMsg) (wasmtypes.QueryContractsByCodeRequest, error) {
+func MakeListContractByCodeMsg(listContractByCodeMsg types.ListContractByCodeMsg, pageReq *query.PageRequest) (wasmtypes.QueryContractsByCodeRequest, error) {
 	if (types.ListContractByCodeMsg{}) == listContractByCodeMsg {
 		return wasmtypes.QueryContractsByCodeRequest{}, util.LogErr(errors.ErrInsufficientParams, "Empty request or type of parameter is not correct")
 	}
@@ -112,7 +111,7 @@ func MakeListContractByCodeMsg(listContractByCodeMsg types.ListContractByCodeMsg
 	}
 	return wasmtypes.QueryContractsByCodeRequest{
 		CodeId:     codeIdU64,
-		Pagination: core.PageRequest,
+		Pagination: pageReq,
 	}, nil
 }
 
@@ -161,31 +160,31 @@ func MakeContractInfoMsg(contractInfoMsg types.ContractInfoMsg) (wasmtypes.Query
 }
 
 // (Query) make msg - contract state all
-func MakeContractStateAllMsg(contractStateAllMsg types.ContractStateAllMsg) (wasmtypes.QueryAllContractStateRequest, error) {
+func MakeContractStateAllMsg(contractStateAllMsg types.ContractStateAllMsg, pageReq *query.PageRequest) (wasmtypes.QueryAllContractStateRequest, error) {
 	if (types.ContractStateAllMsg{}) == contractStateAllMsg {
 		return wasmtypes.QueryAllContractStateRequest{}, util.LogErr(errors.ErrInsufficientParams, "Empty request or type of parameter is not correct")
 	}
 	return wasmtypes.QueryAllContractStateRequest{
 		Address:    contractStateAllMsg.ContractAddress,
-		Pagination: core.PageRequest,
+		Pagination: pageReq,
 	}, nil
 }
 
 // (Query) make msg - history
-func MakeContractHistoryMsg(contractHistoryMsg types.ContractHistoryMsg) (wasmtypes.QueryContractHistoryRequest, error) {
+func MakeContractHistoryMsg(contractHistoryMsg types.ContractHistoryMsg, pageReq *query.PageRequest) (wasmtypes.QueryContractHistoryRequest, error) {
 	if (types.ContractHistoryMsg{}) == contractHistoryMsg {
 		return wasmtypes.QueryContractHistoryRequest{}, util.LogErr(errors.ErrInsufficientParams, "Empty request or type of parameter is not correct")
 	}
 	return wasmtypes.QueryContractHistoryRequest{
 		Address:    contractHistoryMsg.ContractAddress,
-		Pagination: core.PageRequest,
+		Pagination: pageReq,
 	}, nil
 }
 
 // (Query) make msg - pinned
-func MakePinnedMsg() (wasmtypes.QueryPinnedCodesRequest, error) {
+func MakePinnedMsg(pageReq *query.PageRequest) (wasmtypes.QueryPinnedCodesRequest, error) {
 	return wasmtypes.QueryPinnedCodesRequest{
-		Pagination: core.PageRequest,
+		Pagination: pageReq,
 	}, nil
 }
 
@@ -5,7 +5,6 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	"github.com/Moonyongjung/xpriv.go/util"
@@ -186,10 +185,10 @@ func parseExecuteArgs(executeMsgData types.ExecuteMsg,
 }
 
 // Parsing - clear contract admin
-func parseClearContractAdminArgs(clearContractAdminMsg types.ClearContractAdminMsg, privKey key.PrivateKey) (wasmtypes.MsgClearAdmin, error) {
-	sender, err := util.GetAddrByPrivKey(privKey)
+func parseClearContractAdminArgs(clearContractAdminMsg types.ClearContractAdminMsg, signer types.Signer) (wasmtypes.MsgClearAdmin, error) {
+	sender, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return wasmtypes.MsgClearAdmin{}, util.LogErr(errors.ErrParse, err)
+		return wasmtypes.MsgClearAdmin{}, err
 	}
 
 	return wasmtypes.MsgClearAdmin{
@@ -199,10 +198,10 @@ func parseClearContractAdminArgs(clearContractAdminMsg types.ClearContractAdminM
 }
 
 // Parsing - set contract admin
-func parseSetContractAdmintArgs(setContractAdminMsg types.SetContractAdminMsg, privKey key.PrivateKey) (wasmtypes.MsgUpdateAdmin, error) {
-	sender, err := util.GetAddrByPrivKey(privKey)
+func parseSetContractAdmintArgs(setContractAdminMsg types.SetContractAdminMsg, signer types.Signer) (wasmtypes.MsgUpdateAdmin, error) {
+	sender, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return wasmtypes.MsgUpdateAdmin{}, util.LogErr(errors.ErrParse, err)
+		return wasmtypes.MsgUpdateAdmin{}, err
 	}
 
 	return wasmtypes.MsgUpdateAdmin{
@@ -213,10 +212,10 @@ func parseSetContractAdmintArgs(setContractAdminMsg types.SetContractAdminMsg, p
 }
 
 // Parsing - migrate
-func parseMigrateArgs(migrateMsg types.MigrateMsg, privKey key.PrivateKey) (wasmtypes.MsgMigrateContract, error) {
-	sender, err := util.GetAddrByPrivKey(privKey)
+func parseMigrateArgs(migrateMsg types.MigrateMsg, signer types.Signer) (wasmtypes.MsgMigrateContract, error) {
+	sender, err := util.GetAddrBySigner(signer)
 	if err != nil {
-		return wasmtypes.MsgMigrateContract{}, util.LogErr(errors.ErrParse, err)
+		return wasmtypes.MsgMigrateContract{}, err
 	}
 
 	codeIdU64, err := util.FromStringToUint64(migrateMsg.CodeId)
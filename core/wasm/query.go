@@ -14,12 +14,8 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 )
 
-var out []byte
-var res proto.Message
-var err error
-
 // Query client for wasm module.
-func QueryWasm(i core.QueryClient) (string, error) {
+func QueryWasm(i core.QueryClient) (*core.QueryResponse, error) {
 	if i.QueryType == types.QueryGrpc {
 		return queryByGrpcWasm(i)
 	} else {
@@ -27,7 +23,10 @@ func QueryWasm(i core.QueryClient) (string, error) {
 	}
 }
 
-func queryByGrpcWasm(i core.QueryClient) (string, error) {
+func queryByGrpcWasm(i core.QueryClient) (*core.QueryResponse, error) {
+	var res proto.Message
+	var err error
+
 	queryClient := wasmtypes.NewQueryClient(i.Ixplac.GetGrpcClient())
 
 	switch {
@@ -39,7 +38,7 @@ func queryByGrpcWasm(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Wasm list code
@@ -50,7 +49,7 @@ func queryByGrpcWasm(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Wasm list contract by code
@@ -61,7 +60,7 @@ func queryByGrpcWasm(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Wasm download
@@ -76,7 +75,7 @@ func queryByGrpcWasm(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 		os.WriteFile(downloadFileName, res.Data, 0o600)
 		return "download complete", nil
@@ -89,7 +88,7 @@ func queryByGrpcWasm(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Wasm contract info
@@ -100,7 +99,7 @@ func queryByGrpcWasm(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Wasm contract state all
@@ -111,7 +110,7 @@ func queryByGrpcWasm(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Wasm contract history
@@ -122,7 +121,7 @@ func queryByGrpcWasm(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Wasm pinned
@@ -133,24 +132,19 @@ func queryByGrpcWasm(i core.QueryClient) (string, error) {
 			&convertMsg,
 		)
 		if err != nil {
-			return "", util.LogErr(errors.ErrGrpcRequest, err)
+			return nil, util.LogErr(errors.ErrGrpcRequest, err)
 		}
 
 	// Wasm libwasmvm version
 	case i.Ixplac.GetMsgType() == WasmLibwasmvmVersionMsgType:
 		convertMsg := i.Ixplac.GetMsg().(string)
-		return convertMsg, nil
+		return core.RawQueryResponse(i, []byte(convertMsg)), nil
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
-	}
-
-	out, err = core.PrintProto(i, res)
-	if err != nil {
-		return "", err
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	return string(out), nil
+	return core.ProtoQueryResponse(i, res), nil
 }
 
 const (
@@ -163,7 +157,7 @@ const (
 	wasmPinnedLabel   = "pinned"
 )
 
-func queryByLcdWasm(i core.QueryClient) (string, error) {
+func queryByLcdWasm(i core.QueryClient) (*core.QueryResponse, error) {
 	url := "/cosmwasm/wasm/v1/"
 
 	switch {
@@ -186,7 +180,7 @@ func queryByLcdWasm(i core.QueryClient) (string, error) {
 
 	// Wasm download
 	case i.Ixplac.GetMsgType() == WasmDownloadMsgType:
-		return "", util.LogErr(errors.ErrNotSupport, "unsupported download wasm file by using LCD")
+		return nil, util.LogErr(errors.ErrNotSupport, "unsupported download wasm file by using LCD")
 
 	// Wasm code info
 	case i.Ixplac.GetMsgType() == WasmCodeInfoMsgType:
@@ -220,17 +214,17 @@ func queryByLcdWasm(i core.QueryClient) (string, error) {
 	// Wasm libwasmvm version
 	case i.Ixplac.GetMsgType() == WasmLibwasmvmVersionMsgType:
 		convertMsg := i.Ixplac.GetMsg().(string)
-		return convertMsg, nil
+		return core.RawQueryResponse(i, []byte(convertMsg)), nil
 
 	default:
-		return "", util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
+		return nil, util.LogErr(errors.ErrInvalidMsgType, i.Ixplac.GetMsgType())
 	}
 
-	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+url, i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
+	out, err := util.CtxHttpClient("POST", i.Ixplac.GetLcdURL()+core.LcdPaginationURL(url, i.Ixplac.GetMsg()), i.Ixplac.GetVPByte(), i.Ixplac.GetContext())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return string(out), nil
+	return core.RawQueryResponse(i, out), nil
 
 }
@@ -182,9 +182,9 @@ func (s *IntegrationTestSuite) TearDownSuite() {
 func (s *IntegrationTestSuite) TestQueryContract() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		queryMsg := types.QueryMsg{
@@ -211,9 +211,9 @@ func (s *IntegrationTestSuite) TestQueryContract() {
 func (s *IntegrationTestSuite) TestListCode() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.ListCode().Query()
@@ -235,7 +235,7 @@ func (s *IntegrationTestSuite) TestListContractByCode() {
 	for i, api := range s.apis {
 		// LCD cannot support list contract by code, replace code info
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 
 			listContractByCodeMsg := types.ListContractByCodeMsg{
 				CodeId: s.wasmCodeID,
@@ -250,7 +250,7 @@ func (s *IntegrationTestSuite) TestListContractByCode() {
 			s.Require().Equal(s.accounts[0].Address.String(), queryCodeResponse.Creator)
 
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 
 			listContractByCodeMsg := types.ListContractByCodeMsg{
 				CodeId: s.wasmCodeID,
@@ -272,9 +272,9 @@ func (s *IntegrationTestSuite) TestListContractByCode() {
 func (s *IntegrationTestSuite) TestCodeInfo() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		codeInfoMsg := types.CodeInfoMsg{
@@ -298,9 +298,9 @@ func (s *IntegrationTestSuite) TestCodeInfo() {
 func (s *IntegrationTestSuite) TestContractInfo() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		contractInfoMsg := types.ContractInfoMsg{
@@ -326,9 +326,9 @@ func (s *IntegrationTestSuite) TestContractInfo() {
 func (s *IntegrationTestSuite) TestContractStateAll() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		contractStateAllMsg := types.ContractStateAllMsg{
@@ -353,9 +353,9 @@ func (s *IntegrationTestSuite) TestContractStateAll() {
 func (s *IntegrationTestSuite) TestContractHistory() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		contractHistoryMsg := types.ContractHistoryMsg{
@@ -381,9 +381,9 @@ func (s *IntegrationTestSuite) TestPinned() {
 	// have not proposal of the pinned
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.Pinned().Query()
@@ -400,9 +400,9 @@ func (s *IntegrationTestSuite) TestPinned() {
 func (s *IntegrationTestSuite) TestLibWasmvmVersion() {
 	for i, api := range s.apis {
 		if i == 0 {
-			s.xplac.WithURL(api)
+			s.xplac = s.xplac.WithURL(api)
 		} else {
-			s.xplac.WithGrpc(api)
+			s.xplac = s.xplac.WithGrpc(api)
 		}
 
 		res, err := s.xplac.LibwasmvmVersion().Query()
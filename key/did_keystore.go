@@ -0,0 +1,231 @@
+package key
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	xcrypto "github.com/Moonyongjung/xpla-private-chain/crypto"
+	didcrypto "github.com/Moonyongjung/xpla-private-chain/x/did/client/crypto"
+)
+
+var _ types.DIDKeyStore = &FileDIDKeyStore{}
+var _ types.DIDKeyStore = &MemDIDKeyStore{}
+
+// DID key store which saves encrypted DID keys in the directory.
+// It is same as the key store which is used by the DID key path of DID and private messages,
+// and a key file is named by the verification method ID.
+type FileDIDKeyStore struct {
+	dir string
+	ks  *didcrypto.KeyStore
+}
+
+// Make the DID key store of the directory. The directory is created if it does not exist.
+func NewFileDIDKeyStore(dir string) (*FileDIDKeyStore, error) {
+	if dir == "" {
+		return nil, util.LogErr(errors.ErrInsufficientParams, "indicate directory of DID key store")
+	}
+
+	ks, err := didcrypto.NewKeyStore(dir)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrParse, err)
+	}
+
+	return &FileDIDKeyStore{dir: dir, ks: ks}, nil
+}
+
+func (f *FileDIDKeyStore) Save(verificationMethodID string, privKey []byte, passphrase string) error {
+	if _, err := f.ks.Save(verificationMethodID, privKey, passphrase); err != nil {
+		return util.LogErr(errors.ErrParse, err)
+	}
+	return nil
+}
+
+func (f *FileDIDKeyStore) Load(verificationMethodID string, passphrase string) ([]byte, error) {
+	privKey, err := f.ks.LoadByAddress(verificationMethodID, passphrase)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrInvalidRequest, err)
+	}
+	return privKey, nil
+}
+
+func (f *FileDIDKeyStore) List() ([]string, error) {
+	entries, err := os.ReadDir(f.dir)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrParse, err)
+	}
+
+	var ids []string
+	for _, entry := range entries {
+		if entry.IsDir() || !isVerificationMethodID(entry.Name()) {
+			continue
+		}
+		ids = append(ids, entry.Name())
+	}
+
+	return ids, nil
+}
+
+func (f *FileDIDKeyStore) Delete(verificationMethodID string) error {
+	if !isVerificationMethodID(verificationMethodID) {
+		return util.LogErr(errors.ErrInvalidRequest, "invalid verification method ID:", verificationMethodID)
+	}
+
+	if err := os.Remove(filepath.Join(f.dir, verificationMethodID)); err != nil {
+		if os.IsNotExist(err) {
+			return util.LogErr(errors.ErrNotFound, "no DID key of", verificationMethodID)
+		}
+		return util.LogErr(errors.ErrParse, err)
+	}
+	return nil
+}
+
+// DID key store which keeps DID keys in memory.
+// Keys are not encrypted, but the passphrase is checked when the key is loaded.
+type MemDIDKeyStore struct {
+	mu   sync.RWMutex
+	keys map[string]memDIDKey
+}
+
+type memDIDKey struct {
+	privKey    []byte
+	passphrase [sha256.Size]byte
+}
+
+// Make the empty in-memory DID key store.
+func NewMemDIDKeyStore() *MemDIDKeyStore {
+	return &MemDIDKeyStore{keys: make(map[string]memDIDKey)}
+}
+
+func (m *MemDIDKeyStore) Save(verificationMethodID string, privKey []byte, passphrase string) error {
+	if !isVerificationMethodID(verificationMethodID) {
+		return util.LogErr(errors.ErrInvalidRequest, "invalid verification method ID:", verificationMethodID)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.keys[verificationMethodID] = memDIDKey{
+		privKey:    append([]byte(nil), privKey...),
+		passphrase: sha256.Sum256([]byte(passphrase)),
+	}
+	return nil
+}
+
+func (m *MemDIDKeyStore) Load(verificationMethodID string, passphrase string) ([]byte, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	k, ok := m.keys[verificationMethodID]
+	if !ok {
+		return nil, util.LogErr(errors.ErrNotFound, "no DID key of", verificationMethodID)
+	}
+
+	hash := sha256.Sum256([]byte(passphrase))
+	if subtle.ConstantTimeCompare(hash[:], k.passphrase[:]) != 1 {
+		return nil, util.LogErr(errors.ErrInvalidRequest, "invalid passphrase of", verificationMethodID)
+	}
+
+	return append([]byte(nil), k.privKey...), nil
+}
+
+func (m *MemDIDKeyStore) List() ([]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var ids []string
+	for id := range m.keys {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	return ids, nil
+}
+
+func (m *MemDIDKeyStore) Delete(verificationMethodID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.keys[verificationMethodID]; !ok {
+		return util.LogErr(errors.ErrNotFound, "no DID key of", verificationMethodID)
+	}
+	delete(m.keys, verificationMethodID)
+
+	return nil
+}
+
+// Get the DID key store. If the key store is nil, the file DID key store of the DID key path is returned.
+func DIDKeyStoreOf(keyStore types.DIDKeyStore, didKeyPath string) (types.DIDKeyStore, error) {
+	if keyStore != nil {
+		return keyStore, nil
+	}
+	return NewFileDIDKeyStore(didKeyPath)
+}
+
+// List verification method IDs of DID keys in the key store.
+func ListDIDKeys(keyStore types.DIDKeyStore) ([]string, error) {
+	return keyStore.List()
+}
+
+// Import the DID private key to the key store.
+func ImportDIDKey(keyStore types.DIDKeyStore, verificationMethodID string, privKey secp256k1.PrivKey, passphrase string) error {
+	if len(privKey) != secp256k1.PrivKeySize {
+		return util.LogErr(errors.ErrInvalidRequest, "invalid secp256k1 private key size")
+	}
+	return keyStore.Save(verificationMethodID, privKey[:], passphrase)
+}
+
+// Export the DID private key from the key store.
+func ExportDIDKey(keyStore types.DIDKeyStore, verificationMethodID string, passphrase string) (secp256k1.PrivKey, error) {
+	privKeyBytes, err := keyStore.Load(verificationMethodID, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err := xcrypto.PrivKeyFromBytes(privKeyBytes)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrParse, err)
+	}
+
+	return privKey, nil
+}
+
+// Change the passphrase of the DID key.
+func RotateDIDKeyPassphrase(keyStore types.DIDKeyStore, verificationMethodID string, oldPassphrase, newPassphrase string) error {
+	privKeyBytes, err := keyStore.Load(verificationMethodID, oldPassphrase)
+	if err != nil {
+		return err
+	}
+	return keyStore.Save(verificationMethodID, privKeyBytes, newPassphrase)
+}
+
+// Delete the DID key from the key store. The passphrase is checked before the key is deleted.
+func DeleteDIDKey(keyStore types.DIDKeyStore, verificationMethodID string, passphrase string) error {
+	if _, err := keyStore.Load(verificationMethodID, passphrase); err != nil {
+		return err
+	}
+	return keyStore.Delete(verificationMethodID)
+}
+
+// Load the DID private key of the verification method ID.
+// The DID key path is used for the file DID key store if the key store is nil.
+func LoadDIDPrivKey(keyStore types.DIDKeyStore, didKeyPath, passphrase, verificationMethodID string) (secp256k1.PrivKey, error) {
+	ks, err := DIDKeyStoreOf(keyStore, didKeyPath)
+	if err != nil {
+		return nil, err
+	}
+	return ExportDIDKey(ks, verificationMethodID, passphrase)
+}
+
+func isVerificationMethodID(id string) bool {
+	return strings.HasPrefix(id, "did:") && strings.Contains(id, "#")
+}
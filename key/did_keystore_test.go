@@ -0,0 +1,58 @@
+package key
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+func TestMemDIDKeyStore(t *testing.T) {
+	ks := NewMemDIDKeyStore()
+	privKey := secp256k1.GenPrivKey()
+	verificationMethodID := "did:xpla:EyAhwxY8KYKNqfZKFoWs9GT1jchFNwrs8MMfeyssmqty#key1"
+
+	require.NoError(t, ImportDIDKey(ks, verificationMethodID, privKey, "passphrase"))
+
+	ids, err := ListDIDKeys(ks)
+	require.NoError(t, err)
+	require.Equal(t, []string{verificationMethodID}, ids)
+
+	exported, err := ExportDIDKey(ks, verificationMethodID, "passphrase")
+	require.NoError(t, err)
+	require.Equal(t, privKey, exported)
+
+	_, err = ExportDIDKey(ks, verificationMethodID, "wrong")
+	assert.Error(t, err)
+
+	require.NoError(t, RotateDIDKeyPassphrase(ks, verificationMethodID, "passphrase", "newPassphrase"))
+	_, err = ExportDIDKey(ks, verificationMethodID, "passphrase")
+	assert.Error(t, err)
+
+	loaded, err := LoadDIDPrivKey(ks, "", "newPassphrase", verificationMethodID)
+	require.NoError(t, err)
+	require.Equal(t, privKey, loaded)
+
+	assert.Error(t, DeleteDIDKey(ks, verificationMethodID, "passphrase"))
+	require.NoError(t, DeleteDIDKey(ks, verificationMethodID, "newPassphrase"))
+
+	ids, err = ListDIDKeys(ks)
+	require.NoError(t, err)
+	require.Empty(t, ids)
+}
+
+func TestFileDIDKeyStore(t *testing.T) {
+	ks, err := NewFileDIDKeyStore(t.TempDir())
+	require.NoError(t, err)
+
+	privKey := secp256k1.GenPrivKey()
+	verificationMethodID := "did:xpla:EyAhwxY8KYKNqfZKFoWs9GT1jchFNwrs8MMfeyssmqty#key1"
+
+	require.NoError(t, ImportDIDKey(ks, verificationMethodID, privKey, "passphrase"))
+	require.NoError(t, RotateDIDKeyPassphrase(ks, verificationMethodID, "passphrase", "newPassphrase"))
+
+	exported, err := ExportDIDKey(ks, verificationMethodID, "newPassphrase")
+	require.NoError(t, err)
+	require.Equal(t, privKey, exported)
+}
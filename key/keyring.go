@@ -0,0 +1,62 @@
+package key
+
+import (
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmhd "github.com/evmos/ethermint/crypto/hd"
+)
+
+// Open the keyring of the backend, file, test, os or memory.
+// The default directory of the file and test backends is "$HOME/.xpla".
+// The passphrase is required by the file backend, and the keyring is created with it if it does not exist.
+func OpenKeyring(backendType string, keyringPath string, passphrase ...string) (keyring.Keyring, error) {
+	keyringPath, err := util.KeyringPath(backendType, keyringPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return util.NewKeyring(backendType, keyringPath, passphrase...)
+}
+
+// Add the key which is derived from the mnemonic to the keyring.
+// The key is eth-secp256k1 key as NewPrivKey in order to use the evm module.
+func AddKeyFromMnemonic(kr keyring.Keyring, name string, mnemonic string) (keyring.Info, error) {
+	info, err := kr.NewAccount(name, mnemonic, keyring.DefaultBIP39Passphrase, sdk.GetConfig().GetFullBIP44Path(), evmhd.EthSecp256k1)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrInvalidRequest, err)
+	}
+
+	return info, nil
+}
+
+// List keys in the keyring.
+func ListKeys(kr keyring.Keyring) ([]keyring.Info, error) {
+	infos, err := kr.List()
+	if err != nil {
+		return nil, util.LogErr(errors.ErrKeyNotFound, err)
+	}
+
+	return infos, nil
+}
+
+// Export the key in the keyring as the armored private key which is encrypted by the passphrase.
+func ExportArmoredKey(kr keyring.Keyring, name string, passphrase string) (string, error) {
+	armor, err := kr.ExportPrivKeyArmor(name, passphrase)
+	if err != nil {
+		return "", util.LogErr(errors.ErrKeyNotFound, err)
+	}
+
+	return armor, nil
+}
+
+// Delete the key in the keyring.
+func DeleteKey(kr keyring.Keyring, name string) error {
+	if err := kr.Delete(name); err != nil {
+		return util.LogErr(errors.ErrKeyNotFound, err)
+	}
+
+	return nil
+}
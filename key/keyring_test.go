@@ -0,0 +1,73 @@
+package key
+
+import (
+	"testing"
+
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyring(t *testing.T) {
+	kr, err := OpenKeyring(util.BackendTest, t.TempDir())
+	require.NoError(t, err)
+
+	mnemonic, err := NewMnemonic()
+	require.NoError(t, err)
+
+	info, err := AddKeyFromMnemonic(kr, "key1", mnemonic)
+	require.NoError(t, err)
+
+	// same key as NewPrivKey
+	privKey, err := NewPrivKey(mnemonic)
+	require.NoError(t, err)
+	require.True(t, info.GetPubKey().Equals(privKey.PubKey()))
+
+	infos, err := ListKeys(kr)
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	require.Equal(t, "key1", infos[0].GetName())
+
+	armor, err := ExportArmoredKey(kr, "key1", "passphrase")
+	require.NoError(t, err)
+	require.NotEmpty(t, armor)
+
+	signer, err := NewKeyringSigner(kr, "key1")
+	require.NoError(t, err)
+	requireValidSigner(t, signer)
+
+	require.NoError(t, DeleteKey(kr, "key1"))
+	infos, err = ListKeys(kr)
+	require.NoError(t, err)
+	require.Empty(t, infos)
+
+	require.Error(t, DeleteKey(kr, "key1"))
+}
+
+func TestFileKeyring(t *testing.T) {
+	keyringPath := t.TempDir()
+
+	// the passphrase is required by the file backend
+	_, err := OpenKeyring(util.BackendFile, keyringPath)
+	require.Error(t, err)
+
+	kr, err := OpenKeyring(util.BackendFile, keyringPath, "keyring-passphrase")
+	require.NoError(t, err)
+
+	mnemonic, err := NewMnemonic()
+	require.NoError(t, err)
+	_, err = AddKeyFromMnemonic(kr, "key1", mnemonic)
+	require.NoError(t, err)
+
+	// the key is read by opening the keyring again with the same passphrase
+	kr, err = OpenKeyring(util.BackendFile, keyringPath, "keyring-passphrase")
+	require.NoError(t, err)
+	signer, err := NewKeyringSigner(kr, "key1")
+	require.NoError(t, err)
+	requireValidSigner(t, signer)
+
+	kr, err = OpenKeyring(util.BackendFile, keyringPath, "wrong-passphrase")
+	require.NoError(t, err)
+	_, err = NewKeyringSigner(kr, "key1")
+	require.Error(t, err)
+}
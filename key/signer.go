@@ -0,0 +1,182 @@
+package key
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	DefaultRemoteSignerTimeout = 30 * time.Second
+	RemoteSignerEvmSignMode    = "EVM"
+)
+
+var _ types.Signer = &PrivKeySigner{}
+var _ types.Signer = &KeyringSigner{}
+var _ types.Signer = &RemoteSigner{}
+
+// Signer which holds the private key in memory.
+type PrivKeySigner struct {
+	privKey PrivateKey
+}
+
+// Make new signer of the private key.
+func NewPrivKeySigner(privKey PrivateKey) *PrivKeySigner {
+	return &PrivKeySigner{privKey: privKey}
+}
+
+func (s *PrivKeySigner) PubKey() cryptotypes.PubKey { return s.privKey.PubKey() }
+func (s *PrivKeySigner) Address() sdk.AccAddress    { return sdk.AccAddress(s.privKey.PubKey().Address()) }
+
+func (s *PrivKeySigner) Sign(_ signing.SignMode, signBytes []byte) ([]byte, error) {
+	sig, err := s.privKey.Sign(signBytes)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrParse, err)
+	}
+	return sig, nil
+}
+
+func (s *PrivKeySigner) SignEvmTxHash(hash []byte) ([]byte, error) {
+	ethPrivKey, err := ethcrypto.ToECDSA(s.privKey.Bytes())
+	if err != nil {
+		return nil, util.LogErr(errors.ErrCannotConvert, err)
+	}
+
+	sig, err := ethcrypto.Sign(hash, ethPrivKey)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrParse, err)
+	}
+	return sig, nil
+}
+
+// Signer which signs by the key in the keyring, e.g. the file keyring.
+// The key must be the eth-secp256k1 key in order to sign evm transactions.
+type KeyringSigner struct {
+	keyring keyring.Keyring
+	uid     string
+	pubKey  cryptotypes.PubKey
+}
+
+// Make new signer of the key which name is uid in the keyring.
+func NewKeyringSigner(kr keyring.Keyring, uid string) (*KeyringSigner, error) {
+	info, err := kr.Key(uid)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrKeyNotFound, err)
+	}
+
+	return &KeyringSigner{
+		keyring: kr,
+		uid:     uid,
+		pubKey:  info.GetPubKey(),
+	}, nil
+}
+
+func (s *KeyringSigner) PubKey() cryptotypes.PubKey { return s.pubKey }
+func (s *KeyringSigner) Address() sdk.AccAddress    { return sdk.AccAddress(s.pubKey.Address()) }
+
+func (s *KeyringSigner) Sign(_ signing.SignMode, signBytes []byte) ([]byte, error) {
+	sig, _, err := s.keyring.Sign(s.uid, signBytes)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrParse, err)
+	}
+	return sig, nil
+}
+
+// The eth-secp256k1 key signs the 32 bytes digest without hashing it again,
+// so the hash of the evm transaction is signed as it is.
+func (s *KeyringSigner) SignEvmTxHash(hash []byte) ([]byte, error) {
+	if len(hash) != ethcrypto.DigestLength {
+		return nil, util.LogErr(errors.ErrInvalidRequest, "invalid evm tx hash length:", len(hash))
+	}
+	return s.Sign(signing.SignMode_SIGN_MODE_UNSPECIFIED, hash)
+}
+
+// Signer which requests signatures to the signing daemon by HTTP.
+// The daemon receives the JSON request
+//
+//	{"address": "xpla1...", "sign_mode": "SIGN_MODE_DIRECT", "sign_bytes": "<base64>"}
+//
+// and returns the JSON response {"signature": "<base64>"}.
+// The sign mode of the evm transaction is "EVM" and the sign bytes of it is the hash of the transaction.
+type RemoteSigner struct {
+	url        string
+	pubKey     cryptotypes.PubKey
+	HttpClient *http.Client
+}
+
+type remoteSignRequest struct {
+	Address   string `json:"address"`
+	SignMode  string `json:"sign_mode"`
+	SignBytes []byte `json:"sign_bytes"`
+}
+
+type remoteSignResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// Make new signer of the signing daemon which has the key of the public key.
+func NewRemoteSigner(url string, pubKey cryptotypes.PubKey) *RemoteSigner {
+	return &RemoteSigner{
+		url:        url,
+		pubKey:     pubKey,
+		HttpClient: &http.Client{Timeout: DefaultRemoteSignerTimeout},
+	}
+}
+
+func (s *RemoteSigner) PubKey() cryptotypes.PubKey { return s.pubKey }
+func (s *RemoteSigner) Address() sdk.AccAddress    { return sdk.AccAddress(s.pubKey.Address()) }
+
+func (s *RemoteSigner) Sign(signMode signing.SignMode, signBytes []byte) ([]byte, error) {
+	return s.request(signMode.String(), signBytes)
+}
+
+func (s *RemoteSigner) SignEvmTxHash(hash []byte) ([]byte, error) {
+	return s.request(RemoteSignerEvmSignMode, hash)
+}
+
+func (s *RemoteSigner) request(signMode string, signBytes []byte) ([]byte, error) {
+	reqBody, err := json.Marshal(remoteSignRequest{
+		Address:   s.Address().String(),
+		SignMode:  signMode,
+		SignBytes: signBytes,
+	})
+	if err != nil {
+		return nil, util.LogErr(errors.ErrFailedToMarshal, err)
+	}
+
+	resp, err := s.HttpClient.Post(s.url, "application/json", bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, util.LogErr(errors.ErrHttpRequest, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, util.LogErr(errors.ErrHttpRequest, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, util.LogErr(errors.ErrHttpRequest, "signing daemon returned", resp.Status, string(respBody))
+	}
+
+	var signResponse remoteSignResponse
+	if err := json.Unmarshal(respBody, &signResponse); err != nil {
+		return nil, util.LogErr(errors.ErrFailedToUnmarshal, err)
+	}
+	if len(signResponse.Signature) == 0 {
+		return nil, util.LogErr(errors.ErrHttpRequest, "empty signature from signing daemon")
+	}
+
+	return signResponse.Signature, nil
+}
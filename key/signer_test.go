@@ -0,0 +1,100 @@
+package key
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPrivKey(t *testing.T) PrivateKey {
+	mnemonic, err := NewMnemonic()
+	require.NoError(t, err)
+
+	privKey, err := NewPrivKey(mnemonic)
+	require.NoError(t, err)
+
+	return privKey
+}
+
+func requireValidSigner(t *testing.T, signer types.Signer) {
+	signBytes := []byte("sign bytes")
+	sig, err := signer.Sign(signing.SignMode_SIGN_MODE_DIRECT, signBytes)
+	require.NoError(t, err)
+	require.True(t, signer.PubKey().VerifySignature(signBytes, sig))
+
+	hash := ethcrypto.Keccak256([]byte("evm tx"))
+	evmSig, err := signer.SignEvmTxHash(hash)
+	require.NoError(t, err)
+	require.Len(t, evmSig, 65)
+
+	recovered, err := ethcrypto.SigToPub(hash, evmSig)
+	require.NoError(t, err)
+	require.Equal(t, signer.Address().Bytes(), ethcrypto.PubkeyToAddress(*recovered).Bytes())
+}
+
+func TestPrivKeySigner(t *testing.T) {
+	privKey := newTestPrivKey(t)
+	signer := NewPrivKeySigner(privKey)
+
+	addr, err := Bech32AddrString(privKey)
+	require.NoError(t, err)
+	require.Equal(t, addr, signer.Address().String())
+	requireValidSigner(t, signer)
+}
+
+func TestKeyringSigner(t *testing.T) {
+	kr, err := util.NewKeyring(util.BackendMemory, "")
+	require.NoError(t, err)
+
+	privKey := newTestPrivKey(t)
+	err = kr.ImportPrivKey("signer", EncryptArmorPrivKey(privKey, DefaultEncryptPassphrase), DefaultEncryptPassphrase)
+	require.NoError(t, err)
+
+	signer, err := NewKeyringSigner(kr, "signer")
+	require.NoError(t, err)
+	require.True(t, signer.PubKey().Equals(privKey.PubKey()))
+	requireValidSigner(t, signer)
+
+	_, err = NewKeyringSigner(kr, "unknown")
+	require.Error(t, err)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	privKey := newTestPrivKey(t)
+	daemon := NewPrivKeySigner(privKey)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req remoteSignRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, daemon.Address().String(), req.Address)
+
+		var sig []byte
+		var err error
+		if req.SignMode == RemoteSignerEvmSignMode {
+			sig, err = daemon.SignEvmTxHash(req.SignBytes)
+		} else {
+			sig, err = daemon.Sign(signing.SignMode(signing.SignMode_value[req.SignMode]), req.SignBytes)
+		}
+		require.NoError(t, err)
+
+		json.NewEncoder(w).Encode(remoteSignResponse{Signature: sig})
+	}))
+	defer server.Close()
+
+	signer := NewRemoteSigner(server.URL, privKey.PubKey())
+	requireValidSigner(t, signer)
+
+	// unavailable daemon
+	signer = NewRemoteSigner(server.URL+"/unknown", privKey.PubKey())
+	server.Close()
+	_, err := signer.Sign(signing.SignMode_SIGN_MODE_DIRECT, []byte("sign bytes"))
+	require.Error(t, err)
+}
@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"time"
 
 	"github.com/Moonyongjung/xpla-private-chain/app/params"
 	"github.com/Moonyongjung/xpriv.go/key"
 	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/util"
 
 	cmclient "github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -46,6 +48,13 @@ import (
 // e.g. - query
 //
 //	res, err := xplac.BankBalances(bankBalancesMsg).Query()
+//
+// The XplaClient is immutable. Methods which return the XplaClient do not change the receiver,
+// so the returned client must be used.
+//
+// e.g. - set options
+//
+//	xplac = xplac.WithURL(lcdUrl).WithPrivateKey(privKey)
 type XplaClient interface {
 	WithProvider
 	GetProvider
@@ -53,6 +62,7 @@ type XplaClient interface {
 	QueryProvider
 	BroadcastProvider
 	InfoRequestProvider
+	SubscribeProvider
 	TxMsgProvider
 	QueryMsgProvider
 	HelperProvider
@@ -61,6 +71,7 @@ type XplaClient interface {
 // Optional parameters of client.xplaClient.
 type Options struct {
 	PrivateKey     key.PrivateKey
+	Signer         types.Signer
 	AccountNumber  string
 	Sequence       string
 	BroadcastMode  string
@@ -71,6 +82,8 @@ type Options struct {
 	SignMode       signing.SignMode
 	FeeGranter     sdk.AccAddress
 	TimeoutHeight  string
+	Memo           string
+	NonceManager   *util.NonceManager
 	LcdURL         string
 	GrpcURL        string
 	RpcURL         string
@@ -80,6 +93,15 @@ type Options struct {
 	UseVP          bool
 	VPPath         string
 	VPString       string
+	VPProvider     types.VPProvider
+}
+
+// Message of a module which is accumulated in the xpla client for the multi-message transaction.
+// Each message is routed by the transaction router of its module.
+type BatchMsg struct {
+	Module  string
+	MsgType string
+	Msg     interface{}
 }
 
 // Methods set params of client.xplaClient.
@@ -90,6 +112,8 @@ type WithProvider interface {
 	WithEncoding(params.EncodingConfig) XplaClient
 	WithContext(context.Context) XplaClient
 	WithPrivateKey(key.PrivateKey) XplaClient
+	WithSigner(types.Signer) XplaClient
+	WithKeyring(string, string, string, ...string) XplaClient
 	WithAccountNumber(string) XplaClient
 	WithBroadcastMode(string) XplaClient
 	WithSequence(string) XplaClient
@@ -100,6 +124,8 @@ type WithProvider interface {
 	WithSignMode(signing.SignMode) XplaClient
 	WithFeeGranter(sdk.AccAddress) XplaClient
 	WithTimeoutHeight(string) XplaClient
+	WithMemo(string) XplaClient
+	WithNonceManager(*util.NonceManager) XplaClient
 	WithURL(string) XplaClient
 	WithGrpc(string) XplaClient
 	WithRpc(string) XplaClient
@@ -110,15 +136,20 @@ type WithProvider interface {
 	WithMsgType(string) XplaClient
 	WithMsg(interface{}) XplaClient
 	WithErr(error) XplaClient
+	WithBatchMsgs([]BatchMsg) XplaClient
+	AppendMsg() XplaClient
+	Batch(...XplaClient) XplaClient
 	WithUseVP(bool) XplaClient
 	WithVPByPath(string) XplaClient
 	WithVPByString(string) XplaClient
+	WithVPProvider(types.VPProvider) XplaClient
 }
 
 // Methods get params of client.xplaClient.
 type GetProvider interface {
 	GetChainId() string
 	GetPrivateKey() key.PrivateKey
+	GetSigner() types.Signer
 	GetEncoding() params.EncodingConfig
 	GetContext() context.Context
 	GetLcdURL() string
@@ -136,14 +167,18 @@ type GetProvider interface {
 	GetSignMode() signing.SignMode
 	GetFeeGranter() sdk.AccAddress
 	GetTimeoutHeight() string
+	GetMemo() string
+	GetNonceManager() *util.NonceManager
 	GetPagination() *query.PageRequest
 	GetOutputDocument() string
 	GetModule() string
 	GetMsg() interface{}
 	GetMsgType() string
+	GetBatchMsgs() []BatchMsg
 	GetErr() error
 	GetUseVP() bool
 	GetVPByte() []byte
+	GetVPProvider() types.VPProvider
 }
 
 // Methods handle transaction.
@@ -157,9 +192,10 @@ type TxProvider interface {
 	ValidateSignatures(types.ValidateSignaturesMsg) (string, error)
 }
 
-// Method handles query functions.
+// Methods handle query functions.
 type QueryProvider interface {
 	Query() (string, error)
+	QueryTyped(interface{}) error
 }
 
 // Methods handle functions of broadcasting.
@@ -167,6 +203,7 @@ type BroadcastProvider interface {
 	Broadcast([]byte) (*types.TxRes, error)
 	BroadcastBlock([]byte) (*types.TxRes, error)
 	BroadcastAsync([]byte) (*types.TxRes, error)
+	BroadcastAndWait([]byte, time.Duration) (*types.TxRes, error)
 }
 
 // Methods get information from XPLA chain.
@@ -175,6 +212,13 @@ type InfoRequestProvider interface {
 	Simulate(cmclient.TxBuilder) (*sdktx.SimulateResponse, error)
 }
 
+// Methods subscribe events of XPLA chain.
+type SubscribeProvider interface {
+	SubscribeNewBlock(context.Context, ...types.SubscribeOptions) (<-chan types.BlockEvent, <-chan error, error)
+	SubscribeTx(context.Context, string, ...types.SubscribeOptions) (<-chan types.TxEvent, <-chan error, error)
+	SubscribeEvmLogs(context.Context, types.EthNewFilterMsg, string, ...types.SubscribeOptions) (<-chan types.EvmEventLog, <-chan error, error)
+}
+
 // Methods are external functions of each module for sending transaction.
 type TxMsgProvider interface {
 	// anchor
@@ -4,6 +4,7 @@ package provider
 // Remove recorded all parameters.
 func ResetXplac(xplac XplaClient) XplaClient {
 	return ResetModuleAndMsgXplac(xplac).
+		WithBatchMsgs(nil).
 		WithOptions(Options{}).
 		WithErr(nil)
 }
@@ -33,6 +33,8 @@ type TxMultiSignMsg struct {
 	Amino          bool
 	KeyringPath    string
 	KeyringBackend string
+	// Passphrase of the keyring, which is required by the file backend.
+	KeyringPassphrase string
 }
 
 type QueryAccAddressMsg struct {
@@ -1,10 +1,46 @@
 package types
 
+// Verification relationships of the DID document.
+const (
+	DIDRelationshipAuthentication  = "authentication"
+	DIDRelationshipAssertionMethod = "assertionMethod"
+	DIDRelationshipKeyAgreement    = "keyAgreement"
+)
+
 type CreateDIDMsg struct {
 	DIDMnemonic    string
 	DIDPassphrase  string
 	SaveDIDKeyPath string
 	Moniker        string
+	// If it is set, the DID key is saved to it instead of the SaveDIDKeyPath.
+	DIDKeyStore DIDKeyStore
+	// If it is set, the DID is derived from the existing secp256k1 private key instead of the DID mnemonic.
+	DIDPrivKey []byte
+	// Verification relationships of the DID key "key1" in addition to the authentication.
+	// The authentication is added once even though it is included.
+	Relationships []string
+	// Additional verification methods and services of the DID document.
+	VerificationMethods []DIDVerificationMethod
+	Services            []DIDService
+}
+
+type DIDVerificationMethod struct {
+	KeyID string
+	// Key type of the verification method. Default value is "EcdsaSecp256k1VerificationKey2019".
+	Type string
+	// If the private key is set, the public key is derived from it and it is saved to the DID key store
+	// with the DID passphrase. If not, the public key is used.
+	PrivKey []byte
+	PubKey  []byte
+	// Verification relationships, e.g. "authentication", "assertionMethod" and "keyAgreement".
+	Relationships []string
+}
+
+type DIDService struct {
+	// Fragment of the service ID, e.g. "service1" is "did:xpla:...#service1".
+	ID              string
+	Type            string
+	ServiceEndpoint string
 }
 
 type UpdateDIDMsg struct {
@@ -13,6 +49,7 @@ type UpdateDIDMsg struct {
 	DIDDocumentPath string
 	DIDPassphrase   string
 	DIDKeyPath      string
+	DIDKeyStore     DIDKeyStore
 }
 
 type DeactivateDIDMsg struct {
@@ -20,6 +57,7 @@ type DeactivateDIDMsg struct {
 	KeyID         string
 	DIDPassphrase string
 	DIDKeyPath    string
+	DIDKeyStore   DIDKeyStore
 }
 
 type ReplaceDIDMonikerMsg struct {
@@ -27,6 +65,7 @@ type ReplaceDIDMonikerMsg struct {
 	KeyId         string
 	DIDPassphrase string
 	DIDKeyPath    string
+	DIDKeyStore   DIDKeyStore
 	NewMoniker    string
 }
 
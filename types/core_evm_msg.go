@@ -3,13 +3,40 @@ package types
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
+// Types of the evm transaction.
+const (
+	EvmLegacyTxType     = "legacy"
+	EvmAccessListTxType = "access-list"
+	EvmDynamicFeeTxType = "dynamic-fee"
+)
+
+// Options of the evm transaction. The legacy transaction is made when the tx type is empty.
+type EvmTxOptions struct {
+	// legacy, access-list (EIP-2930, type 1) or dynamic-fee (EIP-1559, type 2)
+	TxType string
+	// Fee caps of the dynamic fee transaction.
+	// If they are empty, the priority fee is the suggested gas tip cap and the max fee is
+	// the priority fee with twice the base fee of the latest block.
+	MaxFeePerGas         string
+	MaxPriorityFeePerGas string
+	// Addresses and storage keys which are accessed by the access list or dynamic fee transaction.
+	AccessList []EvmAccessTuple
+}
+
+type EvmAccessTuple struct {
+	Address     string
+	StorageKeys []string
+}
+
 type SendCoinMsg struct {
 	Amount      string
 	FromAddress string
 	ToAddress   string
+	TxOptions   EvmTxOptions
 }
 
 type DeploySolContractMsg struct {
@@ -18,6 +45,7 @@ type DeploySolContractMsg struct {
 	ABIJsonFilePath      string
 	BytecodeJsonFilePath string
 	Args                 []interface{}
+	TxOptions            EvmTxOptions
 }
 
 type InvokeSolContractMsg struct {
@@ -29,6 +57,7 @@ type InvokeSolContractMsg struct {
 	ABIJsonFilePath      string
 	BytecodeJsonFilePath string
 	FromByteAddress      string
+	TxOptions            EvmTxOptions
 }
 
 type CallSolContractMsg struct {
@@ -103,6 +132,8 @@ type EthGetFilterLogsMsg struct {
 // Responses
 type CallSolContractResponse struct {
 	ContractResponse []string `json:"contract_response"`
+	// The return data of the contract which is not unpacked
+	Data hexutil.Bytes `json:"data"`
 }
 
 type BlockResponse struct {
@@ -197,15 +228,22 @@ type EthUninstallFilterResponse struct {
 }
 
 type EthGetFilterChangesResponse struct {
+	// Block hashes or transaction hashes of the block filter and the pending transaction filter.
 	GetFilterChanges []string `json:"eth_getFilterChanges"`
+	// Logs of the log filter.
+	Logs []ethtypes.Log `json:"logs,omitempty"`
 }
 
 type EthGetFilterLogsResponse struct {
 	GetFilterLogs []string `json:"eth_getFilterLogs"`
+	// Logs of the filter, which are not unmarshaled into GetFilterLogs.
+	Logs []ethtypes.Log `json:"logs,omitempty"`
 }
 
 type EthGetLogsResponse struct {
 	GetLogs interface{} `json:"eth_getLogs"`
+	// Logs of GetLogs which are decoded into the log type.
+	Logs []ethtypes.Log `json:"logs,omitempty"`
 }
 
 type EthCoinbaseResponse struct {
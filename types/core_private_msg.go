@@ -4,6 +4,7 @@ type InitialAdminMsg struct {
 	InitAdminDIDKey string
 	DIDPassphrase   string
 	DIDKeyPath      string
+	DIDKeyStore     DIDKeyStore
 }
 
 type AddAdminMsg struct {
@@ -12,12 +13,14 @@ type AddAdminMsg struct {
 	InitAdminDIDKey        string
 	InitAdminDIDPassphrase string
 	InitAdminDIDKeyPath    string
+	InitAdminDIDKeyStore   DIDKeyStore
 }
 
 type ParticipateMsg struct {
 	ParticipantDIDKey string
 	DIDPassphrase     string
 	DIDKeyPath        string
+	DIDKeyStore       DIDKeyStore
 }
 
 type AcceptMsg struct {
@@ -25,6 +28,7 @@ type AcceptMsg struct {
 	AdminDIDKey        string
 	AdminDIDPassphrase string
 	AdminDIDKeyPath    string
+	AdminDIDKeyStore   DIDKeyStore
 }
 
 type DenyMsg struct {
@@ -40,6 +44,7 @@ type QuitMsg struct {
 	ParticipantDIDKey string
 	DIDPassphrase     string
 	DIDKeyPath        string
+	DIDKeyStore       DIDKeyStore
 }
 
 type ParticipateStateMsg struct {
@@ -54,6 +59,7 @@ type GenDIDSignMsg struct {
 	DIDKey        string
 	DIDPassphrase string
 	DIDKeyPath    string
+	DIDKeyStore   DIDKeyStore
 }
 
 type IssueVCMsg struct {
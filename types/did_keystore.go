@@ -0,0 +1,14 @@
+package types
+
+// Key store of DID private keys which are indexed by the verification method ID, e.g. "did:xpla:...#key1".
+// The DID signers of the did and private modules use it instead of the DID key path if it is set.
+type DIDKeyStore interface {
+	// Save the DID private key which is encrypted by the passphrase. The existing key is overwritten.
+	Save(verificationMethodID string, privKey []byte, passphrase string) error
+	// Load the DID private key which is decrypted by the passphrase.
+	Load(verificationMethodID string, passphrase string) ([]byte, error)
+	// List verification method IDs of saved DID keys.
+	List() ([]string, error)
+	// Delete the DID key.
+	Delete(verificationMethodID string) error
+}
@@ -26,6 +26,7 @@ var (
 	ErrParse               = new(17, "parse error")
 	ErrSdkClient           = new(18, "cosmos sdk client set error")
 	ErrAlreadyExist        = new(19, "already exist")
+	ErrTimeout             = new(20, "timeout")
 )
 
 func new(errCode uint64, desc string) XGoError {
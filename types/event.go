@@ -0,0 +1,54 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// Options of the event subscription.
+type SubscribeOptions struct {
+	// Size of the buffer of the event channel. Default value is 100.
+	BufferSize int
+	// If it is true, a new event is dropped when the buffer of the event channel is full.
+	// If not, receiving events is paused until the buffer has space. In this case,
+	// tendermint may cancel the subscription of the slow client, and the subscription is reconnected.
+	DropWhenFull bool
+	// Interval of polling the filter of the evm log subscription. Default value is 1 second.
+	PollInterval time.Duration
+}
+
+// New block event which is received by the subscription.
+// Txs are in the order of transactions of the block, and the transaction which cannot be decoded is nil.
+type BlockEvent struct {
+	Block            *tmtypes.Block
+	Txs              []sdk.Tx
+	ResultBeginBlock abci.ResponseBeginBlock
+	ResultEndBlock   abci.ResponseEndBlock
+}
+
+// Transaction event which is received by the subscription.
+// Events of the transaction are decoded to the typed events if they are emitted as the typed event.
+type TxEvent struct {
+	Height      int64
+	Index       uint32
+	TxHash      string
+	Tx          sdk.Tx
+	Result      abci.ResponseDeliverTx
+	Events      sdk.StringEvents
+	TypedEvents []proto.Message
+}
+
+// Evm event which is decoded from the log by the ABI of the contract.
+// Fields are values of event inputs by the input name, and the name of the unnamed input is "arg<index>".
+// Indexed inputs of dynamic types, e.g. string and bytes, are the hash of the value as common.Hash.
+type EvmEventLog struct {
+	Name      string                 `json:"name"`
+	Signature string                 `json:"signature"`
+	Fields    map[string]interface{} `json:"fields"`
+	Log       ethtypes.Log           `json:"log"`
+}
@@ -7,5 +7,8 @@ import (
 
 type TxRes struct {
 	Response   *sdk.TxResponse
+	EvmTxHash  string
 	EvmReceipt *evmtypes.Receipt
+	// The address of the contract which is created by the evm transaction
+	EvmContractAddress string
 }
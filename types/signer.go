@@ -0,0 +1,21 @@
+package types
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+// Signer of transactions. The private key is not required to be held by the xpla client,
+// so it can be kept in the local signing daemon, the keyring or the KMS.
+// The xpla client signs both cosmos and evm transactions by the signer.
+type Signer interface {
+	// Get the public key of the signer.
+	PubKey() cryptotypes.PubKey
+	// Get the account address of the signer.
+	Address() sdk.AccAddress
+	// Sign the sign bytes of the cosmos transaction which are made by the sign mode, direct or legacy amino JSON.
+	Sign(signMode signing.SignMode, signBytes []byte) ([]byte, error)
+	// Sign the hash of the evm transaction. The signature is 65 bytes [R || S || V] format.
+	SignEvmTxHash(hash []byte) ([]byte, error)
+}
@@ -0,0 +1,12 @@
+package types
+
+import "context"
+
+// Provider of the verifiable presentation (VP) which is used for accessing the private chain.
+// The xpla client gets the VP from the provider whenever it sends a request.
+type VPProvider interface {
+	// Get the VP. The cached VP may be returned.
+	VP(ctx context.Context) ([]byte, error)
+	// Get the new VP. It is called when the request is failed by the authorization of the VP.
+	Refresh(ctx context.Context) ([]byte, error)
+}
@@ -5,6 +5,9 @@ import (
 	"context"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/Moonyongjung/xpriv.go/types"
@@ -23,6 +26,7 @@ const (
 	BackendFile   = "file"
 	BackendMemory = "memory"
 	BackendTest   = "test"
+	BackendOs     = "os"
 )
 
 // Provide cosmos sdk client.
@@ -70,7 +74,8 @@ func NewEvmClient(evmRpcUrl string, ctx context.Context) (*EvmClient, error) {
 	defaultTransport := *defaultTransportPointer
 	defaultTransport.DisableKeepAlives = true
 
-	httpClient := &http.Client{Transport: &defaultTransport}
+	// The VP of the context is attached to JSON-RPC requests.
+	httpClient := &http.Client{Transport: NewVPTransport(&defaultTransport)}
 	rpcClient, err := erpc.DialHTTPWithClient(evmRpcUrl, httpClient)
 	if err != nil {
 		return nil, LogErr(errors.ErrEvmRpcRequest, err)
@@ -81,8 +86,16 @@ func NewEvmClient(evmRpcUrl string, ctx context.Context) (*EvmClient, error) {
 	return &EvmClient{ctx, ethClient, rpcClient}, nil
 }
 
-// Provide cosmos sdk keyring
-func NewKeyring(backendType string, keyringPath string) (keyring.Keyring, error) {
+// Provide cosmos sdk keyring.
+// The passphrase is required by the file backend, and it is at least 8 characters.
+// The keyring of the file backend is created with the passphrase if it does not exist.
+func NewKeyring(backendType string, keyringPath string, passphrase ...string) (keyring.Keyring, error) {
+	// The file keyring reads the passphrase twice when it is created, to confirm it.
+	var userInput io.Reader
+	if len(passphrase) > 0 && passphrase[0] != "" {
+		userInput = strings.NewReader(passphrase[0] + "\n" + passphrase[0] + "\n")
+	}
+
 	switch {
 	case backendType == BackendMemory:
 		k, err := keyring.New(
@@ -99,11 +112,15 @@ func NewKeyring(backendType string, keyringPath string) (keyring.Keyring, error)
 		return k, nil
 
 	case backendType == BackendFile:
+		if userInput == nil {
+			return nil, LogErr(errors.ErrInsufficientParams, "need passphrase of the file keyring")
+		}
+
 		k, err := keyring.New(
 			types.XplaToolDefaultName,
 			keyring.BackendFile,
 			keyringPath,
-			nil,
+			userInput,
 			hd.EthSecp256k1Option(),
 		)
 		if err != nil {
@@ -126,11 +143,40 @@ func NewKeyring(backendType string, keyringPath string) (keyring.Keyring, error)
 
 		return k, nil
 
+	case backendType == BackendOs:
+		k, err := keyring.New(
+			types.XplaToolDefaultName,
+			keyring.BackendOS,
+			keyringPath,
+			userInput,
+			hd.EthSecp256k1Option(),
+		)
+		if err != nil {
+			return nil, LogErr(errors.ErrKeyNotFound, err)
+		}
+
+		return k, nil
+
 	default:
 		return nil, LogErr(errors.ErrInvalidMsgType, "invalid keyring backend type")
 	}
 }
 
+// Get the directory of the keyring.
+// The default directory is "$HOME/.xpla" when the keyring path of the file or test backend is empty.
+func KeyringPath(backendType string, keyringPath string) (string, error) {
+	if keyringPath != "" || (backendType != BackendFile && backendType != BackendTest) {
+		return keyringPath, nil
+	}
+
+	userHomeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", LogErr(errors.ErrParse, err)
+	}
+
+	return filepath.Join(userHomeDir, ".xpla"), nil
+}
+
 // Provide cosmos sdk tx factory.
 func NewFactory(clientCtx cmclient.Context) tx.Factory {
 	txFactory := tx.Factory{}.
@@ -146,7 +192,8 @@ func CtxHttpClient(methodType string, url string, reqBody []byte, ctx context.Co
 	var resp *http.Response
 	var err error
 
-	httpClient := &http.Client{Timeout: 30 * time.Second}
+	// The VP of the context is attached to the request.
+	httpClient := &http.Client{Timeout: 30 * time.Second, Transport: NewVPTransport(nil)}
 
 	if methodType == "GET" {
 		resp, err = ctxhttp.Get(ctx, httpClient, url)
@@ -170,7 +217,11 @@ func CtxHttpClient(methodType string, url string, reqBody []byte, ctx context.Co
 	}
 
 	if resp.StatusCode != 200 {
-		return nil, LogErr(errors.ErrHttpRequest, resp.StatusCode, ":", string(out))
+		return nil, &HttpStatusError{
+			StatusCode: resp.StatusCode,
+			Body:       out,
+			err:        LogErr(errors.ErrHttpRequest, resp.StatusCode, ":", string(out)),
+		}
 	}
 
 	return out, nil
@@ -1,14 +1,17 @@
 package util
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"strconv"
 	"strings"
 
+	"github.com/Moonyongjung/xpriv.go/types"
 	"github.com/Moonyongjung/xpriv.go/types/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 func FromBigIntToString(v *big.Int) string {
@@ -94,6 +97,35 @@ func FromByte20AddressToCosmosAddr(address common.Address) (sdk.AccAddress, erro
 	return addr, nil
 }
 
+func FromEvmAccessTuplesToAccessList(tuples []types.EvmAccessTuple) (ethtypes.AccessList, error) {
+	if len(tuples) == 0 {
+		return nil, nil
+	}
+
+	accessList := make(ethtypes.AccessList, 0, len(tuples))
+	for _, tuple := range tuples {
+		if !common.IsHexAddress(tuple.Address) {
+			return nil, LogErr(errors.ErrInvalidRequest, "invalid address of the access list:", tuple.Address)
+		}
+
+		storageKeys := make([]common.Hash, 0, len(tuple.StorageKeys))
+		for _, storageKey := range tuple.StorageKeys {
+			keyBytes, err := hex.DecodeString(strings.TrimPrefix(storageKey, "0x"))
+			if err != nil || len(keyBytes) > common.HashLength {
+				return nil, LogErr(errors.ErrInvalidRequest, "invalid storage key of the access list:", storageKey)
+			}
+			storageKeys = append(storageKeys, common.BytesToHash(keyBytes))
+		}
+
+		accessList = append(accessList, ethtypes.AccessTuple{
+			Address:     common.HexToAddress(tuple.Address),
+			StorageKeys: storageKeys,
+		})
+	}
+
+	return accessList, nil
+}
+
 func FromStringHexToHash(hashString string) common.Hash {
 	return common.HexToHash(hashString)
 }
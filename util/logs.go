@@ -11,8 +11,16 @@ func LogInfo(log ...interface{}) {
 	fmt.Println(ToStringTrim(log, ""))
 }
 
+// The first error in the description is kept as the cause of the returned error,
+// so the gRPC status or the HTTP status of it can be checked.
 func LogErr(errType xgoerrors.XGoError, errDesc ...interface{}) error {
-	return logErrReturn("code", errType.ErrCode(), ":", errType.Desc(), "-", errDesc)
+	err := logErrReturn("code", errType.ErrCode(), ":", errType.Desc(), "-", errDesc)
+	for _, desc := range errDesc {
+		if cause, ok := desc.(error); ok && cause != nil {
+			return &causeError{msg: err.Error(), cause: cause}
+		}
+	}
+	return err
 }
 
 func logErrReturn(log ...interface{}) error {
@@ -0,0 +1,93 @@
+package util
+
+import (
+	"strings"
+	"sync"
+)
+
+// Messages of errors which are returned when the sequence (nonce) of the transaction is not matched with the account.
+var sequenceMismatchLogs = []string{
+	"account sequence mismatch",
+	"incorrect account sequence",
+	"invalid nonce",
+	"nonce too low",
+}
+
+// Nonce manager keeps account number and sequence of addresses locally,
+// in order to send transactions back to back without waiting that the state of the account is changed.
+// The sequence is used as the nonce of the evm transaction.
+// It is safe for concurrent use, and it can be shared by several xpla clients.
+type NonceManager struct {
+	mu       sync.Mutex
+	accounts map[string]accountNonce
+}
+
+type accountNonce struct {
+	accountNumber uint64
+	sequence      uint64
+}
+
+// Make new nonce manager.
+func NewNonceManager() *NonceManager {
+	return &NonceManager{
+		accounts: make(map[string]accountNonce),
+	}
+}
+
+// Get account number and sequence of the address.
+// If the address is not managed, ok is false.
+func (m *NonceManager) Get(address string) (accountNumber uint64, sequence uint64, ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	account, ok := m.accounts[address]
+	return account.accountNumber, account.sequence, ok
+}
+
+// Set account number and sequence of the address.
+// It is used when the account is loaded from the chain.
+func (m *NonceManager) Set(address string, accountNumber uint64, sequence uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.accounts[address] = accountNonce{
+		accountNumber: accountNumber,
+		sequence:      sequence,
+	}
+}
+
+// Increment the sequence of the address after the transaction which has the used sequence is broadcasted.
+// The sequence is not decreased even though the transaction which has old sequence is broadcasted late.
+func (m *NonceManager) Increment(address string, usedSequence uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	account, ok := m.accounts[address]
+	if !ok {
+		return
+	}
+
+	if account.sequence <= usedSequence {
+		account.sequence = usedSequence + 1
+		m.accounts[address] = account
+	}
+}
+
+// Delete the address from the nonce manager.
+// The account is loaded from the chain again when the next transaction is created.
+func (m *NonceManager) Delete(address string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.accounts, address)
+}
+
+// Check the error log is occurred by mismatched sequence (nonce) of the transaction.
+func IsSequenceMismatch(log string) bool {
+	for _, sequenceMismatchLog := range sequenceMismatchLogs {
+		if strings.Contains(log, sequenceMismatchLog) {
+			return true
+		}
+	}
+	return false
+}
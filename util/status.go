@@ -0,0 +1,72 @@
+package util
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error of the HTTP request whose response status is not OK.
+// The status code is kept, so the error can be checked by the status code even though it is wrapped by LogErr.
+type HttpStatusError struct {
+	StatusCode int
+	Body       []byte
+	err        error
+}
+
+func (e *HttpStatusError) Error() string {
+	return e.err.Error()
+}
+
+// Error of xpla.go which keeps the error of the cause, e.g. the gRPC status error.
+type causeError struct {
+	msg   string
+	cause error
+}
+
+func (e *causeError) Error() string {
+	return e.msg
+}
+
+func (e *causeError) Unwrap() error {
+	return e.cause
+}
+
+// Get the HTTP status code of the error which is returned by the HTTP request.
+func HttpStatusCode(err error) (int, bool) {
+	var httpErr *HttpStatusError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode, true
+	}
+
+	// Error of the evm JSON-RPC request
+	var rpcErr rpc.HTTPError
+	if errors.As(err, &rpcErr) {
+		return rpcErr.StatusCode, true
+	}
+	return 0, false
+}
+
+// Get the gRPC status code of the error which is returned by the gRPC request.
+func GrpcStatusCode(err error) (codes.Code, bool) {
+	var grpcErr interface{ GRPCStatus() *status.Status }
+	if errors.As(err, &grpcErr) {
+		return grpcErr.GRPCStatus().Code(), true
+	}
+	return codes.OK, false
+}
+
+// Check the error is occurred because the requested data does not exist,
+// e.g. the gRPC status is not found or the HTTP status is 404.
+func IsNotFoundErr(err error) bool {
+	if code, ok := GrpcStatusCode(err); ok {
+		return code == codes.NotFound
+	}
+	if code, ok := HttpStatusCode(err); ok {
+		return code == http.StatusNotFound
+	}
+	return false
+}
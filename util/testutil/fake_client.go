@@ -0,0 +1,211 @@
+package testutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Moonyongjung/xpriv.go/provider"
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+	"github.com/Moonyongjung/xpriv.go/util"
+)
+
+var _ provider.XplaClient = (*FakeXplaClient)(nil)
+
+// Fake xpla client for unit tests of packages which use the xpla client without the network.
+// Methods of modules, e.g. CreateDID, record the called method and its message, and requests of the
+// client are handled by FakeXplaClientHandlers of the test.
+// The method which is not handled fails the test.
+type FakeXplaClient struct {
+	t        testing.TB
+	handlers *FakeXplaClientHandlers
+
+	// The method of the module which is called, e.g. "CreateDID".
+	Method string
+
+	ctx        context.Context
+	module     string
+	msgType    string
+	msg        interface{}
+	batchMsgs  []provider.BatchMsg
+	rpc        string
+	signer     types.Signer
+	vpProvider types.VPProvider
+	err        error
+}
+
+// Handlers of requests of the fake xpla client.
+// The request whose handler is nil fails the test.
+type FakeXplaClientHandlers struct {
+	// Convert the message of the called method to the message which is kept in the client.
+	// If it is nil, the message of the method is kept.
+	Msg              func(method string, msg interface{}) interface{}
+	Query            func(c *FakeXplaClient) (string, error)
+	QueryTyped       func(c *FakeXplaClient, res interface{}) error
+	CreateAndSignTx  func(c *FakeXplaClient) ([]byte, error)
+	BroadcastAndWait func(c *FakeXplaClient, txbytes []byte, timeout time.Duration) (*types.TxRes, error)
+}
+
+// Make new fake xpla client.
+func NewFakeXplaClient(t testing.TB, handlers FakeXplaClientHandlers) *FakeXplaClient {
+	return &FakeXplaClient{
+		t:        t,
+		handlers: &handlers,
+		ctx:      context.Background(),
+	}
+}
+
+// Fail the test because the request of the client is not expected.
+// The returned error is used as the result of the request.
+func (c *FakeXplaClient) Unexpected() error {
+	c.t.Helper()
+	return c.unexpected(c.Method).err
+}
+
+func (c *FakeXplaClient) copy() *FakeXplaClient {
+	copied := *c
+	return &copied
+}
+
+func (c *FakeXplaClient) call(method string, msg interface{}) provider.XplaClient {
+	called := c.copy()
+	called.Method = method
+	called.module = ""
+	called.msgType = ""
+	called.msg = msg
+	if c.handlers.Msg != nil {
+		called.msg = c.handlers.Msg(method, msg)
+	}
+	return called
+}
+
+func (c *FakeXplaClient) unexpected(method string) *FakeXplaClient {
+	c.t.Helper()
+	c.t.Errorf("unexpected call of the fake xpla client: %s", method)
+
+	failed := c.copy()
+	failed.err = util.LogErr(errors.ErrInvalidRequest, "unexpected call:", method)
+	return failed
+}
+
+func optionalMsg[T any](msgs []T) interface{} {
+	if len(msgs) == 0 {
+		return nil
+	}
+	return msgs[0]
+}
+
+func (c *FakeXplaClient) WithContext(ctx context.Context) provider.XplaClient {
+	copied := c.copy()
+	copied.ctx = ctx
+	return copied
+}
+
+func (c *FakeXplaClient) WithModule(module string) provider.XplaClient {
+	copied := c.copy()
+	copied.module = module
+	return copied
+}
+
+func (c *FakeXplaClient) WithMsgType(msgType string) provider.XplaClient {
+	copied := c.copy()
+	copied.msgType = msgType
+	return copied
+}
+
+func (c *FakeXplaClient) WithMsg(msg interface{}) provider.XplaClient {
+	copied := c.copy()
+	copied.msg = msg
+	return copied
+}
+
+func (c *FakeXplaClient) WithErr(err error) provider.XplaClient {
+	copied := c.copy()
+	copied.err = err
+	return copied
+}
+
+func (c *FakeXplaClient) WithRpc(rpc string) provider.XplaClient {
+	copied := c.copy()
+	copied.rpc = rpc
+	return copied
+}
+
+func (c *FakeXplaClient) WithSigner(signer types.Signer) provider.XplaClient {
+	copied := c.copy()
+	copied.signer = signer
+	return copied
+}
+
+func (c *FakeXplaClient) WithVPProvider(vpProvider types.VPProvider) provider.XplaClient {
+	copied := c.copy()
+	copied.vpProvider = vpProvider
+	return copied
+}
+
+// Batch records messages of clients as the batch messages.
+func (c *FakeXplaClient) Batch(xplacs ...provider.XplaClient) provider.XplaClient {
+	batched := c.copy()
+	batched.Method = "Batch"
+	batched.batchMsgs = nil
+	for _, xplac := range xplacs {
+		batched.batchMsgs = append(batched.batchMsgs, provider.BatchMsg{
+			Module:  xplac.GetModule(),
+			MsgType: xplac.GetMsgType(),
+			Msg:     xplac.GetMsg(),
+		})
+	}
+	return batched
+}
+
+func (c *FakeXplaClient) GetContext() context.Context       { return c.ctx }
+func (c *FakeXplaClient) GetModule() string                 { return c.module }
+func (c *FakeXplaClient) GetMsgType() string                { return c.msgType }
+func (c *FakeXplaClient) GetMsg() interface{}               { return c.msg }
+func (c *FakeXplaClient) GetBatchMsgs() []provider.BatchMsg { return c.batchMsgs }
+func (c *FakeXplaClient) GetErr() error                     { return c.err }
+func (c *FakeXplaClient) GetRpc() string                    { return c.rpc }
+func (c *FakeXplaClient) GetSigner() types.Signer           { return c.signer }
+func (c *FakeXplaClient) GetVPProvider() types.VPProvider   { return c.vpProvider }
+
+func (c *FakeXplaClient) Query() (string, error) {
+	c.t.Helper()
+	if c.err != nil {
+		return "", c.err
+	}
+	if c.handlers.Query == nil {
+		return "", c.Unexpected()
+	}
+	return c.handlers.Query(c)
+}
+
+func (c *FakeXplaClient) QueryTyped(res interface{}) error {
+	c.t.Helper()
+	if c.err != nil {
+		return c.err
+	}
+	if c.handlers.QueryTyped == nil {
+		return c.Unexpected()
+	}
+	return c.handlers.QueryTyped(c, res)
+}
+
+func (c *FakeXplaClient) CreateAndSignTx() ([]byte, error) {
+	c.t.Helper()
+	if c.err != nil {
+		return nil, c.err
+	}
+	if c.handlers.CreateAndSignTx == nil {
+		return nil, c.Unexpected()
+	}
+	return c.handlers.CreateAndSignTx(c)
+}
+
+func (c *FakeXplaClient) BroadcastAndWait(txbytes []byte, timeout time.Duration) (*types.TxRes, error) {
+	c.t.Helper()
+	if c.handlers.BroadcastAndWait == nil {
+		return nil, c.Unexpected()
+	}
+	return c.handlers.BroadcastAndWait(c, txbytes, timeout)
+}
@@ -0,0 +1,909 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/Moonyongjung/xpla-private-chain/app/params"
+	"github.com/Moonyongjung/xpriv.go/key"
+	"github.com/Moonyongjung/xpriv.go/provider"
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/util"
+
+	cmclient "github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/gogo/protobuf/grpc"
+)
+
+// Methods of the provider.XplaClient which are not handled by the fake xpla client.
+// Calling them fails the test. Methods of modules record the called method and its message.
+
+// WithProvider
+func (c *FakeXplaClient) UpdateXplacInCoreModule() provider.XplaClient {
+	return c.unexpected("UpdateXplacInCoreModule")
+}
+
+func (c *FakeXplaClient) WithOptions(provider.Options) provider.XplaClient {
+	return c.unexpected("WithOptions")
+}
+
+func (c *FakeXplaClient) WithChainId(string) provider.XplaClient {
+	return c.unexpected("WithChainId")
+}
+
+func (c *FakeXplaClient) WithEncoding(params.EncodingConfig) provider.XplaClient {
+	return c.unexpected("WithEncoding")
+}
+
+func (c *FakeXplaClient) WithPrivateKey(key.PrivateKey) provider.XplaClient {
+	return c.unexpected("WithPrivateKey")
+}
+
+func (c *FakeXplaClient) WithKeyring(string, string, string, ...string) provider.XplaClient {
+	return c.unexpected("WithKeyring")
+}
+
+func (c *FakeXplaClient) WithAccountNumber(string) provider.XplaClient {
+	return c.unexpected("WithAccountNumber")
+}
+
+func (c *FakeXplaClient) WithBroadcastMode(string) provider.XplaClient {
+	return c.unexpected("WithBroadcastMode")
+}
+
+func (c *FakeXplaClient) WithSequence(string) provider.XplaClient {
+	return c.unexpected("WithSequence")
+}
+
+func (c *FakeXplaClient) WithGasLimit(string) provider.XplaClient {
+	return c.unexpected("WithGasLimit")
+}
+
+func (c *FakeXplaClient) WithGasPrice(string) provider.XplaClient {
+	return c.unexpected("WithGasPrice")
+}
+
+func (c *FakeXplaClient) WithGasAdjustment(string) provider.XplaClient {
+	return c.unexpected("WithGasAdjustment")
+}
+
+func (c *FakeXplaClient) WithFeeAmount(string) provider.XplaClient {
+	return c.unexpected("WithFeeAmount")
+}
+
+func (c *FakeXplaClient) WithSignMode(signing.SignMode) provider.XplaClient {
+	return c.unexpected("WithSignMode")
+}
+
+func (c *FakeXplaClient) WithFeeGranter(sdk.AccAddress) provider.XplaClient {
+	return c.unexpected("WithFeeGranter")
+}
+
+func (c *FakeXplaClient) WithTimeoutHeight(string) provider.XplaClient {
+	return c.unexpected("WithTimeoutHeight")
+}
+
+func (c *FakeXplaClient) WithMemo(string) provider.XplaClient {
+	return c.unexpected("WithMemo")
+}
+
+func (c *FakeXplaClient) WithNonceManager(*util.NonceManager) provider.XplaClient {
+	return c.unexpected("WithNonceManager")
+}
+
+func (c *FakeXplaClient) WithURL(string) provider.XplaClient {
+	return c.unexpected("WithURL")
+}
+
+func (c *FakeXplaClient) WithGrpc(string) provider.XplaClient {
+	return c.unexpected("WithGrpc")
+}
+
+func (c *FakeXplaClient) WithEvmRpc(string) provider.XplaClient {
+	return c.unexpected("WithEvmRpc")
+}
+
+func (c *FakeXplaClient) WithPagination(types.Pagination) provider.XplaClient {
+	return c.unexpected("WithPagination")
+}
+
+func (c *FakeXplaClient) WithOutputDocument(string) provider.XplaClient {
+	return c.unexpected("WithOutputDocument")
+}
+
+func (c *FakeXplaClient) WithBatchMsgs([]provider.BatchMsg) provider.XplaClient {
+	return c.unexpected("WithBatchMsgs")
+}
+
+func (c *FakeXplaClient) AppendMsg() provider.XplaClient {
+	return c.unexpected("AppendMsg")
+}
+
+func (c *FakeXplaClient) WithUseVP(bool) provider.XplaClient {
+	return c.unexpected("WithUseVP")
+}
+
+func (c *FakeXplaClient) WithVPByPath(string) provider.XplaClient {
+	return c.unexpected("WithVPByPath")
+}
+
+func (c *FakeXplaClient) WithVPByString(string) provider.XplaClient {
+	return c.unexpected("WithVPByString")
+}
+
+// GetProvider
+func (c *FakeXplaClient) GetChainId() (_ string) {
+	c.unexpected("GetChainId")
+	return
+}
+
+func (c *FakeXplaClient) GetPrivateKey() (_ key.PrivateKey) {
+	c.unexpected("GetPrivateKey")
+	return
+}
+
+func (c *FakeXplaClient) GetEncoding() (_ params.EncodingConfig) {
+	c.unexpected("GetEncoding")
+	return
+}
+
+func (c *FakeXplaClient) GetLcdURL() (_ string) {
+	c.unexpected("GetLcdURL")
+	return
+}
+
+func (c *FakeXplaClient) GetGrpcUrl() (_ string) {
+	c.unexpected("GetGrpcUrl")
+	return
+}
+
+func (c *FakeXplaClient) GetGrpcClient() (_ grpc.ClientConn) {
+	c.unexpected("GetGrpcClient")
+	return
+}
+
+func (c *FakeXplaClient) GetEvmRpc() (_ string) {
+	c.unexpected("GetEvmRpc")
+	return
+}
+
+func (c *FakeXplaClient) GetBroadcastMode() (_ string) {
+	c.unexpected("GetBroadcastMode")
+	return
+}
+
+func (c *FakeXplaClient) GetAccountNumber() (_ string) {
+	c.unexpected("GetAccountNumber")
+	return
+}
+
+func (c *FakeXplaClient) GetSequence() (_ string) {
+	c.unexpected("GetSequence")
+	return
+}
+
+func (c *FakeXplaClient) GetGasLimit() (_ string) {
+	c.unexpected("GetGasLimit")
+	return
+}
+
+func (c *FakeXplaClient) GetGasPrice() (_ string) {
+	c.unexpected("GetGasPrice")
+	return
+}
+
+func (c *FakeXplaClient) GetGasAdjustment() (_ string) {
+	c.unexpected("GetGasAdjustment")
+	return
+}
+
+func (c *FakeXplaClient) GetFeeAmount() (_ string) {
+	c.unexpected("GetFeeAmount")
+	return
+}
+
+func (c *FakeXplaClient) GetSignMode() (_ signing.SignMode) {
+	c.unexpected("GetSignMode")
+	return
+}
+
+func (c *FakeXplaClient) GetFeeGranter() (_ sdk.AccAddress) {
+	c.unexpected("GetFeeGranter")
+	return
+}
+
+func (c *FakeXplaClient) GetTimeoutHeight() (_ string) {
+	c.unexpected("GetTimeoutHeight")
+	return
+}
+
+func (c *FakeXplaClient) GetMemo() (_ string) {
+	c.unexpected("GetMemo")
+	return
+}
+
+func (c *FakeXplaClient) GetNonceManager() (_ *util.NonceManager) {
+	c.unexpected("GetNonceManager")
+	return
+}
+
+func (c *FakeXplaClient) GetPagination() (_ *query.PageRequest) {
+	c.unexpected("GetPagination")
+	return
+}
+
+func (c *FakeXplaClient) GetOutputDocument() (_ string) {
+	c.unexpected("GetOutputDocument")
+	return
+}
+
+func (c *FakeXplaClient) GetUseVP() (_ bool) {
+	c.unexpected("GetUseVP")
+	return
+}
+
+func (c *FakeXplaClient) GetVPByte() (_ []byte) {
+	c.unexpected("GetVPByte")
+	return
+}
+
+// TxProvider
+func (c *FakeXplaClient) CreateUnsignedTx() (_ []byte, err error) {
+	err = c.unexpected("CreateUnsignedTx").err
+	return
+}
+
+func (c *FakeXplaClient) SignTx(types.SignTxMsg) (_ []byte, err error) {
+	err = c.unexpected("SignTx").err
+	return
+}
+
+func (c *FakeXplaClient) MultiSign(types.TxMultiSignMsg) (_ []byte, err error) {
+	err = c.unexpected("MultiSign").err
+	return
+}
+
+func (c *FakeXplaClient) EncodeTx(types.EncodeTxMsg) (_ string, err error) {
+	err = c.unexpected("EncodeTx").err
+	return
+}
+
+func (c *FakeXplaClient) DecodeTx(types.DecodeTxMsg) (_ string, err error) {
+	err = c.unexpected("DecodeTx").err
+	return
+}
+
+func (c *FakeXplaClient) ValidateSignatures(types.ValidateSignaturesMsg) (_ string, err error) {
+	err = c.unexpected("ValidateSignatures").err
+	return
+}
+
+// BroadcastProvider
+func (c *FakeXplaClient) Broadcast([]byte) (_ *types.TxRes, err error) {
+	err = c.unexpected("Broadcast").err
+	return
+}
+
+func (c *FakeXplaClient) BroadcastBlock([]byte) (_ *types.TxRes, err error) {
+	err = c.unexpected("BroadcastBlock").err
+	return
+}
+
+func (c *FakeXplaClient) BroadcastAsync([]byte) (_ *types.TxRes, err error) {
+	err = c.unexpected("BroadcastAsync").err
+	return
+}
+
+// InfoRequestProvider
+func (c *FakeXplaClient) LoadAccount(sdk.AccAddress) (_ authtypes.AccountI, err error) {
+	err = c.unexpected("LoadAccount").err
+	return
+}
+
+func (c *FakeXplaClient) Simulate(cmclient.TxBuilder) (_ *sdktx.SimulateResponse, err error) {
+	err = c.unexpected("Simulate").err
+	return
+}
+
+// SubscribeProvider
+func (c *FakeXplaClient) SubscribeNewBlock(context.Context, ...types.SubscribeOptions) (_ <-chan types.BlockEvent, _ <-chan error, err error) {
+	err = c.unexpected("SubscribeNewBlock").err
+	return
+}
+
+func (c *FakeXplaClient) SubscribeTx(context.Context, string, ...types.SubscribeOptions) (_ <-chan types.TxEvent, _ <-chan error, err error) {
+	err = c.unexpected("SubscribeTx").err
+	return
+}
+
+func (c *FakeXplaClient) SubscribeEvmLogs(context.Context, types.EthNewFilterMsg, string, ...types.SubscribeOptions) (_ <-chan types.EvmEventLog, _ <-chan error, err error) {
+	err = c.unexpected("SubscribeEvmLogs").err
+	return
+}
+
+// HelperProvider
+func (c *FakeXplaClient) EncodedTxbytesToJsonTx([]byte) (_ []byte, err error) {
+	err = c.unexpected("EncodedTxbytesToJsonTx").err
+	return
+}
+
+// TxMsgProvider
+func (c *FakeXplaClient) RegisterAnchorAcc(msg types.RegisterAnchorAccMsg) provider.XplaClient {
+	return c.call("RegisterAnchorAcc", msg)
+}
+
+func (c *FakeXplaClient) ChangeAnchorAcc(msg types.ChangeAnchorAccMsg) provider.XplaClient {
+	return c.call("ChangeAnchorAcc", msg)
+}
+
+func (c *FakeXplaClient) BankSend(msg types.BankSendMsg) provider.XplaClient {
+	return c.call("BankSend", msg)
+}
+
+func (c *FakeXplaClient) InvariantBroken(msg types.InvariantBrokenMsg) provider.XplaClient {
+	return c.call("InvariantBroken", msg)
+}
+
+func (c *FakeXplaClient) CreateDID(msg types.CreateDIDMsg) provider.XplaClient {
+	return c.call("CreateDID", msg)
+}
+
+func (c *FakeXplaClient) UpdateDID(msg types.UpdateDIDMsg) provider.XplaClient {
+	return c.call("UpdateDID", msg)
+}
+
+func (c *FakeXplaClient) DeactivateDID(msg types.DeactivateDIDMsg) provider.XplaClient {
+	return c.call("DeactivateDID", msg)
+}
+
+func (c *FakeXplaClient) ReplaceDIDMoniker(msg types.ReplaceDIDMonikerMsg) provider.XplaClient {
+	return c.call("ReplaceDIDMoniker", msg)
+}
+
+func (c *FakeXplaClient) FundCommunityPool(msg types.FundCommunityPoolMsg) provider.XplaClient {
+	return c.call("FundCommunityPool", msg)
+}
+
+func (c *FakeXplaClient) CommunityPoolSpend(msg types.CommunityPoolSpendMsg) provider.XplaClient {
+	return c.call("CommunityPoolSpend", msg)
+}
+
+func (c *FakeXplaClient) WithdrawRewards(msg types.WithdrawRewardsMsg) provider.XplaClient {
+	return c.call("WithdrawRewards", msg)
+}
+
+func (c *FakeXplaClient) WithdrawAllRewards() provider.XplaClient {
+	return c.call("WithdrawAllRewards", nil)
+}
+
+func (c *FakeXplaClient) SetWithdrawAddr(msg types.SetWithdrawAddrMsg) provider.XplaClient {
+	return c.call("SetWithdrawAddr", msg)
+}
+
+func (c *FakeXplaClient) EvmSendCoin(msg types.SendCoinMsg) provider.XplaClient {
+	return c.call("EvmSendCoin", msg)
+}
+
+func (c *FakeXplaClient) DeploySolidityContract(msg types.DeploySolContractMsg) provider.XplaClient {
+	return c.call("DeploySolidityContract", msg)
+}
+
+func (c *FakeXplaClient) InvokeSolidityContract(msg types.InvokeSolContractMsg) provider.XplaClient {
+	return c.call("InvokeSolidityContract", msg)
+}
+
+func (c *FakeXplaClient) FeeGrant(msg types.FeeGrantMsg) provider.XplaClient {
+	return c.call("FeeGrant", msg)
+}
+
+func (c *FakeXplaClient) RevokeFeeGrant(msg types.RevokeFeeGrantMsg) provider.XplaClient {
+	return c.call("RevokeFeeGrant", msg)
+}
+
+func (c *FakeXplaClient) SubmitProposal(msg types.SubmitProposalMsg) provider.XplaClient {
+	return c.call("SubmitProposal", msg)
+}
+
+func (c *FakeXplaClient) GovDeposit(msg types.GovDepositMsg) provider.XplaClient {
+	return c.call("GovDeposit", msg)
+}
+
+func (c *FakeXplaClient) Vote(msg types.VoteMsg) provider.XplaClient {
+	return c.call("Vote", msg)
+}
+
+func (c *FakeXplaClient) WeightedVote(msg types.WeightedVoteMsg) provider.XplaClient {
+	return c.call("WeightedVote", msg)
+}
+
+func (c *FakeXplaClient) ParamChange(msg types.ParamChangeMsg) provider.XplaClient {
+	return c.call("ParamChange", msg)
+}
+
+func (c *FakeXplaClient) InitialAdmin(msg types.InitialAdminMsg) provider.XplaClient {
+	return c.call("InitialAdmin", msg)
+}
+
+func (c *FakeXplaClient) AddAdmin(msg types.AddAdminMsg) provider.XplaClient {
+	return c.call("AddAdmin", msg)
+}
+
+func (c *FakeXplaClient) Participate(msg types.ParticipateMsg) provider.XplaClient {
+	return c.call("Participate", msg)
+}
+
+func (c *FakeXplaClient) Accept(msg types.AcceptMsg) provider.XplaClient {
+	return c.call("Accept", msg)
+}
+
+func (c *FakeXplaClient) Deny(msg types.DenyMsg) provider.XplaClient {
+	return c.call("Deny", msg)
+}
+
+func (c *FakeXplaClient) Exile(msg types.ExileMsg) provider.XplaClient {
+	return c.call("Exile", msg)
+}
+
+func (c *FakeXplaClient) Quit(msg types.QuitMsg) provider.XplaClient {
+	return c.call("Quit", msg)
+}
+
+func (c *FakeXplaClient) Unjail() provider.XplaClient {
+	return c.call("Unjail", nil)
+}
+
+func (c *FakeXplaClient) CreateValidator(msg types.CreateValidatorMsg) provider.XplaClient {
+	return c.call("CreateValidator", msg)
+}
+
+func (c *FakeXplaClient) EditValidator(msg types.EditValidatorMsg) provider.XplaClient {
+	return c.call("EditValidator", msg)
+}
+
+func (c *FakeXplaClient) Delegate(msg types.DelegateMsg) provider.XplaClient {
+	return c.call("Delegate", msg)
+}
+
+func (c *FakeXplaClient) Unbond(msg types.UnbondMsg) provider.XplaClient {
+	return c.call("Unbond", msg)
+}
+
+func (c *FakeXplaClient) Redelegate(msg types.RedelegateMsg) provider.XplaClient {
+	return c.call("Redelegate", msg)
+}
+
+func (c *FakeXplaClient) SoftwareUpgrade(msg types.SoftwareUpgradeMsg) provider.XplaClient {
+	return c.call("SoftwareUpgrade", msg)
+}
+
+func (c *FakeXplaClient) CancelSoftwareUpgrade(msg types.CancelSoftwareUpgradeMsg) provider.XplaClient {
+	return c.call("CancelSoftwareUpgrade", msg)
+}
+
+func (c *FakeXplaClient) StoreCode(msg types.StoreMsg) provider.XplaClient {
+	return c.call("StoreCode", msg)
+}
+
+func (c *FakeXplaClient) InstantiateContract(msg types.InstantiateMsg) provider.XplaClient {
+	return c.call("InstantiateContract", msg)
+}
+
+func (c *FakeXplaClient) ExecuteContract(msg types.ExecuteMsg) provider.XplaClient {
+	return c.call("ExecuteContract", msg)
+}
+
+func (c *FakeXplaClient) ClearContractAdmin(msg types.ClearContractAdminMsg) provider.XplaClient {
+	return c.call("ClearContractAdmin", msg)
+}
+
+func (c *FakeXplaClient) SetContractAdmin(msg types.SetContractAdminMsg) provider.XplaClient {
+	return c.call("SetContractAdmin", msg)
+}
+
+func (c *FakeXplaClient) Migrate(msg types.MigrateMsg) provider.XplaClient {
+	return c.call("Migrate", msg)
+}
+
+// QueryMsgProvider
+func (c *FakeXplaClient) AnchorAcc(msg types.AnchorAccMsg) provider.XplaClient {
+	return c.call("AnchorAcc", msg)
+}
+
+func (c *FakeXplaClient) AllAggregatedBlocks() provider.XplaClient {
+	return c.call("AllAggregatedBlocks", nil)
+}
+
+func (c *FakeXplaClient) AnchorInfo(msg types.AnchorInfoMsg) provider.XplaClient {
+	return c.call("AnchorInfo", msg)
+}
+
+func (c *FakeXplaClient) AnchorBlock(msg types.AnchorBlockMsg) provider.XplaClient {
+	return c.call("AnchorBlock", msg)
+}
+
+func (c *FakeXplaClient) AnchorTxBody(msg types.AnchorTxBodyMsg) provider.XplaClient {
+	return c.call("AnchorTxBody", msg)
+}
+
+func (c *FakeXplaClient) AnchorVerify(msg types.AnchorVerifyMsg) provider.XplaClient {
+	return c.call("AnchorVerify", msg)
+}
+
+func (c *FakeXplaClient) AnchorBalances(msg types.AnchorBalancesMsg) provider.XplaClient {
+	return c.call("AnchorBalances", msg)
+}
+
+func (c *FakeXplaClient) AnchorParams() provider.XplaClient {
+	return c.call("AnchorParams", nil)
+}
+
+func (c *FakeXplaClient) AuthParams() provider.XplaClient {
+	return c.call("AuthParams", nil)
+}
+
+func (c *FakeXplaClient) AccAddress(msg types.QueryAccAddressMsg) provider.XplaClient {
+	return c.call("AccAddress", msg)
+}
+
+func (c *FakeXplaClient) Accounts() provider.XplaClient {
+	return c.call("Accounts", nil)
+}
+
+func (c *FakeXplaClient) TxsByEvents(msg types.QueryTxsByEventsMsg) provider.XplaClient {
+	return c.call("TxsByEvents", msg)
+}
+
+func (c *FakeXplaClient) Tx(msg types.QueryTxMsg) provider.XplaClient {
+	return c.call("Tx", msg)
+}
+
+func (c *FakeXplaClient) BankBalances(msg types.BankBalancesMsg) provider.XplaClient {
+	return c.call("BankBalances", msg)
+}
+
+func (c *FakeXplaClient) DenomMetadata(msg ...types.DenomMetadataMsg) provider.XplaClient {
+	return c.call("DenomMetadata", optionalMsg(msg))
+}
+
+func (c *FakeXplaClient) Total(msg ...types.TotalMsg) provider.XplaClient {
+	return c.call("Total", optionalMsg(msg))
+}
+
+func (c *FakeXplaClient) NodeInfo() provider.XplaClient {
+	return c.call("NodeInfo", nil)
+}
+
+func (c *FakeXplaClient) Syncing() provider.XplaClient {
+	return c.call("Syncing", nil)
+}
+
+func (c *FakeXplaClient) Block(msg ...types.BlockMsg) provider.XplaClient {
+	return c.call("Block", optionalMsg(msg))
+}
+
+func (c *FakeXplaClient) ValidatorSet(msg ...types.ValidatorSetMsg) provider.XplaClient {
+	return c.call("ValidatorSet", optionalMsg(msg))
+}
+
+func (c *FakeXplaClient) GetDID(msg types.GetDIDMsg) provider.XplaClient {
+	return c.call("GetDID", msg)
+}
+
+func (c *FakeXplaClient) MonikerByDID(msg types.MonikerByDIDMsg) provider.XplaClient {
+	return c.call("MonikerByDID", msg)
+}
+
+func (c *FakeXplaClient) DIDByMoniker(msg types.DIDByMonikerMsg) provider.XplaClient {
+	return c.call("DIDByMoniker", msg)
+}
+
+func (c *FakeXplaClient) AllDIDs() provider.XplaClient {
+	return c.call("AllDIDs", nil)
+}
+
+func (c *FakeXplaClient) DistributionParams() provider.XplaClient {
+	return c.call("DistributionParams", nil)
+}
+
+func (c *FakeXplaClient) ValidatorOutstandingRewards(msg types.ValidatorOutstandingRewardsMsg) provider.XplaClient {
+	return c.call("ValidatorOutstandingRewards", msg)
+}
+
+func (c *FakeXplaClient) DistCommission(msg types.QueryDistCommissionMsg) provider.XplaClient {
+	return c.call("DistCommission", msg)
+}
+
+func (c *FakeXplaClient) DistSlashes(msg types.QueryDistSlashesMsg) provider.XplaClient {
+	return c.call("DistSlashes", msg)
+}
+
+func (c *FakeXplaClient) DistRewards(msg types.QueryDistRewardsMsg) provider.XplaClient {
+	return c.call("DistRewards", msg)
+}
+
+func (c *FakeXplaClient) CommunityPool() provider.XplaClient {
+	return c.call("CommunityPool", nil)
+}
+
+func (c *FakeXplaClient) QueryEvidence(msg ...types.QueryEvidenceMsg) provider.XplaClient {
+	return c.call("QueryEvidence", optionalMsg(msg))
+}
+
+func (c *FakeXplaClient) CallSolidityContract(msg types.CallSolContractMsg) provider.XplaClient {
+	return c.call("CallSolidityContract", msg)
+}
+
+func (c *FakeXplaClient) GetTransactionByHash(msg types.GetTransactionByHashMsg) provider.XplaClient {
+	return c.call("GetTransactionByHash", msg)
+}
+
+func (c *FakeXplaClient) GetBlockByHashOrHeight(msg types.GetBlockByHashHeightMsg) provider.XplaClient {
+	return c.call("GetBlockByHashOrHeight", msg)
+}
+
+func (c *FakeXplaClient) AccountInfo(msg types.AccountInfoMsg) provider.XplaClient {
+	return c.call("AccountInfo", msg)
+}
+
+func (c *FakeXplaClient) SuggestGasPrice() provider.XplaClient {
+	return c.call("SuggestGasPrice", nil)
+}
+
+func (c *FakeXplaClient) EthChainID() provider.XplaClient {
+	return c.call("EthChainID", nil)
+}
+
+func (c *FakeXplaClient) EthBlockNumber() provider.XplaClient {
+	return c.call("EthBlockNumber", nil)
+}
+
+func (c *FakeXplaClient) Web3ClientVersion() provider.XplaClient {
+	return c.call("Web3ClientVersion", nil)
+}
+
+func (c *FakeXplaClient) Web3Sha3(msg types.Web3Sha3Msg) provider.XplaClient {
+	return c.call("Web3Sha3", msg)
+}
+
+func (c *FakeXplaClient) NetVersion() provider.XplaClient {
+	return c.call("NetVersion", nil)
+}
+
+func (c *FakeXplaClient) NetPeerCount() provider.XplaClient {
+	return c.call("NetPeerCount", nil)
+}
+
+func (c *FakeXplaClient) NetListening() provider.XplaClient {
+	return c.call("NetListening", nil)
+}
+
+func (c *FakeXplaClient) EthProtocolVersion() provider.XplaClient {
+	return c.call("EthProtocolVersion", nil)
+}
+
+func (c *FakeXplaClient) EthSyncing() provider.XplaClient {
+	return c.call("EthSyncing", nil)
+}
+
+func (c *FakeXplaClient) EthAccounts() provider.XplaClient {
+	return c.call("EthAccounts", nil)
+}
+
+func (c *FakeXplaClient) EthGetBlockTransactionCount(msg types.EthGetBlockTransactionCountMsg) provider.XplaClient {
+	return c.call("EthGetBlockTransactionCount", msg)
+}
+
+func (c *FakeXplaClient) EstimateGas(msg types.InvokeSolContractMsg) provider.XplaClient {
+	return c.call("EstimateGas", msg)
+}
+
+func (c *FakeXplaClient) EthGetTransactionByBlockHashAndIndex(msg types.GetTransactionByBlockHashAndIndexMsg) provider.XplaClient {
+	return c.call("EthGetTransactionByBlockHashAndIndex", msg)
+}
+
+func (c *FakeXplaClient) EthGetTransactionReceipt(msg types.GetTransactionReceiptMsg) provider.XplaClient {
+	return c.call("EthGetTransactionReceipt", msg)
+}
+
+func (c *FakeXplaClient) EthNewFilter(msg types.EthNewFilterMsg) provider.XplaClient {
+	return c.call("EthNewFilter", msg)
+}
+
+func (c *FakeXplaClient) EthNewBlockFilter() provider.XplaClient {
+	return c.call("EthNewBlockFilter", nil)
+}
+
+func (c *FakeXplaClient) EthNewPendingTransactionFilter() provider.XplaClient {
+	return c.call("EthNewPendingTransactionFilter", nil)
+}
+
+func (c *FakeXplaClient) EthUninstallFilter(msg types.EthUninstallFilterMsg) provider.XplaClient {
+	return c.call("EthUninstallFilter", msg)
+}
+
+func (c *FakeXplaClient) EthGetFilterChanges(msg types.EthGetFilterChangesMsg) provider.XplaClient {
+	return c.call("EthGetFilterChanges", msg)
+}
+
+func (c *FakeXplaClient) EthGetFilterLogs(msg types.EthGetFilterLogsMsg) provider.XplaClient {
+	return c.call("EthGetFilterLogs", msg)
+}
+
+func (c *FakeXplaClient) EthGetLogs(msg types.EthGetLogsMsg) provider.XplaClient {
+	return c.call("EthGetLogs", msg)
+}
+
+func (c *FakeXplaClient) EthCoinbase() provider.XplaClient {
+	return c.call("EthCoinbase", nil)
+}
+
+func (c *FakeXplaClient) QueryFeeGrants(msg types.QueryFeeGrantMsg) provider.XplaClient {
+	return c.call("QueryFeeGrants", msg)
+}
+
+func (c *FakeXplaClient) QueryProposal(msg types.QueryProposalMsg) provider.XplaClient {
+	return c.call("QueryProposal", msg)
+}
+
+func (c *FakeXplaClient) QueryProposals(msg types.QueryProposalsMsg) provider.XplaClient {
+	return c.call("QueryProposals", msg)
+}
+
+func (c *FakeXplaClient) QueryDeposit(msg types.QueryDepositMsg) provider.XplaClient {
+	return c.call("QueryDeposit", msg)
+}
+
+func (c *FakeXplaClient) QueryVote(msg types.QueryVoteMsg) provider.XplaClient {
+	return c.call("QueryVote", msg)
+}
+
+func (c *FakeXplaClient) Tally(msg types.TallyMsg) provider.XplaClient {
+	return c.call("Tally", msg)
+}
+
+func (c *FakeXplaClient) GovParams(msg ...types.GovParamsMsg) provider.XplaClient {
+	return c.call("GovParams", optionalMsg(msg))
+}
+
+func (c *FakeXplaClient) Proposer(msg types.ProposerMsg) provider.XplaClient {
+	return c.call("Proposer", msg)
+}
+
+func (c *FakeXplaClient) MintParams() provider.XplaClient {
+	return c.call("MintParams", nil)
+}
+
+func (c *FakeXplaClient) Inflation() provider.XplaClient {
+	return c.call("Inflation", nil)
+}
+
+func (c *FakeXplaClient) AnnualProvisions() provider.XplaClient {
+	return c.call("AnnualProvisions", nil)
+}
+
+func (c *FakeXplaClient) QuerySubspace(msg types.SubspaceMsg) provider.XplaClient {
+	return c.call("QuerySubspace", msg)
+}
+
+func (c *FakeXplaClient) Admin() provider.XplaClient {
+	return c.call("Admin", nil)
+}
+
+func (c *FakeXplaClient) ParticipateState(msg types.ParticipateStateMsg) provider.XplaClient {
+	return c.call("ParticipateState", msg)
+}
+
+func (c *FakeXplaClient) ParticipateSequence(msg types.ParticipateSequenceMsg) provider.XplaClient {
+	return c.call("ParticipateSequence", msg)
+}
+
+func (c *FakeXplaClient) GenDIDSign(msg types.GenDIDSignMsg) provider.XplaClient {
+	return c.call("GenDIDSign", msg)
+}
+
+func (c *FakeXplaClient) IssueVC(msg types.IssueVCMsg) provider.XplaClient {
+	return c.call("IssueVC", msg)
+}
+
+func (c *FakeXplaClient) GetVP(msg types.GetVPMsg) provider.XplaClient {
+	return c.call("GetVP", msg)
+}
+
+func (c *FakeXplaClient) AllUnderReviews() provider.XplaClient {
+	return c.call("AllUnderReviews", nil)
+}
+
+func (c *FakeXplaClient) AllParticipants() provider.XplaClient {
+	return c.call("AllParticipants", nil)
+}
+
+func (c *FakeXplaClient) SlashingParams() provider.XplaClient {
+	return c.call("SlashingParams", nil)
+}
+
+func (c *FakeXplaClient) SigningInfos(msg ...types.SigningInfoMsg) provider.XplaClient {
+	return c.call("SigningInfos", optionalMsg(msg))
+}
+
+func (c *FakeXplaClient) QueryValidators(msg ...types.QueryValidatorMsg) provider.XplaClient {
+	return c.call("QueryValidators", optionalMsg(msg))
+}
+
+func (c *FakeXplaClient) QueryDelegation(msg types.QueryDelegationMsg) provider.XplaClient {
+	return c.call("QueryDelegation", msg)
+}
+
+func (c *FakeXplaClient) QueryUnbondingDelegation(msg types.QueryUnbondingDelegationMsg) provider.XplaClient {
+	return c.call("QueryUnbondingDelegation", msg)
+}
+
+func (c *FakeXplaClient) QueryRedelegation(msg types.QueryRedelegationMsg) provider.XplaClient {
+	return c.call("QueryRedelegation", msg)
+}
+
+func (c *FakeXplaClient) HistoricalInfo(msg types.HistoricalInfoMsg) provider.XplaClient {
+	return c.call("HistoricalInfo", msg)
+}
+
+func (c *FakeXplaClient) StakingPool() provider.XplaClient {
+	return c.call("StakingPool", nil)
+}
+
+func (c *FakeXplaClient) StakingParams() provider.XplaClient {
+	return c.call("StakingParams", nil)
+}
+
+func (c *FakeXplaClient) UpgradeApplied(msg types.AppliedMsg) provider.XplaClient {
+	return c.call("UpgradeApplied", msg)
+}
+
+func (c *FakeXplaClient) ModulesVersion(msg ...types.QueryModulesVersionMsg) provider.XplaClient {
+	return c.call("ModulesVersion", optionalMsg(msg))
+}
+
+func (c *FakeXplaClient) Plan() provider.XplaClient {
+	return c.call("Plan", nil)
+}
+
+func (c *FakeXplaClient) QueryContract(msg types.QueryMsg) provider.XplaClient {
+	return c.call("QueryContract", msg)
+}
+
+func (c *FakeXplaClient) ListCode() provider.XplaClient {
+	return c.call("ListCode", nil)
+}
+
+func (c *FakeXplaClient) ListContractByCode(msg types.ListContractByCodeMsg) provider.XplaClient {
+	return c.call("ListContractByCode", msg)
+}
+
+func (c *FakeXplaClient) Download(msg types.DownloadMsg) provider.XplaClient {
+	return c.call("Download", msg)
+}
+
+func (c *FakeXplaClient) CodeInfo(msg types.CodeInfoMsg) provider.XplaClient {
+	return c.call("CodeInfo", msg)
+}
+
+func (c *FakeXplaClient) ContractInfo(msg types.ContractInfoMsg) provider.XplaClient {
+	return c.call("ContractInfo", msg)
+}
+
+func (c *FakeXplaClient) ContractStateAll(msg types.ContractStateAllMsg) provider.XplaClient {
+	return c.call("ContractStateAll", msg)
+}
+
+func (c *FakeXplaClient) ContractHistory(msg types.ContractHistoryMsg) provider.XplaClient {
+	return c.call("ContractHistory", msg)
+}
+
+func (c *FakeXplaClient) Pinned() provider.XplaClient {
+	return c.call("Pinned", nil)
+}
+
+func (c *FakeXplaClient) LibwasmvmVersion() provider.XplaClient {
+	return c.call("LibwasmvmVersion", nil)
+}
@@ -2,6 +2,7 @@ package util
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 
 	didtypes "github.com/Moonyongjung/xpla-private-chain/x/did/types"
 	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gogo/protobuf/grpc"
@@ -23,6 +25,15 @@ func GetAddrByPrivKey(priv cryptotypes.PrivKey) (sdk.AccAddress, error) {
 	return addr, nil
 }
 
+// Get the account address of the signer.
+// It returns the error if the xpla client has neither the private key nor the signer.
+func GetAddrBySigner(signer types.Signer) (sdk.AccAddress, error) {
+	if signer == nil {
+		return nil, LogErr(errors.ErrNotSatisfiedOptions, "need private key or signer of xpla client's option")
+	}
+	return signer.Address(), nil
+}
+
 func GasLimitAdjustment(gasUsed uint64, gasAdjustment string) (string, error) {
 	gasAdj, err := strconv.ParseFloat(gasAdjustment, 64)
 	if err != nil {
@@ -119,3 +130,91 @@ func GetDIDDocByQueryClient(did, lcdUrl, grpcUrl string, grpcConn grpc.ClientCon
 
 	return didRes.DidDocumentWithSeq, nil
 }
+
+// Find the value of the key in the JSON regardless of the depth, e.g. the query response which is
+// received as JSON by both gRPC and LCD. If several keys are given, the first found key is used.
+func FindJsonValue(jsonStr string, keys ...string) (string, bool) {
+	var data interface{}
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return "", false
+	}
+
+	for _, key := range keys {
+		if value, ok := findJsonValue(data, key); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// Find all values of keys in the JSON regardless of the depth.
+func FindJsonValues(jsonStr string, keys ...string) []string {
+	var data interface{}
+	if err := json.Unmarshal([]byte(jsonStr), &data); err != nil {
+		return nil
+	}
+
+	var values []string
+	for _, key := range keys {
+		values = append(values, findJsonValues(data, key)...)
+	}
+	return values
+}
+
+func findJsonValues(data interface{}, key string) []string {
+	var values []string
+	switch v := data.(type) {
+	case map[string]interface{}:
+		for k, value := range v {
+			if k == key {
+				if found, ok := jsonScalarString(value); ok {
+					values = append(values, found)
+					continue
+				}
+			}
+			values = append(values, findJsonValues(value, key)...)
+		}
+	case []interface{}:
+		for _, value := range v {
+			values = append(values, findJsonValues(value, key)...)
+		}
+	}
+
+	return values
+}
+
+func jsonScalarString(value interface{}) (string, bool) {
+	switch value := value.(type) {
+	case string:
+		return value, true
+	case float64:
+		return FromInt64ToString(int64(value)), true
+	case bool:
+		return strconv.FormatBool(value), true
+	}
+	return "", false
+}
+
+func findJsonValue(data interface{}, key string) (string, bool) {
+	switch v := data.(type) {
+	case map[string]interface{}:
+		if value, ok := v[key]; ok {
+			if found, ok := jsonScalarString(value); ok {
+				return found, true
+			}
+		}
+		for _, value := range v {
+			if found, ok := findJsonValue(value, key); ok {
+				return found, true
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if found, ok := findJsonValue(value, key); ok {
+				return found, true
+			}
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,173 @@
+package util
+
+import (
+	"context"
+	"encoding/base64"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/Moonyongjung/xpriv.go/types"
+	"github.com/Moonyongjung/xpriv.go/types/errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// The header of HTTP requests and the metadata key of gRPC requests which has the base64 encoded VP.
+const VPHeader = "x-vp"
+
+type vpContextKey struct{}
+
+var _ types.VPProvider = &StaticVPProvider{}
+var _ types.VPProvider = &FileVPProvider{}
+
+// VP provider which always provides the same VP.
+type StaticVPProvider struct {
+	vp []byte
+}
+
+// Make new static VP provider.
+func NewStaticVPProvider(vp []byte) *StaticVPProvider {
+	return &StaticVPProvider{vp: vp}
+}
+
+func (p *StaticVPProvider) VP(ctx context.Context) ([]byte, error) {
+	return p.vp, nil
+}
+
+func (p *StaticVPProvider) Refresh(ctx context.Context) ([]byte, error) {
+	return p.vp, nil
+}
+
+// VP provider which reads the VP from the file.
+// The file is read again when its modification time is changed, so the VP can be replaced by writing the file.
+// It is safe for concurrent use.
+type FileVPProvider struct {
+	mu      sync.Mutex
+	path    string
+	vp      []byte
+	modTime time.Time
+}
+
+// Make new VP provider of the file.
+func NewFileVPProvider(path string) *FileVPProvider {
+	return &FileVPProvider{path: path}
+}
+
+func (p *FileVPProvider) VP(ctx context.Context) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return nil, LogErr(errors.ErrInvalidRequest, err)
+	}
+
+	if p.vp == nil || !info.ModTime().Equal(p.modTime) {
+		return p.load(info.ModTime())
+	}
+	return p.vp, nil
+}
+
+func (p *FileVPProvider) Refresh(ctx context.Context) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return nil, LogErr(errors.ErrInvalidRequest, err)
+	}
+	return p.load(info.ModTime())
+}
+
+func (p *FileVPProvider) load(modTime time.Time) ([]byte, error) {
+	vp, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, LogErr(errors.ErrInvalidRequest, err)
+	}
+
+	p.vp = vp
+	p.modTime = modTime
+	return vp, nil
+}
+
+// Check the error is occurred by the authorization of the VP,
+// e.g. the gRPC status is unauthenticated or permission denied, or the HTTP status is 401 or 403.
+func IsAuthorizationErr(err error) bool {
+	if code, ok := GrpcStatusCode(err); ok {
+		return code == codes.Unauthenticated || code == codes.PermissionDenied
+	}
+	if code, ok := HttpStatusCode(err); ok {
+		return code == http.StatusUnauthorized || code == http.StatusForbidden
+	}
+	return false
+}
+
+// Set the VP to the context. The VP of the context is attached to every HTTP and gRPC request
+// which is sent with the context by the VP transport and the VP interceptors.
+func ContextWithVP(ctx context.Context, vp []byte) context.Context {
+	if vp == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, vpContextKey{}, vp)
+}
+
+// Get the VP of the context. It is nil if the context has no VP.
+func VPFromContext(ctx context.Context) []byte {
+	if ctx == nil {
+		return nil
+	}
+	vp, _ := ctx.Value(vpContextKey{}).([]byte)
+	return vp
+}
+
+type vpTransport struct {
+	base http.RoundTripper
+}
+
+// Make the HTTP transport which attaches the VP of the request context to the header.
+// It is used by LCD requests and evm JSON-RPC requests. If base is nil, http.DefaultTransport is used.
+func NewVPTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &vpTransport{base: base}
+}
+
+func (t *vpTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	vp := VPFromContext(req.Context())
+	if vp == nil || req.Header.Get(VPHeader) != "" {
+		return t.base.RoundTrip(req)
+	}
+
+	// The request must not be modified by the round tripper.
+	vpReq := req.Clone(req.Context())
+	vpReq.Header.Set(VPHeader, base64.StdEncoding.EncodeToString(vp))
+	return t.base.RoundTrip(vpReq)
+}
+
+// gRPC unary interceptor which attaches the VP of the context to the outgoing metadata.
+func VPUnaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(vpOutgoingContext(ctx), method, req, reply, cc, opts...)
+}
+
+// gRPC stream interceptor which attaches the VP of the context to the outgoing metadata.
+func VPStreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(vpOutgoingContext(ctx), desc, cc, method, opts...)
+}
+
+func vpOutgoingContext(ctx context.Context) context.Context {
+	vp := VPFromContext(ctx)
+	if vp == nil {
+		return ctx
+	}
+
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if len(md.Get(VPHeader)) > 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, VPHeader, base64.StdEncoding.EncodeToString(vp))
+}